@@ -47,6 +47,22 @@ type ConcatOptions struct {
 
 	// Sort if true, sort non-concatenation axis if it is not already aligned. Default: false.
 	Sort bool
+
+	// SortKeys if true, sorts the aligned index labels lexicographically when
+	// concatenating along AxisColumns. When false, labels keep the order of
+	// the first DataFrame's index, followed by labels first seen in later
+	// DataFrames in encounter order. Default: false.
+	SortKeys bool
+
+	// Keys labels each input DataFrame, so the result records where each row
+	// or column came from. Keys must have one entry per element of objs
+	// (including nil entries, whose keys are skipped). With AxisIndex each
+	// index label becomes key + KeySep + label; with AxisColumns each column
+	// name becomes key + KeySep + name. Default: nil (no labelling).
+	Keys []string
+
+	// KeySep separates a key from the original label. Default: "/".
+	KeySep string
 }
 
 // DefaultConcatOptions returns the default options for Concat.
@@ -57,6 +73,7 @@ func DefaultConcatOptions() ConcatOptions {
 		IgnoreIndex:     false,
 		VerifyIntegrity: false,
 		Sort:            false,
+		KeySep:          "/",
 	}
 }
 
@@ -81,6 +98,9 @@ func DefaultConcatOptions() ConcatOptions {
 //
 //	// With options:
 //	result, err := gpandas.Concat([]*dataframe.DataFrame{df1, df2}, gpandas.ConcatOptions{Axis: gpandas.AxisColumns, Join: gpandas.JoinInner})
+//
+//	// Labelling the source of each row ("jan/0", "jan/1", "feb/0", ...):
+//	result, err := gpandas.Concat([]*dataframe.DataFrame{jan, feb}, gpandas.ConcatOptions{Keys: []string{"jan", "feb"}})
 func Concat(objs []*dataframe.DataFrame, opts ...ConcatOptions) (*dataframe.DataFrame, error) {
 	// Apply default options
 	options := DefaultConcatOptions()
@@ -88,10 +108,20 @@ func Concat(objs []*dataframe.DataFrame, opts ...ConcatOptions) (*dataframe.Data
 		options = opts[0]
 	}
 
+	if options.Keys != nil && len(options.Keys) != len(objs) {
+		return nil, fmt.Errorf("keys length %d does not match number of DataFrames %d", len(options.Keys), len(objs))
+	}
+	if options.KeySep == "" {
+		options.KeySep = "/"
+	}
+
 	// Filter out nil DataFrames
 	validDFs := make([]*dataframe.DataFrame, 0, len(objs))
-	for _, df := range objs {
+	for i, df := range objs {
 		if df != nil {
+			if options.Keys != nil {
+				df = withConcatKey(df, options.Keys[i]+options.KeySep, options.Axis)
+			}
 			validDFs = append(validDFs, df)
 		}
 	}
@@ -102,7 +132,7 @@ func Concat(objs []*dataframe.DataFrame, opts ...ConcatOptions) (*dataframe.Data
 
 	if len(validDFs) == 1 {
 		// Return a copy of the single DataFrame
-		return copyDataFrame(validDFs[0]), nil
+		return validDFs[0].View(), nil
 	}
 
 	switch options.Axis {
@@ -211,9 +241,20 @@ func concatAlongRows(dfs []*dataframe.DataFrame, opts ConcatOptions) (*dataframe
 		df.RUnlock()
 	}
 
-	// Create result series for each column using AnySeries for simplicity
+	// Columns that every DataFrame holds as the same typed series are
+	// concatenated directly; the rest are collected row by row in AnySeries.
 	resultSeries := make(map[string]collection.Series)
+	typedColumns := make(map[string]bool)
 	for _, col := range resultColumns {
+		typed, err := concatTypedColumn(dfs, col)
+		if err != nil {
+			return nil, err
+		}
+		if typed != nil {
+			resultSeries[col] = typed
+			typedColumns[col] = true
+			continue
+		}
 		resultSeries[col] = collection.NewAnySeries(totalRows)
 	}
 
@@ -227,6 +268,9 @@ func concatAlongRows(dfs []*dataframe.DataFrame, opts ConcatOptions) (*dataframe
 
 		for r := 0; r < numRows; r++ {
 			for _, col := range resultColumns {
+				if typedColumns[col] {
+					continue
+				}
 				series := df.Columns[col]
 				if series != nil && r < series.Len() {
 					if series.IsNull(r) {
@@ -273,11 +317,64 @@ func concatAlongRows(dfs []*dataframe.DataFrame, opts ConcatOptions) (*dataframe
 	}, nil
 }
 
+// concatTypedColumn concatenates column col of every DataFrame with the typed
+// Concat*Series functions when all of them hold it as the same Float64,
+// Int64, String or Bool series covering all their rows. It returns nil when
+// that is not the case.
+func concatTypedColumn(dfs []*dataframe.DataFrame, col string) (collection.Series, error) {
+	series := make([]collection.Series, len(dfs))
+	for i, df := range dfs {
+		df.RLock()
+		s, ok := df.Columns[col]
+		rows := df.Len()
+		df.RUnlock()
+		if !ok || s == nil || s.Len() != rows {
+			return nil, nil
+		}
+		series[i] = s
+	}
+
+	switch series[0].(type) {
+	case *collection.Float64Series:
+		if typed, ok := sameSeriesType[*collection.Float64Series](series); ok {
+			return collection.ConcatFloat64Series(typed...)
+		}
+	case *collection.Int64Series:
+		if typed, ok := sameSeriesType[*collection.Int64Series](series); ok {
+			return collection.ConcatInt64Series(typed...)
+		}
+	case *collection.StringSeries:
+		if typed, ok := sameSeriesType[*collection.StringSeries](series); ok {
+			return collection.ConcatStringSeries(typed...)
+		}
+	case *collection.BoolSeries:
+		if typed, ok := sameSeriesType[*collection.BoolSeries](series); ok {
+			return collection.ConcatBoolSeries(typed...)
+		}
+	}
+	return nil, nil
+}
+
+// sameSeriesType converts series to a slice of S, reporting whether every
+// element is an S.
+func sameSeriesType[S collection.Series](series []collection.Series) ([]S, bool) {
+	out := make([]S, len(series))
+	for i, s := range series {
+		typed, ok := s.(S)
+		if !ok {
+			return nil, false
+		}
+		out[i] = typed
+	}
+	return out, true
+}
+
 // concatAlongColumns concatenates DataFrames horizontally (joining columns side-by-side).
 func concatAlongColumns(dfs []*dataframe.DataFrame, opts ConcatOptions) (*dataframe.DataFrame, error) {
 	// For axis=1, we need to align rows based on index
 	// Collect all unique indices
-	allIndices := make(map[string]bool)
+	var allIndices []string // unique labels in encounter order
+	seenIndices := make(map[string]bool)
 	indexSets := make([]map[string]int, len(dfs)) // Map index label to row position
 
 	for i, df := range dfs {
@@ -290,7 +387,10 @@ func concatAlongColumns(dfs []*dataframe.DataFrame, opts ConcatOptions) (*datafr
 			} else {
 				idx = fmt.Sprintf("%d", r)
 			}
-			allIndices[idx] = true
+			if !seenIndices[idx] {
+				seenIndices[idx] = true
+				allIndices = append(allIndices, idx)
+			}
 			indexSets[i][idx] = r
 		}
 		df.RUnlock()
@@ -299,7 +399,7 @@ func concatAlongColumns(dfs []*dataframe.DataFrame, opts ConcatOptions) (*datafr
 	var resultIndex []string
 	if opts.Join == JoinInner {
 		// Intersection: indices present in ALL DataFrames
-		for idx := range allIndices {
+		for _, idx := range allIndices {
 			presentInAll := true
 			for _, idxSet := range indexSets {
 				if _, ok := idxSet[idx]; !ok {
@@ -313,9 +413,7 @@ func concatAlongColumns(dfs []*dataframe.DataFrame, opts ConcatOptions) (*datafr
 		}
 	} else {
 		// Outer join: union of all indices
-		for idx := range allIndices {
-			resultIndex = append(resultIndex, idx)
-		}
+		resultIndex = allIndices
 	}
 
 	if len(resultIndex) == 0 {
@@ -323,7 +421,7 @@ func concatAlongColumns(dfs []*dataframe.DataFrame, opts ConcatOptions) (*datafr
 	}
 
 	// Sort index if requested
-	if opts.Sort {
+	if opts.Sort || opts.SortKeys {
 		sort.Strings(resultIndex)
 	}
 
@@ -384,29 +482,30 @@ func concatAlongColumns(dfs []*dataframe.DataFrame, opts ConcatOptions) (*datafr
 	}, nil
 }
 
-// copyDataFrame creates a shallow copy of a DataFrame.
-func copyDataFrame(df *dataframe.DataFrame) *dataframe.DataFrame {
-	if df == nil {
-		return nil
-	}
-
-	df.RLock()
-	defer df.RUnlock()
-
-	newCols := make(map[string]collection.Series, len(df.Columns))
-	for name, series := range df.Columns {
-		newCols[name] = series // Shallow copy - series are shared
-	}
-
-	newOrder := make([]string, len(df.ColumnOrder))
-	copy(newOrder, df.ColumnOrder)
-
-	newIndex := make([]string, len(df.Index))
-	copy(newIndex, df.Index)
-
-	return &dataframe.DataFrame{
-		Columns:     newCols,
-		ColumnOrder: newOrder,
-		Index:       newIndex,
+// withConcatKey returns a shallow copy of df whose labels along axis are
+// prefixed: index labels for AxisIndex (rows without a label use their
+// position), column names for AxisColumns.
+func withConcatKey(df *dataframe.DataFrame, prefix string, axis ConcatAxis) *dataframe.DataFrame {
+	out := df.View()
+	switch axis {
+	case AxisIndex:
+		rows := out.Len()
+		index := make([]string, rows)
+		for r := 0; r < rows; r++ {
+			if r < len(out.Index) {
+				index[r] = prefix + out.Index[r]
+			} else {
+				index[r] = fmt.Sprintf("%s%d", prefix, r)
+			}
+		}
+		out.Index = index
+	case AxisColumns:
+		cols := make(map[string]collection.Series, len(out.Columns))
+		for i, name := range out.ColumnOrder {
+			cols[prefix+name] = out.Columns[name]
+			out.ColumnOrder[i] = prefix + name
+		}
+		out.Columns = cols
 	}
+	return out
 }
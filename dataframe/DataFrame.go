@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"os"
+	"reflect"
+	"regexp"
+	"slices"
 	"strings"
 	"sync"
+	"unicode/utf8"
 
 	"github.com/apoplexi24/gpandas/plot"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -51,6 +54,20 @@ type DataFrame struct {
 	Columns     map[string]collection.Series
 	ColumnOrder []string
 	Index       []string // Row labels, defaults to string representations of row numbers
+	IndexName   string   // Name of the row axis, set via RenameAxis; empty by default
+	ColumnsName string   // Name of the column axis, set via RenameAxis; empty by default
+
+	// ColumnHierarchy maps synthesized column names to their two column
+	// levels ([valueName, colValue]), e.g. as produced by PivotTable with
+	// multiple Values. It is nil for DataFrames with flat columns.
+	ColumnHierarchy map[string][2]string
+
+	// MultiIndex holds the levels of a composite row index, one []string per
+	// row, e.g. as produced by PivotTable with several Index columns. Index
+	// then holds the same levels joined into a single label. Operations that
+	// select or reorder rows (Head, Slice, SortValues, Filter, ...) carry the
+	// levels of the rows they keep. It is nil for DataFrames with a flat index.
+	MultiIndex [][]string
 }
 
 // Rename changes the names of specified columns in the DataFrame.
@@ -149,6 +166,158 @@ func (df *DataFrame) Rename(columns map[string]string) error {
 	return nil
 }
 
+// RenameRegex renames, in place, every column whose name matches the regular
+// expression pattern, replacing the matched portions with replacement. The
+// replacement may reference capture groups ($1, ${name}) as in
+// regexp.Regexp.ReplaceAllString. Column order is unchanged.
+//
+// An error is returned, and the DataFrame is left unchanged, if the pattern is
+// invalid, a column would be renamed to an empty name, or two columns would end
+// up with the same name.
+//
+// This is analogous to df.rename(columns=lambda c: re.sub(pattern, repl, c))
+// in pandas.
+//
+// Example:
+//
+//	// feature_A, feature_B -> A, B
+//	err := df.RenameRegex("^feature_", "")
+func (df *DataFrame) RenameRegex(pattern string, replacement string) error {
+	if df == nil {
+		return errors.New("RenameRegex: DataFrame is nil")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return fmt.Errorf("RenameRegex: invalid pattern: %w", err)
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	return df.renameColumnsFunc("RenameRegex", nil, func(name string) string {
+		return re.ReplaceAllString(name, replacement)
+	})
+}
+
+// PrefixColumns prepends prefix to the names of the columns in subset, or of
+// all columns when subset is empty, in place. Column order is unchanged.
+//
+// An error is returned, and the DataFrame is left unchanged, if a column in
+// subset does not exist or a new name is empty or collides with another column.
+//
+// This is analogous to df.add_prefix(prefix) in pandas.
+//
+// Example:
+//
+//	// After a merge: Amount, Date -> left_Amount, left_Date
+//	err := merged.PrefixColumns("left_", []string{"Amount", "Date"})
+func (df *DataFrame) PrefixColumns(prefix string, subset []string) error {
+	if df == nil {
+		return errors.New("PrefixColumns: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	return df.renameColumnsFunc("PrefixColumns", subset, func(name string) string {
+		return prefix + name
+	})
+}
+
+// SuffixColumns appends suffix to the names of the columns in subset, or of
+// all columns when subset is empty, in place. Errors are reported as for
+// PrefixColumns.
+//
+// This is analogous to df.add_suffix(suffix) in pandas.
+//
+// Example:
+//
+//	err := df.SuffixColumns("_2023", nil)
+func (df *DataFrame) SuffixColumns(suffix string, subset []string) error {
+	if df == nil {
+		return errors.New("SuffixColumns: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	return df.renameColumnsFunc("SuffixColumns", subset, func(name string) string {
+		return name + suffix
+	})
+}
+
+// renameColumnsFunc renames the columns in subset (all columns when subset is
+// empty) using rename. All new names are validated before anything is changed.
+// The caller must hold the write lock; op prefixes error messages.
+func (df *DataFrame) renameColumnsFunc(op string, subset []string, rename func(string) string) error {
+	selected := make(map[string]bool, len(subset))
+	for _, name := range subset {
+		if _, ok := df.Columns[name]; !ok {
+			return fmt.Errorf("%s: column '%s' not found", op, name)
+		}
+		selected[name] = true
+	}
+
+	newOrder := make([]string, len(df.ColumnOrder))
+	seen := make(map[string]string, len(df.ColumnOrder))
+	for i, name := range df.ColumnOrder {
+		newName := name
+		if len(subset) == 0 || selected[name] {
+			newName = rename(name)
+		}
+		if newName == "" {
+			return fmt.Errorf("%s: column '%s' would be renamed to an empty name", op, name)
+		}
+		if prev, dup := seen[newName]; dup {
+			return fmt.Errorf("%s: columns '%s' and '%s' would both be named '%s'", op, prev, name, newName)
+		}
+		seen[newName] = name
+		newOrder[i] = newName
+	}
+
+	newCols := make(map[string]collection.Series, len(df.Columns))
+	for i, name := range df.ColumnOrder {
+		newCols[newOrder[i]] = df.Columns[name]
+	}
+	df.Columns = newCols
+	df.ColumnOrder = newOrder
+	return nil
+}
+
+// RenameAxis sets the names of the row axis (IndexName) and the column axis
+// (ColumnsName). Pass an empty string to clear a name.
+//
+// When IndexName is non-empty, String and ToCSV print the index as the first
+// column, using IndexName as its header.
+//
+// This is analogous to df.rename_axis(index=..., columns=...) in pandas.
+//
+// Example:
+//
+//	err := df.RenameAxis("user_id", "attribute")
+//	fmt.Println(df) // first column is headed "user_id" and lists the index labels
+func (df *DataFrame) RenameAxis(indexName, columnsName string) error {
+	if df == nil {
+		return errors.New("RenameAxis: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	df.IndexName = indexName
+	df.ColumnsName = columnsName
+	return nil
+}
+
+// indexLabel returns the index label for row i, falling back to the row
+// position when the index is shorter than the data.
+func (df *DataFrame) indexLabel(i int) string {
+	if i < len(df.Index) {
+		return df.Index[i]
+	}
+	return fmt.Sprintf("%d", i)
+}
+
 // String returns a string representation of the DataFrame in a formatted table.
 //
 // The method creates a visually appealing ASCII table representation of the DataFrame
@@ -204,8 +373,14 @@ func (df *DataFrame) String() string {
 		tablewriter.WithRowAutoWrap(tw.WrapNone),
 	)
 
-	// Set headers using the DataFrame's ColumnOrder
-	table.Header(df.ColumnOrder)
+	// Set headers using the DataFrame's ColumnOrder, preceded by the index
+	// column when the row axis is named.
+	showIndex := df.IndexName != ""
+	if showIndex {
+		table.Header(append([]string{df.IndexName}, df.ColumnOrder...))
+	} else {
+		table.Header(df.ColumnOrder)
+	}
 
 	// Determine number of rows using the first column's length (min length across columns)
 	rowCount := 0
@@ -238,6 +413,9 @@ func (df *DataFrame) String() string {
 				stringRow[j] = ""
 			}
 		}
+		if showIndex {
+			stringRow = append([]string{df.indexLabel(i)}, stringRow...)
+		}
 		_ = table.Append(stringRow)
 	}
 
@@ -275,7 +453,12 @@ func (df *DataFrame) String() string {
 //   - error: nil if successful, otherwise an error describing what went wrong
 //
 // Note: If filepath is provided, the method returns ("", nil) on success
-// Null values are represented as empty strings in the CSV output.
+// Null values are represented as empty strings in the CSV output. Values
+// containing the separator, quotes or newlines are quoted, with internal quotes
+// doubled. A multi-character separator (or one that is itself a quote or a
+// newline) cannot be quoted around, so fields are then joined as-is without
+// quoting. Use ToCSVWithOptions for a custom quote character or to quote every
+// field.
 //
 // Example:
 //
@@ -300,58 +483,11 @@ func (df *DataFrame) ToCSV(filepath string, separator ...string) (string, error)
 	if len(separator) > 0 {
 		sep = separator[0]
 	}
-
-	var buf bytes.Buffer
-
-	// Write headers
-	for i, colName := range df.ColumnOrder {
-		if i > 0 {
-			buf.WriteString(sep)
-		}
-		buf.WriteString(colName)
+	r, size := utf8.DecodeRuneInString(sep)
+	if size == 0 || size != len(sep) || r == utf8.RuneError || r == '"' || r == '\r' || r == '\n' {
+		return df.toCSVPlain(filepath, sep)
 	}
-	buf.WriteString("\n")
-
-	// Determine row count (use shortest column to avoid out-of-range)
-	rowCount := 0
-	if len(df.ColumnOrder) > 0 {
-		rowCount = df.Columns[df.ColumnOrder[0]].Len()
-		for _, colName := range df.ColumnOrder[1:] {
-			if s := df.Columns[colName]; s != nil && s.Len() < rowCount {
-				rowCount = s.Len()
-			}
-		}
-	}
-
-	// Write data rows
-	for r := 0; r < rowCount; r++ {
-		for i, colName := range df.ColumnOrder {
-			if i > 0 {
-				buf.WriteString(sep)
-			}
-			series := df.Columns[colName]
-			if series.IsNull(r) {
-				// Null values are represented as empty strings
-				buf.WriteString("")
-			} else {
-				val, _ := series.At(r)
-				buf.WriteString(fmt.Sprintf("%v", val))
-			}
-		}
-		buf.WriteString("\n")
-	}
-
-	// If filepath is provided, write to file and return nil
-	if filepath != "" {
-		err := os.WriteFile(filepath, buf.Bytes(), 0644)
-		if err != nil {
-			return "", fmt.Errorf("failed to write CSV to file: %w", err)
-		}
-		return "", nil
-	}
-
-	// If no filepath, return the CSV string
-	return buf.String(), nil
+	return df.ToCSVWithOptions(filepath, CSVWriteOptions{Separator: r})
 }
 
 // Loc returns a label-based indexer for the DataFrame
@@ -359,14 +495,35 @@ func (df *DataFrame) Loc() *LocIndexer {
 	return &LocIndexer{df: df}
 }
 
-// ILoc returns an integer position-based indexer for the DataFrame
+// ILoc returns an integer position-based indexer for the DataFrame.
+// Col and Cols return Series shared with df (views); call Deep on the indexer
+// to get independent copies instead.
 func (df *DataFrame) ILoc() *iLocIndexer {
 	return &iLocIndexer{df: df}
 }
 
 // Select returns a new DataFrame with only the specified columns.
 // If a single column is requested, still returns a DataFrame (not a Series).
+// The result is a view: its Series are shared with df (see View). Use
+// SelectWithOptions with Deep set to get independent copies.
 func (df *DataFrame) Select(columns ...string) (*DataFrame, error) {
+	return df.SelectWithOptions(columns, SelectOptions{})
+}
+
+// SelectOptions configures SelectWithOptions.
+type SelectOptions struct {
+	// Deep copies the selected Series instead of sharing them with the
+	// source DataFrame. Default: false (return a view).
+	Deep bool
+}
+
+// SelectWithOptions is like Select but takes the columns as a slice along
+// with options controlling whether the result shares its Series with df.
+//
+// Example:
+//
+//	sub, err := df.SelectWithOptions([]string{"A", "B"}, dataframe.SelectOptions{Deep: true})
+func (df *DataFrame) SelectWithOptions(columns []string, opts SelectOptions) (*DataFrame, error) {
 	if df == nil {
 		return nil, errors.New("DataFrame is nil")
 	}
@@ -387,14 +544,64 @@ func (df *DataFrame) Select(columns ...string) (*DataFrame, error) {
 	// Create new DataFrame with selected columns (zero-copy - just reference same Series)
 	newCols := make(map[string]collection.Series, len(columns))
 	for _, colName := range columns {
-		newCols[colName] = df.Columns[colName]
+		if opts.Deep {
+			newCols[colName] = cloneSeries(df.Columns[colName])
+		} else {
+			newCols[colName] = df.Columns[colName]
+		}
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), columns...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
+}
+
+// SelectDtypes returns a new DataFrame with the columns whose Series DType is
+// in include (every column if include is empty), less those whose DType is in
+// exclude, in their original order. At least one of include and exclude must
+// be non-empty. If no column matches, the result has no columns but keeps the
+// index. As with Select, the result shares its Series with df.
+//
+// This is analogous to df.select_dtypes(include=..., exclude=...) in pandas.
+//
+// Example:
+//
+//	numeric, err := df.SelectDtypes(gpandas.NumericTypes(), nil)
+//	nonString, err := df.SelectDtypes(nil, []reflect.Type{reflect.TypeOf("")})
+func (df *DataFrame) SelectDtypes(include []reflect.Type, exclude []reflect.Type) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("SelectDtypes: DataFrame is nil")
+	}
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil, errors.New("SelectDtypes: at least one of include or exclude must be non-empty")
+	}
+	for _, t := range include {
+		if slices.Contains(exclude, t) {
+			return nil, fmt.Errorf("SelectDtypes: type %v is both included and excluded", t)
+		}
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	newCols := make(map[string]collection.Series)
+	order := make([]string, 0, len(df.ColumnOrder))
+	for _, name := range df.ColumnOrder {
+		t := df.Columns[name].DType()
+		if (len(include) > 0 && !slices.Contains(include, t)) || slices.Contains(exclude, t) {
+			continue
+		}
+		newCols[name] = df.Columns[name]
+		order = append(order, name)
+	}
+
+	return df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: order,
+		Index:       append([]string(nil), df.Index...),
+	}, nil), nil
 }
 
 // SelectCol returns a single column as a Series reference.
@@ -439,6 +646,66 @@ func (df *DataFrame) SetIndex(index []string) error {
 	return nil
 }
 
+// SetIndexFromColumn sets the index from the values of an existing column.
+// Each value is converted to its string form with fmt.Sprintf("%v", val), and
+// a null becomes the label "null", as in SetIndexColumns. When drop is true
+// the column is removed from the DataFrame afterwards.
+//
+// An error is returned if the column does not exist or contains duplicate
+// values (including more than one null), since index labels must be unique
+// for Loc lookups.
+//
+// This is analogous to df.set_index(column, drop=drop) in pandas.
+//
+// Example:
+//
+//	err := df.SetIndexFromColumn("ID", true)
+//	row, err := df.Loc().Row("42")
+func (df *DataFrame) SetIndexFromColumn(column string, drop bool) error {
+	if df == nil {
+		return errors.New("SetIndexFromColumn: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	series, ok := df.Columns[column]
+	if !ok {
+		return fmt.Errorf("SetIndexFromColumn: column '%s' not found", column)
+	}
+
+	index := make([]string, series.Len())
+	seen := make(map[string]struct{}, series.Len())
+	for i := range index {
+		label := "null"
+		if !series.IsNull(i) {
+			val, err := series.At(i)
+			if err != nil {
+				return fmt.Errorf("SetIndexFromColumn: %w", err)
+			}
+			label = fmt.Sprintf("%v", val)
+		}
+		if _, dup := seen[label]; dup {
+			return fmt.Errorf("SetIndexFromColumn: column '%s' contains duplicate value '%s'", column, label)
+		}
+		seen[label] = struct{}{}
+		index[i] = label
+	}
+
+	df.Index = index
+	if drop {
+		delete(df.Columns, column)
+		newOrder := make([]string, 0, len(df.ColumnOrder)-1)
+		for _, name := range df.ColumnOrder {
+			if name != column {
+				newOrder = append(newOrder, name)
+			}
+		}
+		df.ColumnOrder = newOrder
+	}
+	return nil
+}
+
 // ResetIndex resets the index to default integer sequence ("0", "1", "2", ...).
 func (df *DataFrame) ResetIndex() {
 	if df == nil {
@@ -461,6 +728,58 @@ func (df *DataFrame) ResetIndex() {
 	}
 }
 
+// ResetIndexToColumn moves the current index into a new string column named
+// name at position 0 of the column order, then resets the index to the
+// default integer sequence ("0", "1", "2", ...). It is the inverse of
+// SetIndexFromColumn.
+//
+// An error is returned if a column with the same name already exists or if
+// the index length does not match the number of rows.
+//
+// This is analogous to df.reset_index() (with drop=False) in pandas.
+//
+// Example:
+//
+//	_ = df.SetIndexFromColumn("ID", true)
+//	err := df.ResetIndexToColumn("ID") // "ID" is restored as the first column
+func (df *DataFrame) ResetIndexToColumn(name string) error {
+	if df == nil {
+		return errors.New("ResetIndexToColumn: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	if _, exists := df.Columns[name]; exists {
+		return fmt.Errorf("ResetIndexToColumn: column '%s' already exists", name)
+	}
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+	if len(df.Index) != rowCount {
+		return fmt.Errorf("ResetIndexToColumn: index length (%d) must match number of rows (%d)", len(df.Index), rowCount)
+	}
+
+	series, err := collection.NewStringSeriesFromData(df.Index, nil)
+	if err != nil {
+		return fmt.Errorf("ResetIndexToColumn: %w", err)
+	}
+
+	if df.Columns == nil {
+		df.Columns = make(map[string]collection.Series)
+	}
+	df.Columns[name] = series
+	df.ColumnOrder = append([]string{name}, df.ColumnOrder...)
+
+	df.Index = make([]string, rowCount)
+	for i := 0; i < rowCount; i++ {
+		df.Index[i] = fmt.Sprintf("%d", i)
+	}
+	return nil
+}
+
 // Head returns the first n rows of the DataFrame.
 // If n is not provided, it defaults to 5.
 func (df *DataFrame) Head(n ...int) *DataFrame {
@@ -502,11 +821,11 @@ func (df *DataFrame) Head(n ...int) *DataFrame {
 		copy(newIndex, df.Index)
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       newIndex,
-	}
+	}, rowRange(0, limit))
 }
 
 // Tail returns the last n rows of the DataFrame.
@@ -550,20 +869,66 @@ func (df *DataFrame) Tail(n ...int) *DataFrame {
 		}
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       newIndex,
-	}
+	}, rowRange(start, rowCount))
 }
 
 // Len returns the number of rows in the DataFrame.
+//
+// It returns 0 for a nil DataFrame, for a DataFrame without columns, and
+// when the first column in ColumnOrder has no backing Series. Otherwise it
+// returns the length of the first column, since all columns share the same
+// length.
 func (df *DataFrame) Len() int {
 	if df == nil || len(df.ColumnOrder) == 0 {
 		return 0
 	}
-	// Assume all columns have the same length
-	return df.Columns[df.ColumnOrder[0]].Len()
+	first, ok := df.Columns[df.ColumnOrder[0]]
+	if !ok || first == nil {
+		return 0
+	}
+	return first.Len()
+}
+
+// Shape returns the dimensions of the DataFrame as (rows, columns).
+//
+// NumRows, NumCols and IsEmpty are convenience accessors for the same
+// information: NumRows is the first element of Shape (and equivalent to
+// Len), NumCols is the second, and IsEmpty reports whether either is zero.
+// All four are safe to call on a nil DataFrame.
+//
+// This is analogous to DataFrame.shape and DataFrame.empty in pandas.
+//
+// Example:
+//
+//	rows, cols := df.Shape()
+//	if df.IsEmpty() {
+//		return
+//	}
+//	fmt.Println(df.NumRows() == rows, df.NumCols() == cols) // true true
+func (df *DataFrame) Shape() (int, int) {
+	return df.NumRows(), df.NumCols()
+}
+
+// NumRows returns the number of rows in the DataFrame. It is equivalent to Len.
+func (df *DataFrame) NumRows() int {
+	return df.Len()
+}
+
+// NumCols returns the number of columns in the DataFrame.
+func (df *DataFrame) NumCols() int {
+	if df == nil {
+		return 0
+	}
+	return len(df.ColumnOrder)
+}
+
+// IsEmpty reports whether the DataFrame has no rows or no columns.
+func (df *DataFrame) IsEmpty() bool {
+	return df.NumRows() == 0 || df.NumCols() == 0
 }
 
 // Slice returns a new DataFrame containing only the rows specified by indices.
@@ -575,6 +940,11 @@ func (df *DataFrame) Slice(indices []int) (*DataFrame, error) {
 	df.RLock()
 	defer df.RUnlock()
 
+	return df.sliceLocked(indices)
+}
+
+// sliceLocked is Slice without locking. Must be called with df's lock held.
+func (df *DataFrame) sliceLocked(indices []int) (*DataFrame, error) {
 	newCols := make(map[string]collection.Series, len(df.Columns))
 	for name, series := range df.Columns {
 		// We need a way to create a new Series from a list of indices.
@@ -582,18 +952,24 @@ func (df *DataFrame) Slice(indices []int) (*DataFrame, error) {
 		// This is not efficient but works for now.
 		// TODO: Add SelectIndices to Series interface for better performance.
 
-		// Create new empty series of same type
-		newSeries := collection.NewSeriesOfTypeWithSize(series.DType(), len(indices))
+		// Start from an empty slice of the source so DateTime and Categorical
+		// columns keep their concrete type.
+		newSeries, err := series.Slice(0, 0)
+		if err != nil {
+			newSeries = collection.NewSeriesOfType(series.DType(), len(indices))
+		}
 
-		for i, idx := range indices {
+		for _, idx := range indices {
 			val, err := series.At(idx)
 			if err != nil {
 				return nil, err
 			}
 			if series.IsNull(idx) {
-				newSeries.SetNull(i)
-			} else {
-				newSeries.Set(i, val)
+				newSeries.AppendNull()
+				continue
+			}
+			if err := newSeries.Append(val); err != nil {
+				return nil, err
 			}
 		}
 		newCols[name] = newSeries
@@ -609,11 +985,11 @@ func (df *DataFrame) Slice(indices []int) (*DataFrame, error) {
 		}
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       newIndex,
-	}, nil
+	}, indices), nil
 }
 
 // DropOptions configures the Drop operation.
@@ -644,12 +1020,19 @@ type DropOptions struct {
 	// "raise" (default): raise an error if any labels are not found.
 	// "ignore": suppress errors for missing labels.
 	Errors string
+
+	// Deep specifies whether the returned DataFrame gets its own copies of
+	// the remaining Series. If false (default), the result is a view that
+	// shares its Series with the original (see View). Ignored when Inplace
+	// is true.
+	Deep bool
 }
 
 // Drop removes specified labels from rows or columns.
 //
 // Remove rows or columns by specifying label names and corresponding axis,
-// or by directly specifying index or column names.
+// or by directly specifying index or column names. Unless opts.Deep is set,
+// the returned DataFrame is a view sharing its Series with df.
 //
 // Parameters:
 //   - opts: DropOptions struct configuring the drop operation
@@ -710,12 +1093,23 @@ func (df *DataFrame) Drop(opts DropOptions) (*DataFrame, error) {
 		if opts.Inplace {
 			return nil, nil
 		}
-		return df.copy(), nil
+		if opts.Deep {
+			return df.Copy(), nil
+		}
+		return df.View(), nil
 	}
 
 	if dropColumns {
-		return df.dropColumns(labelsToDrop, opts.Errors, opts.Inplace)
+		result, err := df.dropColumns(labelsToDrop, opts.Errors, opts.Inplace)
+		if err != nil || result == nil || !opts.Deep {
+			return result, err
+		}
+		for name, series := range result.Columns {
+			result.Columns[name] = cloneSeries(series)
+		}
+		return result, nil
 	}
+	// Dropping rows always builds new Series, so the result never shares data.
 	return df.dropRows(labelsToDrop, opts.Errors, opts.Inplace)
 }
 
@@ -766,11 +1160,11 @@ func (df *DataFrame) dropColumns(columns []string, errors string, inplace bool)
 		}
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: newOrder,
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // dropRows removes rows with the specified index labels from the DataFrame.
@@ -797,13 +1191,20 @@ func (df *DataFrame) dropRows(indexLabels []string, errorsMode string, inplace b
 		}
 	}
 
-	// Find indices of rows to keep
+	// Find indices of rows to keep, carrying along any MultiIndex levels
 	keepIndices := make([]int, 0, len(df.Index))
 	newIndex := make([]string, 0, len(df.Index))
+	var newMulti [][]string
+	if len(df.MultiIndex) == len(df.Index) && df.MultiIndex != nil {
+		newMulti = make([][]string, 0, len(df.Index))
+	}
 	for i, idx := range df.Index {
 		if !dropSet[idx] {
 			keepIndices = append(keepIndices, i)
 			newIndex = append(newIndex, idx)
+			if newMulti != nil {
+				newMulti = append(newMulti, append([]string(nil), df.MultiIndex[i]...))
+			}
 		}
 	}
 
@@ -826,6 +1227,7 @@ func (df *DataFrame) dropRows(indexLabels []string, errorsMode string, inplace b
 		// Replace DataFrame contents
 		df.Columns = newCols
 		df.Index = newIndex
+		df.MultiIndex = newMulti
 		return nil, nil
 	}
 
@@ -833,9 +1235,63 @@ func (df *DataFrame) dropRows(indexLabels []string, errorsMode string, inplace b
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       newIndex,
+		IndexName:   df.IndexName,
+		ColumnsName: df.ColumnsName,
+
+		ColumnHierarchy: copyHierarchy(df.ColumnHierarchy),
+		MultiIndex:      newMulti,
 	}, nil
 }
 
+// View returns a shallow copy of the DataFrame: the column map, column order,
+// index and index metadata are new, but the Series themselves are shared with
+// df. Creating a view is zero-copy with respect to the data and is safe for
+// read-only use; adding, dropping or reordering columns on the view does not
+// affect df, but mutating a shared Series (e.g. with Set or SetNull) is
+// visible through both. Use Copy before mutating values.
+//
+// Select, Drop (of columns) and ILoc().Col/Cols return views by default.
+//
+// This is analogous to df.copy(deep=False) in pandas.
+//
+// Example:
+//
+//	v := df.View()
+//	v.ColumnOrder = v.ColumnOrder[:1] // df is unchanged
+func (df *DataFrame) View() *DataFrame {
+	if df == nil {
+		return nil
+	}
+	return df.copy()
+}
+
+// Copy returns a deep copy of the DataFrame in which every Series is newly
+// allocated, so values can be mutated without affecting df.
+//
+// This is analogous to df.copy() (deep=True) in pandas.
+//
+// Example:
+//
+//	c := df.Copy()
+//	c.Columns["A"].Set(0, 1.5) // df is unchanged
+func (df *DataFrame) Copy() *DataFrame {
+	if df == nil {
+		return nil
+	}
+	out := df.copy()
+	for name, series := range out.Columns {
+		out.Columns[name] = cloneSeries(series)
+	}
+	return out
+}
+
+// cloneSeries returns a copy of series that shares no data with it.
+func cloneSeries(series collection.Series) collection.Series {
+	// Slicing the full range cannot fail and always copies the data and mask.
+	clone, _ := series.Slice(0, series.Len())
+	return clone
+}
+
 // copy creates a shallow copy of the DataFrame.
 func (df *DataFrame) copy() *DataFrame {
 	df.RLock()
@@ -850,7 +1306,47 @@ func (df *DataFrame) copy() *DataFrame {
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
+		IndexName:   df.IndexName,
+		ColumnsName: df.ColumnsName,
+
+		ColumnHierarchy: copyHierarchy(df.ColumnHierarchy),
+		MultiIndex:      copyMultiIndex(df.MultiIndex),
+	}
+}
+
+// withAxisMeta sets the axis metadata of out, a DataFrame built from the rows
+// of df at positions rows, and returns out. A nil rows means out has all of
+// df's rows in their original order. IndexName and ColumnsName are carried
+// over, as are the MultiIndex levels of the selected rows; the levels are
+// dropped if a position is out of range. Must be called with df's lock held.
+func (df *DataFrame) withAxisMeta(out *DataFrame, rows []int) *DataFrame {
+	out.IndexName = df.IndexName
+	out.ColumnsName = df.ColumnsName
+	if df.MultiIndex == nil || len(df.MultiIndex) != len(df.Index) {
+		return out
+	}
+	if rows == nil {
+		out.MultiIndex = copyMultiIndex(df.MultiIndex)
+		return out
+	}
+	multi := make([][]string, len(rows))
+	for i, r := range rows {
+		if r < 0 || r >= len(df.MultiIndex) {
+			return out
+		}
+		multi[i] = append([]string(nil), df.MultiIndex[r]...)
+	}
+	out.MultiIndex = multi
+	return out
+}
+
+// rowRange returns the positions start, start+1, ..., end-1.
+func rowRange(start, end int) []int {
+	rows := make([]int, 0, max(end-start, 0))
+	for i := start; i < end; i++ {
+		rows = append(rows, i)
 	}
+	return rows
 }
 
 // PlotBar creates a bar chart from DataFrame columns.
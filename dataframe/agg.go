@@ -19,6 +19,8 @@ const (
 	AggFirst AggFunc = "first"
 	// AggLast returns the last non-null value in the group.
 	AggLast AggFunc = "last"
+	// AggNUnique counts the distinct non-null values in the group.
+	AggNUnique AggFunc = "nunique"
 )
 
 // Agg applies one or more aggregation functions to one or more columns of each
@@ -30,9 +32,10 @@ const (
 // correspond to the groups, ordered by group key.
 //
 // Supported functions: AggSum, AggMean, AggCount, AggMin, AggMax, AggStd,
-// AggMedian, AggFirst, AggLast. Numeric functions ignore null and non-numeric
-// values; AggCount counts non-null values; AggFirst/AggLast return the
-// first/last non-null value of any type.
+// AggMedian, AggFirst, AggLast, AggNUnique. Numeric functions ignore null and
+// non-numeric values; AggCount counts non-null values and AggNUnique distinct
+// non-null values; AggFirst/AggLast return the first/last non-null value of
+// any type.
 //
 // This is analogous to df.groupby(...).agg({...}) in pandas.
 //
@@ -64,19 +67,9 @@ func (gb *GroupBy) Agg(spec map[string][]AggFunc) (*DataFrame, error) {
 	resultCols := make(map[string]collection.Series, len(gb.colNames)+len(spec))
 	resultOrder := make([]string, 0, len(gb.colNames)+len(spec))
 
-	// Grouping columns first (preserve original value types).
+	// Grouping columns first (preserve original series types).
 	for _, colName := range gb.colNames {
-		values := make([]any, numGroups)
-		for i, key := range sortedKeys {
-			firstIdx := gb.groups[key][0]
-			v, _ := gb.df.Columns[colName].At(firstIdx)
-			values[i] = v
-		}
-		s, err := seriesFromAnyValues(values)
-		if err != nil {
-			return nil, fmt.Errorf("Agg: building grouping column '%s': %w", colName, err)
-		}
-		resultCols[colName] = s
+		resultCols[colName] = gb.keyColumn(colName, sortedKeys)
 		resultOrder = append(resultOrder, colName)
 	}
 
@@ -119,6 +112,98 @@ func (gb *GroupBy) Agg(spec map[string][]AggFunc) (*DataFrame, error) {
 	}, nil
 }
 
+// GroupByAgg groups the DataFrame by the given columns and applies the
+// aggregations in aggs in a single call. It is equivalent to calling GroupBy
+// with axis 0 followed by Agg, without exposing the intermediate GroupBy.
+//
+// Example:
+//
+//	result, err := df.GroupByAgg([]string{"Department"}, map[string][]dataframe.AggFunc{
+//	    "Salary": {dataframe.AggMean, dataframe.AggMax},
+//	})
+func (df *DataFrame) GroupByAgg(by []string, aggs map[string][]AggFunc) (*DataFrame, error) {
+	if df == nil {
+		return nil, fmt.Errorf("GroupByAgg: DataFrame is nil")
+	}
+	gb, err := df.GroupBy(by, 0)
+	if err != nil {
+		return nil, fmt.Errorf("GroupByAgg: %w", err)
+	}
+	return gb.Agg(aggs)
+}
+
+// Aggregate applies one aggregation function to each column named in aggs,
+// optionally grouping by the given columns first. Each aggregated column keeps
+// its name and columns appear in the DataFrame's column order.
+//
+// When groupby is empty the whole DataFrame is treated as a single group and
+// the result has one row; otherwise the result holds the grouping columns
+// followed by the aggregated columns, one row per group as in GroupBy.Agg.
+// The same functions as GroupBy.Agg are supported. A column cannot be both
+// aggregated and used for grouping.
+//
+// This is analogous to df.agg({...}) and df.groupby(...).agg({...}) in pandas.
+//
+// Example:
+//
+//	totals, err := df.Aggregate(map[string]dataframe.AggFunc{
+//	    "Salary": dataframe.AggSum,
+//	    "Age":    dataframe.AggMean,
+//	}, nil)
+func (df *DataFrame) Aggregate(aggs map[string]AggFunc, groupby []string) (*DataFrame, error) {
+	if df == nil {
+		return nil, fmt.Errorf("Aggregate: DataFrame is nil")
+	}
+	if len(aggs) == 0 {
+		return nil, fmt.Errorf("Aggregate: aggs must contain at least one column")
+	}
+	spec := make(map[string][]AggFunc, len(aggs))
+	for col, fn := range aggs {
+		if containsColumn(groupby, col) {
+			return nil, fmt.Errorf("Aggregate: column '%s' is used for grouping", col)
+		}
+		spec[col] = []AggFunc{fn}
+	}
+
+	var gb *GroupBy
+	if len(groupby) == 0 {
+		all := make([]int, df.Len())
+		for i := range all {
+			all[i] = i
+		}
+		gb = &GroupBy{df: df, groups: map[string][]int{"": all}}
+	} else {
+		var err error
+		gb, err = df.GroupBy(groupby, 0)
+		if err != nil {
+			return nil, fmt.Errorf("Aggregate: %w", err)
+		}
+	}
+
+	result, err := gb.Agg(spec)
+	if err != nil {
+		return nil, fmt.Errorf("Aggregate: %w", err)
+	}
+
+	// Agg names its outputs "<column>_<func>" in column order; with one
+	// function per column the plain column name is unambiguous.
+	columns := make(map[string]collection.Series, len(result.ColumnOrder))
+	order := make([]string, 0, len(result.ColumnOrder))
+	for _, name := range groupby {
+		columns[name] = result.Columns[name]
+		order = append(order, name)
+	}
+	for _, name := range df.ColumnOrder {
+		if fn, ok := aggs[name]; ok {
+			columns[name] = result.Columns[fmt.Sprintf("%s_%s", name, fn)]
+			order = append(order, name)
+		}
+	}
+	result.Columns = columns
+	result.ColumnOrder = order
+	return result, nil
+}
+
 // aggregateGroup applies a single aggregation function to the given row indices
 // of a series and returns the scalar result.
 func aggregateGroup(series collection.Series, indices []int, fn AggFunc) (any, error) {
@@ -132,6 +217,9 @@ func aggregateGroup(series collection.Series, indices []int, fn AggFunc) (any, e
 		}
 		return count, nil
 
+	case AggNUnique:
+		return int64(collection.NUnique(groupSeries(series, indices), true)), nil
+
 	case AggFirst:
 		for _, idx := range indices {
 			if !series.IsNull(idx) {
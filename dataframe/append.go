@@ -0,0 +1,362 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+var (
+	float64Type = reflect.TypeOf(float64(0))
+	int64Type   = reflect.TypeOf(int64(0))
+	anyType     = reflect.TypeOf((*any)(nil)).Elem()
+)
+
+// AppendDataFrame appends the rows of other to the DataFrame in place,
+// growing each column's Series with Append calls instead of allocating a new
+// DataFrame. The index labels of other are appended to df.Index.
+//
+// Both DataFrames must have identical ColumnOrder (an empty DataFrame without
+// columns adopts the columns of other). Column types are reconciled as
+// follows:
+//   - identical types are appended directly
+//   - Int64 and Float64 columns are combined as Float64
+//   - an AnySeries column in df whose values all match the type of other's
+//     column is upcast to that more specific type
+//   - an AnySeries column in other is accepted if its values fit df's column
+//     type; otherwise df's column is widened to AnySeries
+//
+// Any other combination is an error, and the DataFrame is left unchanged.
+//
+// This is analogous to pd.concat([df, other]) in pandas, but modifies df in
+// place, which avoids repeated allocation when building a DataFrame in a loop.
+//
+// Example:
+//
+//	for _, batch := range batches {
+//	    if err := result.AppendDataFrame(batch); err != nil {
+//	        return err
+//	    }
+//	}
+func (df *DataFrame) AppendDataFrame(other *DataFrame) error {
+	if df == nil {
+		return errors.New("AppendDataFrame: DataFrame is nil")
+	}
+	if other == nil {
+		return errors.New("AppendDataFrame: other DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+	if other != df {
+		other.RLock()
+		defer other.RUnlock()
+	}
+
+	otherRows := 0
+	if len(other.ColumnOrder) > 0 {
+		otherRows = other.Columns[other.ColumnOrder[0]].Len()
+	}
+	otherIndex := make([]string, otherRows)
+	for i := range otherIndex {
+		otherIndex[i] = other.indexLabel(i)
+	}
+
+	// An empty DataFrame adopts the columns of other.
+	if len(df.ColumnOrder) == 0 {
+		cols := make(map[string]collection.Series, len(other.ColumnOrder))
+		for _, name := range other.ColumnOrder {
+			src := other.Columns[name]
+			copied, err := src.Slice(0, src.Len())
+			if err != nil {
+				return fmt.Errorf("AppendDataFrame: column '%s': %w", name, err)
+			}
+			cols[name] = copied
+		}
+		df.Columns = cols
+		df.ColumnOrder = append([]string(nil), other.ColumnOrder...)
+		df.Index = otherIndex
+		return nil
+	}
+
+	if len(df.ColumnOrder) != len(other.ColumnOrder) {
+		return fmt.Errorf("AppendDataFrame: column count mismatch: %d vs %d", len(df.ColumnOrder), len(other.ColumnOrder))
+	}
+	for i, name := range df.ColumnOrder {
+		if other.ColumnOrder[i] != name {
+			return fmt.Errorf("AppendDataFrame: column order mismatch at position %d: '%s' vs '%s'", i, name, other.ColumnOrder[i])
+		}
+	}
+
+	// First pass: resolve the target type of every column and build any
+	// upcast replacements, so that a type error leaves df untouched.
+	rowCount := df.Columns[df.ColumnOrder[0]].Len()
+	targets := make(map[string]collection.Series, len(df.ColumnOrder))
+	for _, name := range df.ColumnOrder {
+		dst := df.Columns[name]
+		src := other.Columns[name]
+		target, err := resolveAppendTarget(dst, src)
+		if err != nil {
+			return fmt.Errorf("AppendDataFrame: column '%s': %w", name, err)
+		}
+		targets[name] = target
+	}
+
+	// Second pass: append values. Source values are guaranteed to fit.
+	for _, name := range df.ColumnOrder {
+		src := other.Columns[name]
+		dst := targets[name]
+		n := src.Len()
+		for i := 0; i < n; i++ {
+			if src.IsNull(i) {
+				dst.AppendNull()
+				continue
+			}
+			val, err := src.At(i)
+			if err != nil {
+				return fmt.Errorf("AppendDataFrame: column '%s': %w", name, err)
+			}
+			if err := dst.Append(coerceToType(val, dst.DType())); err != nil {
+				return fmt.Errorf("AppendDataFrame: column '%s': %w", name, err)
+			}
+		}
+		df.Columns[name] = dst
+	}
+
+	if len(df.Index) != rowCount {
+		df.ensureIndex(rowCount)
+	}
+	df.Index = append(df.Index, otherIndex...)
+	return nil
+}
+
+// AppendAll returns a new DataFrame holding the rows of df followed by the
+// rows of each of others, as Concat along the rows with the default outer
+// join: the result has the union of the columns, in order of first
+// appearance, and rows from a frame lacking a column get nulls there. nil
+// entries in others are skipped and index labels are kept.
+//
+// A column shared by several frames must have compatible types: identical
+// types keep that type, Int64 and Float64 are combined as Float64, and a
+// column that is an AnySeries in any frame stays an AnySeries. Any other
+// combination is an error.
+//
+// This is analogous to pd.concat([df, *others]) in pandas. Unlike
+// AppendDataFrame, it leaves df unchanged and does not require the frames
+// to share a column order.
+//
+// Example:
+//
+//	all, err := first.AppendAll(second, third)
+func (df *DataFrame) AppendAll(others ...*DataFrame) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("AppendAll: DataFrame is nil")
+	}
+	frames := make([]*DataFrame, 0, len(others)+1)
+	frames = append(frames, df)
+	for _, other := range others {
+		if other != nil {
+			frames = append(frames, other)
+		}
+	}
+
+	// Resolve the type of every column before concatenating, so that
+	// incompatible frames are rejected up front.
+	types := make(map[string]reflect.Type)
+	for _, frame := range frames {
+		frame.RLock()
+		for _, name := range frame.ColumnOrder {
+			t := frame.Columns[name].DType()
+			prev, seen := types[name]
+			switch {
+			case !seen || prev == t:
+				types[name] = t
+			case prev == anyType || t == anyType:
+				types[name] = anyType
+			case (prev == int64Type && t == float64Type) || (prev == float64Type && t == int64Type):
+				types[name] = float64Type
+			default:
+				frame.RUnlock()
+				return nil, fmt.Errorf("AppendAll: column '%s' has incompatible types %v and %v", name, prev, t)
+			}
+		}
+		frame.RUnlock()
+	}
+
+	if len(frames) == 1 {
+		return df.Copy(), nil
+	}
+	result, err := Concat(frames, DefaultConcatOptions())
+	if err != nil {
+		return nil, fmt.Errorf("AppendAll: %w", err)
+	}
+
+	// Concat builds AnySeries columns; restore the resolved types.
+	for _, name := range result.ColumnOrder {
+		t := types[name]
+		if t == anyType || result.Columns[name].DType() == t {
+			continue
+		}
+		typed, err := upcastSeries(result.Columns[name], t)
+		if err != nil {
+			return nil, fmt.Errorf("AppendAll: column '%s': %w", name, err)
+		}
+		result.Columns[name] = typed
+	}
+	return result, nil
+}
+
+// resolveAppendTarget returns the series that values from src should be
+// appended to: dst itself when the types already agree, or a converted copy
+// of dst when it must be upcast or widened.
+func resolveAppendTarget(dst, src collection.Series) (collection.Series, error) {
+	dt, st := dst.DType(), src.DType()
+	switch {
+	case dt == st:
+		return dst, nil
+	case dt == int64Type && st == float64Type:
+		return upcastSeries(dst, float64Type)
+	case dt == float64Type && st == int64Type:
+		return dst, nil
+	case dt == anyType:
+		if valuesFitType(dst, st) {
+			return upcastSeries(dst, st)
+		}
+		return dst, nil
+	case st == anyType:
+		if valuesFitType(src, dt) {
+			return dst, nil
+		}
+		return upcastSeries(dst, anyType)
+	default:
+		return nil, fmt.Errorf("incompatible types %v and %v", dt, st)
+	}
+}
+
+// valuesFitType reports whether every non-null value of s can be stored in a
+// series of type t.
+func valuesFitType(s collection.Series, t reflect.Type) bool {
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			continue
+		}
+		val, err := s.At(i)
+		if err != nil || val == nil {
+			continue
+		}
+		vt := reflect.TypeOf(val)
+		if vt == t || (t == float64Type && vt == int64Type) {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// upcastSeries returns a new series of type t holding the values of s.
+func upcastSeries(s collection.Series, t reflect.Type) (collection.Series, error) {
+	var out collection.Series
+	if t == anyType {
+		out = collection.NewAnySeries(s.Len())
+	} else {
+		out = collection.NewSeriesOfType(t, s.Len())
+	}
+	if out.DType() != t && t != anyType {
+		// NewSeriesOfType has no dedicated series for t (e.g. time.Time);
+		// fall back to copying the original series via Slice.
+		return s.Slice(0, s.Len())
+	}
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			out.AppendNull()
+			continue
+		}
+		val, err := s.At(i)
+		if err != nil {
+			return nil, err
+		}
+		if err := out.Append(coerceToType(val, t)); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// coerceToType converts int64 values to float64 when the target is a Float64
+// column; all other values are returned unchanged.
+func coerceToType(val any, t reflect.Type) any {
+	if t == float64Type {
+		if iv, ok := val.(int64); ok {
+			return float64(iv)
+		}
+	}
+	return val
+}
+
+// AppendRow appends a single row to the DataFrame in place. Map keys are
+// column names and values are the cell values; use nil for null. Columns
+// missing from the map receive null. The index is extended with the next
+// integer label (the previous row count).
+//
+// An error is returned if a key does not name an existing column or a value
+// cannot be stored in its column; in that case the DataFrame is unchanged.
+//
+// This is analogous to df.loc[len(df)] = row in pandas.
+//
+// Example:
+//
+//	err := df.AppendRow(map[string]any{"Name": "Dana", "Age": int64(41)})
+func (df *DataFrame) AppendRow(row map[string]any) error {
+	if df == nil {
+		return errors.New("AppendRow: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	for name := range row {
+		if _, ok := df.Columns[name]; !ok {
+			return fmt.Errorf("AppendRow: column '%s' not found", name)
+		}
+	}
+
+	// Coerce all values first so a type error leaves the DataFrame unchanged.
+	values := make(map[string]any, len(row))
+	for name, val := range row {
+		if val == nil {
+			continue
+		}
+		series := df.Columns[name]
+		coerced, ok := coerceForSeries(series, val)
+		if dt := series.DType(); ok && dt != nil && dt.Kind() == reflect.Struct {
+			ok = reflect.TypeOf(val) == dt
+		}
+		if !ok {
+			return fmt.Errorf("AppendRow: value %v (%T) is incompatible with column '%s'", val, val, name)
+		}
+		values[name] = coerced
+	}
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+
+	for _, name := range df.ColumnOrder {
+		series := df.Columns[name]
+		val, ok := values[name]
+		if !ok {
+			series.AppendNull()
+			continue
+		}
+		if err := series.Append(val); err != nil {
+			return fmt.Errorf("AppendRow: column '%s': %w", name, err)
+		}
+	}
+
+	df.ensureIndex(rowCount)
+	df.Index = append(df.Index, fmt.Sprintf("%d", rowCount))
+	return nil
+}
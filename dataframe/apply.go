@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
 	"sort"
+	"sync"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
 )
@@ -76,11 +78,80 @@ func (df *DataFrame) Apply(column string, fn func(any) any) (*DataFrame, error)
 	}
 	newCols[column] = newSeries
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
+}
+
+// ApplyInPlace transforms the values of the given column element-wise by fn,
+// writing the results back into the column's existing Series. fn is called
+// only for non-null values, so nulls are preserved; returning nil from fn
+// turns that value into a null.
+//
+// Unlike Apply, the column keeps its type: fn must return values of the
+// column's element type (e.g. float64 for a Float64Series, string for a
+// StringSeries). Every result is checked before anything is written, so on
+// error the column is left unchanged. Because the Series is modified in
+// place, the change is visible through any view sharing it (see View).
+//
+// This is analogous to df["col"] = df["col"].apply(fn) in pandas.
+//
+// Example:
+//
+//	err := df.ApplyInPlace("Name", func(v any) any {
+//	    return strings.ToUpper(v.(string))
+//	})
+func (df *DataFrame) ApplyInPlace(column string, fn func(any) any) error {
+	if df == nil {
+		return errors.New("ApplyInPlace: DataFrame is nil")
+	}
+	if fn == nil {
+		return errors.New("ApplyInPlace: fn must not be nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	series, ok := df.Columns[column]
+	if !ok {
+		return fmt.Errorf("ApplyInPlace: column '%s' not found", column)
+	}
+
+	dtype := series.DType()
+	rowCount := series.Len()
+	results := make([]any, rowCount)
+	for i := 0; i < rowCount; i++ {
+		if series.IsNull(i) {
+			continue
+		}
+		v, err := series.At(i)
+		if err != nil {
+			return fmt.Errorf("ApplyInPlace: error reading row %d: %w", i, err)
+		}
+		out := fn(v)
+		if out != nil && dtype != nil && dtype.Kind() != reflect.Interface && reflect.TypeOf(out) != dtype {
+			return fmt.Errorf("ApplyInPlace: row %d: fn returned %T, column '%s' holds %s", i, out, column, dtypeName(dtype))
+		}
+		results[i] = out
+	}
+
+	for i, v := range results {
+		if series.IsNull(i) {
+			continue
+		}
+		var err error
+		if v == nil {
+			err = series.SetNull(i)
+		} else {
+			err = series.Set(i, v)
+		}
+		if err != nil {
+			return fmt.Errorf("ApplyInPlace: row %d: %w", i, err)
+		}
+	}
+	return nil
 }
 
 // Map returns a new DataFrame in which values of the given column are replaced
@@ -147,11 +218,11 @@ func (df *DataFrame) Map(column string, mapping map[any]any) (*DataFrame, error)
 	}
 	newCols[column] = newSeries
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // ApplyRow returns a new DataFrame produced by applying fn to every row. The
@@ -264,11 +335,210 @@ func (df *DataFrame) ApplyRow(fn func(map[string]any) map[string]any) (*DataFram
 		}
 	}
 
-	return &DataFrame{
+	df.RLock()
+	defer df.RUnlock()
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: outOrder,
 		Index:       index,
-	}, nil
+	}, nil), nil
+}
+
+// ApplyAxis selects the direction in which ApplyAlong passes data to fn.
+type ApplyAxis int
+
+const (
+	// ColumnWise calls fn once per column with that column's Series.
+	ColumnWise ApplyAxis = iota
+	// RowWise calls fn once per row with the row's values as a Series.
+	RowWise
+)
+
+// ApplyAlong reduces the DataFrame along the given axis with fn and returns
+// the results as a Series. With ColumnWise, fn receives each column's Series
+// in ColumnOrder and the result has one value per column; with RowWise, fn
+// receives each row as an untyped Series of its values in ColumnOrder (nulls
+// preserved) and the result has one value per row. fn must not modify the
+// Series it is given.
+//
+// The result type is inferred from the returned values as in Apply; return
+// nil to produce a null.
+//
+// This is analogous to df.apply(fn, axis=0) and df.apply(fn, axis=1) in
+// pandas when fn reduces to a scalar.
+//
+// Example:
+//
+//	// Count the nulls in every row
+//	nulls, err := df.ApplyAlong(dataframe.RowWise, func(row collection.Series) any {
+//	    return row.NullCount()
+//	})
+func (df *DataFrame) ApplyAlong(axis ApplyAxis, fn func(collection.Series) any) (collection.Series, error) {
+	if df == nil {
+		return nil, errors.New("ApplyAlong: DataFrame is nil")
+	}
+	if fn == nil {
+		return nil, errors.New("ApplyAlong: fn must not be nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	var results []any
+	switch axis {
+	case ColumnWise:
+		results = make([]any, len(df.ColumnOrder))
+		for i, name := range df.ColumnOrder {
+			results[i] = fn(df.Columns[name])
+		}
+	case RowWise:
+		rowCount := 0
+		if len(df.ColumnOrder) > 0 {
+			rowCount = df.Columns[df.ColumnOrder[0]].Len()
+		}
+		results = make([]any, rowCount)
+		for i := 0; i < rowCount; i++ {
+			values := make([]any, len(df.ColumnOrder))
+			mask := make([]bool, len(df.ColumnOrder))
+			for j, name := range df.ColumnOrder {
+				series := df.Columns[name]
+				if series.IsNull(i) {
+					mask[j] = true
+					continue
+				}
+				val, err := series.At(i)
+				if err != nil {
+					return nil, fmt.Errorf("ApplyAlong: error reading column '%s' row %d: %w", name, i, err)
+				}
+				values[j] = val
+			}
+			row, err := collection.NewAnySeriesFromData(values, mask)
+			if err != nil {
+				return nil, fmt.Errorf("ApplyAlong: row %d: %w", i, err)
+			}
+			results[i] = fn(row)
+		}
+	default:
+		return nil, fmt.Errorf("ApplyAlong: invalid axis %d (use ColumnWise or RowWise)", axis)
+	}
+
+	out, err := seriesFromAnyValues(results)
+	if err != nil {
+		return nil, fmt.Errorf("ApplyAlong: failed building result: %w", err)
+	}
+	return out, nil
+}
+
+// ApplyParallel applies fn to every row using multiple goroutines and returns
+// the results as a single Series of type resultType. The function receives the
+// row's values in ColumnOrder (nil for nulls) and returns the result for that
+// row (return nil to produce a null). The row slice is reused between calls on
+// the same goroutine, so fn must not retain it.
+//
+// Rows are split into contiguous chunks across workers goroutines; when
+// workers <= 0, runtime.NumCPU() is used. Results are written into a
+// pre-allocated slice indexed by row number, so the output order always
+// matches the row order regardless of scheduling. fn is called concurrently
+// and must be safe for concurrent use.
+//
+// A nil resultType produces an untyped (any) Series. Results that cannot be
+// stored in a Series of resultType cause an error.
+//
+// This is the parallel counterpart of ApplyRow and is useful for CPU-intensive
+// per-row transformations.
+//
+// Example:
+//
+//	// Compute a score from the first two columns on all cores
+//	scores, err := df.ApplyParallel(func(row []any) any {
+//	    return expensiveScore(row[0].(float64), row[1].(float64))
+//	}, reflect.TypeOf(float64(0)), 0)
+func (df *DataFrame) ApplyParallel(fn func([]any) any, resultType reflect.Type, workers int) (collection.Series, error) {
+	if df == nil {
+		return nil, errors.New("ApplyParallel: DataFrame is nil")
+	}
+	if fn == nil {
+		return nil, errors.New("ApplyParallel: fn must not be nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > rowCount {
+		workers = rowCount
+	}
+
+	columns := make([]collection.Series, len(df.ColumnOrder))
+	for i, name := range df.ColumnOrder {
+		columns[i] = df.Columns[name]
+	}
+
+	results := make([]any, rowCount)
+	errs := make([]error, workers)
+	chunk := 0
+	if workers > 0 {
+		chunk = (rowCount + workers - 1) / workers
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		end := min(start+chunk, rowCount)
+		if start >= end {
+			break
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			row := make([]any, len(columns))
+			for i := start; i < end; i++ {
+				for j, series := range columns {
+					if series.IsNull(i) {
+						row[j] = nil
+						continue
+					}
+					val, err := series.At(i)
+					if err != nil {
+						errs[w] = fmt.Errorf("ApplyParallel: error reading column '%s' row %d: %w", df.ColumnOrder[j], i, err)
+						return
+					}
+					row[j] = val
+				}
+				results[i] = fn(row)
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	out := collection.NewSeriesOfType(resultType, rowCount)
+	for i, val := range results {
+		if val == nil {
+			out.AppendNull()
+			continue
+		}
+		coerced, ok := coerceForSeries(out, val)
+		if !ok {
+			return nil, fmt.Errorf("ApplyParallel: row %d: result %v (%T) is incompatible with type %v", i, val, val, resultType)
+		}
+		if err := out.Append(coerced); err != nil {
+			return nil, fmt.Errorf("ApplyParallel: row %d: %w", i, err)
+		}
+	}
+	return out, nil
 }
 
 // seriesFromAnyValues builds a Series from a slice of values, inferring a typed
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"strconv"
 	"strings"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -61,11 +60,54 @@ func (df *DataFrame) AsType(column string, targetType any) (*DataFrame, error) {
 	}
 	newCols[column] = converted
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
+}
+
+// SetColumnType replaces the named column, in place, with a new Series of the
+// given element type, converting each value with the same rules as AsType.
+// Supported types are float64, float32, int64, int32, string, bool and any.
+// Null values are preserved.
+//
+// By default the conversion is strict: if any value cannot be converted an
+// error is returned and the column is left unchanged. Pass strict=false to turn
+// unconvertible values into nulls instead.
+//
+// This is analogous to df["col"] = pd.to_numeric(df["col"], errors="coerce")
+// in pandas when strict is false.
+//
+// Example:
+//
+//	// "Price" was read from CSV as strings
+//	err := df.SetColumnType("Price", reflect.TypeOf(float64(0)))
+//
+//	// Treat values such as "n/a" as missing
+//	err = df.SetColumnType("Price", reflect.TypeOf(float64(0)), false)
+func (df *DataFrame) SetColumnType(column string, dtype reflect.Type, strict ...bool) error {
+	if df == nil {
+		return errors.New("SetColumnType: DataFrame is nil")
+	}
+	isStrict := true
+	if len(strict) > 0 {
+		isStrict = strict[0]
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	series, ok := df.Columns[column]
+	if !ok {
+		return fmt.Errorf("SetColumnType: column '%s' not found", column)
+	}
+	converted, err := collection.Cast(series, dtype, isStrict)
+	if err != nil {
+		return fmt.Errorf("SetColumnType: column '%s' %w", column, err)
+	}
+	df.Columns[column] = converted
+	return nil
 }
 
 // DTypes returns a map of column name to its data type name (e.g. "float64",
@@ -164,149 +206,29 @@ func resolveTargetKind(targetType any) (reflect.Kind, error) {
 }
 
 // convertSeries builds a new typed series by converting each value of the source
-// series to the target kind. Null values are preserved.
+// series to the target kind using the Series As* conversions. Null values are
+// preserved.
 func convertSeries(series collection.Series, kind reflect.Kind, column string) (collection.Series, error) {
-	n := series.Len()
-	mask := make([]bool, n)
-
+	var (
+		out collection.Series
+		err error
+	)
 	switch kind {
 	case reflect.Float64:
-		data := make([]float64, n)
-		for i := 0; i < n; i++ {
-			if series.IsNull(i) {
-				mask[i] = true
-				continue
-			}
-			val, _ := series.At(i)
-			f, err := convertToFloat(val)
-			if err != nil {
-				return nil, fmt.Errorf("AsType: column '%s' row %d: %w", column, i, err)
-			}
-			data[i] = f
-		}
-		return collection.NewFloat64SeriesFromData(data, mask)
-
+		out, err = collection.AsFloat64(series)
 	case reflect.Int64:
-		data := make([]int64, n)
-		for i := 0; i < n; i++ {
-			if series.IsNull(i) {
-				mask[i] = true
-				continue
-			}
-			val, _ := series.At(i)
-			x, err := convertToInt(val)
-			if err != nil {
-				return nil, fmt.Errorf("AsType: column '%s' row %d: %w", column, i, err)
-			}
-			data[i] = x
-		}
-		return collection.NewInt64SeriesFromData(data, mask)
-
+		out, err = collection.AsInt64(series)
 	case reflect.String:
-		data := make([]string, n)
-		for i := 0; i < n; i++ {
-			if series.IsNull(i) {
-				mask[i] = true
-				continue
-			}
-			val, _ := series.At(i)
-			data[i] = fmt.Sprintf("%v", val)
-		}
-		return collection.NewStringSeriesFromData(data, mask)
-
+		out, err = collection.AsString(series)
 	case reflect.Bool:
-		data := make([]bool, n)
-		for i := 0; i < n; i++ {
-			if series.IsNull(i) {
-				mask[i] = true
-				continue
-			}
-			val, _ := series.At(i)
-			b, err := convertToBool(val)
-			if err != nil {
-				return nil, fmt.Errorf("AsType: column '%s' row %d: %w", column, i, err)
-			}
-			data[i] = b
-		}
-		return collection.NewBoolSeriesFromData(data, mask)
-
+		out, err = collection.AsBool(series)
 	default:
 		return nil, fmt.Errorf("AsType: unsupported target kind %v", kind)
 	}
-}
-
-// convertToFloat converts a value to float64.
-func convertToFloat(val any) (float64, error) {
-	switch v := val.(type) {
-	case string:
-		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
-		if err != nil {
-			return 0, fmt.Errorf("cannot convert %q to float64", v)
-		}
-		return f, nil
-	case bool:
-		if v {
-			return 1, nil
-		}
-		return 0, nil
-	default:
-		if f, ok := toFloat64(val); ok {
-			return f, nil
-		}
-		return 0, fmt.Errorf("cannot convert %T to float64", val)
-	}
-}
-
-// convertToInt converts a value to int64 (floats are truncated).
-func convertToInt(val any) (int64, error) {
-	switch v := val.(type) {
-	case string:
-		s := strings.TrimSpace(v)
-		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
-			return n, nil
-		}
-		// Allow integral floats like "3.0".
-		if f, err := strconv.ParseFloat(s, 64); err == nil {
-			return int64(f), nil
-		}
-		return 0, fmt.Errorf("cannot convert %q to int64", v)
-	case bool:
-		if v {
-			return 1, nil
-		}
-		return 0, nil
-	case float64, float32:
-		f, _ := toFloat64(val)
-		return int64(f), nil
-	default:
-		switch val.(type) {
-		case int, int64, int32, int16, int8:
-			return toInt64(val), nil
-		}
-		return 0, fmt.Errorf("cannot convert %T to int64", val)
-	}
-}
-
-// convertToBool converts a value to bool.
-func convertToBool(val any) (bool, error) {
-	switch v := val.(type) {
-	case bool:
-		return v, nil
-	case string:
-		switch strings.ToLower(strings.TrimSpace(v)) {
-		case "true", "1", "t", "yes", "y":
-			return true, nil
-		case "false", "0", "f", "no", "n":
-			return false, nil
-		default:
-			return false, fmt.Errorf("cannot convert %q to bool", v)
-		}
-	default:
-		if f, ok := toFloat64(val); ok {
-			return f != 0, nil
-		}
-		return false, fmt.Errorf("cannot convert %T to bool", val)
+	if err != nil {
+		return nil, fmt.Errorf("AsType: column '%s' %w", column, err)
 	}
+	return out, nil
 }
 
 // dtypeName returns a friendly name for a series dtype.
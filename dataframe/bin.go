@@ -0,0 +1,139 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// Bin discretizes a numeric column into bins equal-width intervals spanning
+// its non-null values and returns a new DataFrame with a StringSeries column
+// outputColumn (default "<column>_bin") holding the label of each row's
+// interval. The original DataFrame is not modified; an existing column named
+// outputColumn is replaced, as with WithColumn.
+//
+// Intervals are closed on the right, "(left, right]". As in pandas, the
+// lowest edge is moved down by 0.1% of the range so that the minimum falls
+// into the first interval; if all values are equal, the range is widened by
+// 0.1% on each side. When labels is nil, each row gets its interval written
+// as "(left, right]" with edges rounded to three decimals; otherwise labels
+// must hold exactly bins names, one per interval in ascending order. Null
+// and NaN values get a null label.
+//
+// This is a simpler form of pd.cut(df[column], bins=bins, labels=labels) in
+// pandas that assigns the result as a new column.
+//
+// Example:
+//
+//	binned, err := df.Bin("Age", 3, []string{"young", "middle", "senior"}, "AgeGroup")
+func (df *DataFrame) Bin(column string, bins int, labels []string, outputColumn string) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("Bin: DataFrame is nil")
+	}
+	if bins < 1 {
+		return nil, fmt.Errorf("Bin: bins must be >= 1, got %d", bins)
+	}
+	if labels != nil && len(labels) != bins {
+		return nil, fmt.Errorf("Bin: expected %d labels, got %d", bins, len(labels))
+	}
+	if outputColumn == "" {
+		outputColumn = column + "_bin"
+	}
+
+	df.RLock()
+	series, ok := df.Columns[column]
+	if !ok {
+		df.RUnlock()
+		return nil, fmt.Errorf("Bin: column '%s' not found", column)
+	}
+	if !isNumericSeries(series) {
+		df.RUnlock()
+		return nil, fmt.Errorf("Bin: column '%s' is not numeric", column)
+	}
+	n := series.Len()
+	values := make([]float64, n)
+	valid := make([]bool, n)
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for i := 0; i < n; i++ {
+		if series.IsNull(i) {
+			continue
+		}
+		v, _ := series.At(i)
+		f, ok := toFloat64(v)
+		if !ok || math.IsNaN(f) {
+			continue
+		}
+		values[i], valid[i] = f, true
+		lo, hi = math.Min(lo, f), math.Max(hi, f)
+	}
+	df.RUnlock()
+
+	if math.IsInf(lo, 1) {
+		return nil, fmt.Errorf("Bin: column '%s' has no non-null values", column)
+	}
+	if math.IsInf(lo, 0) || math.IsInf(hi, 0) {
+		return nil, fmt.Errorf("Bin: column '%s' has infinite values", column)
+	}
+
+	edges := binEdges(lo, hi, bins)
+	if labels == nil {
+		labels = make([]string, bins)
+		for i := range labels {
+			labels[i] = fmt.Sprintf("(%s, %s]", formatBinEdge(edges[i]), formatBinEdge(edges[i+1]))
+		}
+	}
+
+	out := make([]string, n)
+	mask := make([]bool, n)
+	for i, v := range values {
+		if !valid[i] {
+			mask[i] = true
+			continue
+		}
+		// The first interval whose right edge is >= v.
+		b := min(sort.SearchFloat64s(edges[1:], v), bins-1)
+		out[i] = labels[b]
+	}
+	binned, err := collection.NewStringSeriesFromData(out, mask)
+	if err != nil {
+		return nil, fmt.Errorf("Bin: %w", err)
+	}
+
+	result, err := df.WithColumn(outputColumn, binned)
+	if err != nil {
+		return nil, fmt.Errorf("Bin: %w", err)
+	}
+	return result, nil
+}
+
+// binEdges returns the bins+1 edges of bins equal-width intervals spanning
+// [lo, hi], adjusted as described in Bin.
+func binEdges(lo, hi float64, bins int) []float64 {
+	widen := lo == hi
+	if widen {
+		adj := 0.001 * math.Abs(lo)
+		if adj == 0 {
+			adj = 0.001
+		}
+		lo, hi = lo-adj, hi+adj
+	}
+	width := (hi - lo) / float64(bins)
+	edges := make([]float64, bins+1)
+	for i := range edges {
+		edges[i] = lo + float64(i)*width
+	}
+	edges[bins] = hi
+	if !widen {
+		edges[0] -= (hi - lo) * 0.001
+	}
+	return edges
+}
+
+// formatBinEdge formats an interval edge rounded to three decimals.
+func formatBinEdge(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1000)/1000, 'f', -1, 64)
+}
@@ -0,0 +1,107 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// Builder assembles a DataFrame column by column. Constraints (consistent
+// lengths, unique names, index length) are checked once in Build rather than
+// on every AddColumn call.
+//
+// A Builder is safe for concurrent use: AddColumn and SetIndex may be called
+// from multiple goroutines. Columns appear in the order AddColumn was called,
+// so callers adding columns concurrently should not rely on column order.
+//
+// The zero value is ready to use.
+//
+// Example:
+//
+//	df, err := dataframe.NewBuilder().
+//	    AddColumn("Name", names).
+//	    AddColumn("Age", ages).
+//	    SetIndex([]string{"a", "b", "c"}).
+//	    Build()
+type Builder struct {
+	mu     sync.Mutex
+	names  []string
+	series []collection.Series
+	index  []string
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// AddColumn adds a column to the DataFrame being built and returns the
+// Builder for chaining.
+func (b *Builder) AddColumn(name string, s collection.Series) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.names = append(b.names, name)
+	b.series = append(b.series, s)
+	return b
+}
+
+// SetIndex sets the row labels of the DataFrame being built and returns the
+// Builder for chaining. Without it, a default integer index is used.
+func (b *Builder) SetIndex(index []string) *Builder {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.index = append([]string(nil), index...)
+	return b
+}
+
+// Build validates the collected columns and returns the DataFrame. An error
+// is returned if no columns were added, a series is nil, a column name is
+// repeated, column lengths differ, or the index length does not match the
+// number of rows.
+func (b *Builder) Build() (*DataFrame, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.names) == 0 {
+		return nil, errors.New("Build: at least one column is required")
+	}
+
+	rowCount := -1
+	cols := make(map[string]collection.Series, len(b.names))
+	for i, name := range b.names {
+		s := b.series[i]
+		if s == nil {
+			return nil, fmt.Errorf("Build: column '%s' has a nil series", name)
+		}
+		if _, dup := cols[name]; dup {
+			return nil, fmt.Errorf("Build: duplicate column name '%s'", name)
+		}
+		if rowCount == -1 {
+			rowCount = s.Len()
+		} else if s.Len() != rowCount {
+			return nil, fmt.Errorf("Build: inconsistent row count: column '%s' has %d rows, expected %d", name, s.Len(), rowCount)
+		}
+		cols[name] = s
+	}
+
+	var index []string
+	if b.index != nil {
+		if len(b.index) != rowCount {
+			return nil, fmt.Errorf("Build: index length (%d) must match number of rows (%d)", len(b.index), rowCount)
+		}
+		index = append([]string(nil), b.index...)
+	} else {
+		index = make([]string, rowCount)
+		for i := 0; i < rowCount; i++ {
+			index[i] = fmt.Sprintf("%d", i)
+		}
+	}
+
+	return &DataFrame{
+		Columns:     cols,
+		ColumnOrder: append([]string(nil), b.names...),
+		Index:       index,
+	}, nil
+}
@@ -53,11 +53,11 @@ func (df *DataFrame) AsCategorical(column string) (*DataFrame, error) {
 	}
 	newCols[column] = cat
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // Categories returns the distinct categories of a categorical column in code
@@ -34,6 +34,7 @@ func (df *DataFrame) Assign(name string, series collection.Series) error {
 	if err := df.validateNewColumnLen(series.Len()); err != nil {
 		return fmt.Errorf("Assign: %w", err)
 	}
+	df.ensureColumns()
 
 	if _, exists := df.Columns[name]; !exists {
 		df.ColumnOrder = append(df.ColumnOrder, name)
@@ -135,6 +136,7 @@ func (df *DataFrame) Insert(loc int, name string, series collection.Series) erro
 	if err := df.validateNewColumnLen(series.Len()); err != nil {
 		return fmt.Errorf("Insert: %w", err)
 	}
+	df.ensureColumns()
 
 	// Insert into ColumnOrder at loc.
 	newOrder := make([]string, 0, len(df.ColumnOrder)+1)
@@ -148,10 +150,126 @@ func (df *DataFrame) Insert(loc int, name string, series collection.Series) erro
 	return nil
 }
 
+// InsertColumn adds a new column at position pos in the column order. It is
+// an alias for Insert, named to pair with AddColumn.
+//
+// Example:
+//
+//	col, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3}, nil)
+//	err := df.InsertColumn(1, "ID", col)
+func (df *DataFrame) InsertColumn(pos int, name string, series collection.Series) error {
+	return df.Insert(pos, name, series)
+}
+
+// AddColumn appends a new column at the end of the column order. Unlike
+// Assign, it returns an error if a column with the same name already exists.
+// The series length must match the number of rows. The operation modifies the
+// DataFrame in place.
+//
+// This is analogous to df[name] = series in pandas for a new column; use
+// InsertColumn to place the column at a given position.
+//
+// Example:
+//
+//	col, _ := collection.NewFloat64SeriesFromData([]float64{0.3, 0.2, 0.3}, nil)
+//	err := df.AddColumn("TaxRate", col)
+func (df *DataFrame) AddColumn(name string, series collection.Series) error {
+	if df == nil {
+		return errors.New("AddColumn: DataFrame is nil")
+	}
+	if series == nil {
+		return errors.New("AddColumn: series must not be nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	if _, exists := df.Columns[name]; exists {
+		return fmt.Errorf("AddColumn: column '%s' already exists", name)
+	}
+	if err := df.validateNewColumnLen(series.Len()); err != nil {
+		return fmt.Errorf("AddColumn: %w", err)
+	}
+	df.ensureColumns()
+
+	df.ColumnOrder = append(df.ColumnOrder, name)
+	df.Columns[name] = series
+	df.ensureIndex(series.Len())
+	return nil
+}
+
+// SetColumnValues sets a column from raw values, one per row, with nil
+// meaning null. The operation modifies the DataFrame in place.
+//
+// If the column exists it keeps its Series type: each value is coerced to
+// the column's dtype (an int fills a float64 column) and an error is returned
+// if one cannot be without loss, such as 2.7 for an integer column, leaving
+// the column unchanged. The column gets a new Series, so views sharing the
+// old one are not affected. If the column does not exist it is appended with
+// a type inferred from the values, as in AssignFunc.
+//
+// This is analogous to df[name] = values in pandas.
+//
+// Example:
+//
+//	err := df.SetColumnValues("Bonus", []any{100.0, nil, 250.0})
+func (df *DataFrame) SetColumnValues(name string, values []any) error {
+	if df == nil {
+		return errors.New("SetColumnValues: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	if err := df.validateNewColumnLen(len(values)); err != nil {
+		return fmt.Errorf("SetColumnValues: %w", err)
+	}
+	df.ensureColumns()
+
+	existing, exists := df.Columns[name]
+	if !exists {
+		series, err := seriesFromAnyValues(values)
+		if err != nil {
+			return fmt.Errorf("SetColumnValues: failed building column '%s': %w", name, err)
+		}
+		df.ColumnOrder = append(df.ColumnOrder, name)
+		df.Columns[name] = series
+		df.ensureIndex(len(values))
+		return nil
+	}
+
+	// An empty slice of the existing Series keeps its concrete type (e.g.
+	// DateTimeSeries or CategoricalSeries).
+	series, err := existing.Slice(0, 0)
+	if err != nil {
+		return fmt.Errorf("SetColumnValues: column '%s': %w", name, err)
+	}
+	for i, val := range values {
+		if val == nil {
+			series.AppendNull()
+			continue
+		}
+		coerced, ok := coerceForSeries(series, val)
+		if !ok {
+			return fmt.Errorf("SetColumnValues: row %d: value %v (%T) is incompatible with column '%s' (%s)", i, val, val, name, dtypeName(series.DType()))
+		}
+		if err := series.Append(coerced); err != nil {
+			return fmt.Errorf("SetColumnValues: row %d: %w", i, err)
+		}
+	}
+	df.Columns[name] = series
+	return nil
+}
+
 // validateNewColumnLen checks that a new column's length matches the existing
-// row count. When the DataFrame has no columns yet, any length is accepted.
+// row count. When the DataFrame has no columns yet, the length must match the
+// index if one is set (e.g. by gpandas.RangeIndex); otherwise any length is
+// accepted.
 func (df *DataFrame) validateNewColumnLen(length int) error {
 	if len(df.ColumnOrder) == 0 {
+		if len(df.Index) > 0 && length != len(df.Index) {
+			return fmt.Errorf("length mismatch: column has %d rows, index has %d labels", length, len(df.Index))
+		}
 		return nil
 	}
 	rowCount := df.Columns[df.ColumnOrder[0]].Len()
@@ -161,6 +279,14 @@ func (df *DataFrame) validateNewColumnLen(length int) error {
 	return nil
 }
 
+// ensureColumns allocates the columns map of a zero-value DataFrame so a
+// first column can be added to it.
+func (df *DataFrame) ensureColumns() {
+	if df.Columns == nil {
+		df.Columns = make(map[string]collection.Series)
+	}
+}
+
 // ensureIndex creates a default integer index when the current index does not
 // match the row count (e.g. after adding the first column to an empty frame).
 func (df *DataFrame) ensureIndex(rowCount int) {
@@ -172,3 +298,88 @@ func (df *DataFrame) ensureIndex(rowCount int) {
 		df.Index[i] = fmt.Sprintf("%d", i)
 	}
 }
+
+// WithIndex returns a new DataFrame with the given index labels. The original
+// DataFrame is not modified. The columns map is copied shallowly, so the new
+// DataFrame shares its Series with the original.
+//
+// An error is returned if the index length does not match the row count.
+// This is the non-mutating counterpart of SetIndex.
+//
+// Example:
+//
+//	labeled, err := df.WithIndex([]string{"a", "b", "c"})
+func (df *DataFrame) WithIndex(index []string) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("WithIndex: DataFrame is nil")
+	}
+	out := df.copy()
+	if rowCount := out.Len(); len(index) != rowCount {
+		return nil, fmt.Errorf("WithIndex: index length %d does not match row count %d", len(index), rowCount)
+	}
+	out.Index = append([]string(nil), index...)
+	out.MultiIndex = nil
+	return out, nil
+}
+
+// WithColumn returns a new DataFrame with the named column set to series. An
+// existing column is replaced in place in the column order; otherwise the
+// column is appended. The original DataFrame is not modified and shares its
+// other Series with the result.
+//
+// The series length must match the number of rows. This is the non-mutating
+// counterpart of Assign.
+//
+// Example:
+//
+//	out, err := df.WithColumn("Score", scores)
+func (df *DataFrame) WithColumn(name string, series collection.Series) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("WithColumn: DataFrame is nil")
+	}
+	if series == nil {
+		return nil, errors.New("WithColumn: series must not be nil")
+	}
+	out := df.copy()
+	_, exists := out.Columns[name]
+	if !exists || len(out.ColumnOrder) > 1 {
+		if err := out.validateNewColumnLen(series.Len()); err != nil {
+			return nil, fmt.Errorf("WithColumn: %w", err)
+		}
+	}
+	if exists {
+		delete(out.ColumnHierarchy, name)
+	} else {
+		out.ColumnOrder = append(out.ColumnOrder, name)
+	}
+	out.Columns[name] = series
+	out.ensureIndex(series.Len())
+	return out, nil
+}
+
+// WithoutColumn returns a new DataFrame without the named column. The
+// original DataFrame is not modified and shares its remaining Series with the
+// result. An error is returned if the column does not exist.
+//
+// Example:
+//
+//	out, err := df.WithoutColumn("Temp")
+func (df *DataFrame) WithoutColumn(name string) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("WithoutColumn: DataFrame is nil")
+	}
+	out := df.copy()
+	if _, exists := out.Columns[name]; !exists {
+		return nil, fmt.Errorf("WithoutColumn: column '%s' not found", name)
+	}
+	delete(out.Columns, name)
+	delete(out.ColumnHierarchy, name)
+	order := out.ColumnOrder[:0]
+	for _, c := range out.ColumnOrder {
+		if c != name {
+			order = append(order, c)
+		}
+	}
+	out.ColumnOrder = order
+	return out, nil
+}
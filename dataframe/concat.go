@@ -46,6 +46,12 @@ type ConcatOptions struct {
 
 	// Sort if true, sort non-concatenation axis if it is not already aligned. Default: false.
 	Sort bool
+
+	// SortKeys if true, sorts the aligned index labels lexicographically when
+	// concatenating along AxisColumns. When false, labels keep the order of
+	// the first DataFrame's index, followed by labels first seen in later
+	// DataFrames in encounter order. Default: false.
+	SortKeys bool
 }
 
 // DefaultConcatOptions returns the default options for Concat.
@@ -83,7 +89,7 @@ func Concat(objs []*DataFrame, opts ...ConcatOptions) (*DataFrame, error) {
 
 	if len(validDFs) == 1 {
 		// Return a copy of the single DataFrame
-		return copyDataFrame(validDFs[0]), nil
+		return validDFs[0].View(), nil
 	}
 
 	switch options.Axis {
@@ -192,9 +198,20 @@ func concatAlongRows(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error) {
 		df.RUnlock()
 	}
 
-	// Create result series for each column using AnySeries for simplicity
+	// Columns that every DataFrame holds as the same typed series are
+	// concatenated directly; the rest are collected row by row in AnySeries.
 	resultSeries := make(map[string]collection.Series)
+	typedColumns := make(map[string]bool)
 	for _, col := range resultColumns {
+		typed, err := concatTypedColumn(dfs, col)
+		if err != nil {
+			return nil, err
+		}
+		if typed != nil {
+			resultSeries[col] = typed
+			typedColumns[col] = true
+			continue
+		}
 		resultSeries[col] = collection.NewAnySeries(totalRows)
 	}
 
@@ -208,6 +225,9 @@ func concatAlongRows(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error) {
 
 		for r := 0; r < numRows; r++ {
 			for _, col := range resultColumns {
+				if typedColumns[col] {
+					continue
+				}
 				series := df.Columns[col]
 				if series != nil && r < series.Len() {
 					if series.IsNull(r) {
@@ -254,11 +274,64 @@ func concatAlongRows(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error) {
 	}, nil
 }
 
+// concatTypedColumn concatenates column col of every DataFrame with the typed
+// Concat*Series functions when all of them hold it as the same Float64,
+// Int64, String or Bool series covering all their rows. It returns nil when
+// that is not the case.
+func concatTypedColumn(dfs []*DataFrame, col string) (collection.Series, error) {
+	series := make([]collection.Series, len(dfs))
+	for i, df := range dfs {
+		df.RLock()
+		s, ok := df.Columns[col]
+		rows := df.Len()
+		df.RUnlock()
+		if !ok || s == nil || s.Len() != rows {
+			return nil, nil
+		}
+		series[i] = s
+	}
+
+	switch series[0].(type) {
+	case *collection.Float64Series:
+		if typed, ok := sameSeriesType[*collection.Float64Series](series); ok {
+			return collection.ConcatFloat64Series(typed...)
+		}
+	case *collection.Int64Series:
+		if typed, ok := sameSeriesType[*collection.Int64Series](series); ok {
+			return collection.ConcatInt64Series(typed...)
+		}
+	case *collection.StringSeries:
+		if typed, ok := sameSeriesType[*collection.StringSeries](series); ok {
+			return collection.ConcatStringSeries(typed...)
+		}
+	case *collection.BoolSeries:
+		if typed, ok := sameSeriesType[*collection.BoolSeries](series); ok {
+			return collection.ConcatBoolSeries(typed...)
+		}
+	}
+	return nil, nil
+}
+
+// sameSeriesType converts series to a slice of S, reporting whether every
+// element is an S.
+func sameSeriesType[S collection.Series](series []collection.Series) ([]S, bool) {
+	out := make([]S, len(series))
+	for i, s := range series {
+		typed, ok := s.(S)
+		if !ok {
+			return nil, false
+		}
+		out[i] = typed
+	}
+	return out, true
+}
+
 // concatAlongColumns concatenates DataFrames horizontally (joining columns side-by-side).
 func concatAlongColumns(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error) {
 	// For axis=1, we need to align rows based on index
 	// Collect all unique indices
-	allIndices := make(map[string]bool)
+	var allIndices []string // unique labels in encounter order
+	seenIndices := make(map[string]bool)
 	indexSets := make([]map[string]int, len(dfs)) // Map index label to row position
 
 	for i, df := range dfs {
@@ -271,7 +344,10 @@ func concatAlongColumns(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error
 			} else {
 				idx = fmt.Sprintf("%d", r)
 			}
-			allIndices[idx] = true
+			if !seenIndices[idx] {
+				seenIndices[idx] = true
+				allIndices = append(allIndices, idx)
+			}
 			indexSets[i][idx] = r
 		}
 		df.RUnlock()
@@ -280,7 +356,7 @@ func concatAlongColumns(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error
 	var resultIndex []string
 	if opts.Join == JoinInner {
 		// Intersection: indices present in ALL DataFrames
-		for idx := range allIndices {
+		for _, idx := range allIndices {
 			presentInAll := true
 			for _, idxSet := range indexSets {
 				if _, ok := idxSet[idx]; !ok {
@@ -294,9 +370,7 @@ func concatAlongColumns(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error
 		}
 	} else {
 		// Outer join: union of all indices
-		for idx := range allIndices {
-			resultIndex = append(resultIndex, idx)
-		}
+		resultIndex = allIndices
 	}
 
 	if len(resultIndex) == 0 {
@@ -304,7 +378,7 @@ func concatAlongColumns(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error
 	}
 
 	// Sort index if requested
-	if opts.Sort {
+	if opts.Sort || opts.SortKeys {
 		sort.Strings(resultIndex)
 	}
 
@@ -364,30 +438,3 @@ func concatAlongColumns(dfs []*DataFrame, opts ConcatOptions) (*DataFrame, error
 		Index:       finalIndex,
 	}, nil
 }
-
-// copyDataFrame creates a shallow copy of a DataFrame.
-func copyDataFrame(df *DataFrame) *DataFrame {
-	if df == nil {
-		return nil
-	}
-
-	df.RLock()
-	defer df.RUnlock()
-
-	newCols := make(map[string]collection.Series, len(df.Columns))
-	for name, series := range df.Columns {
-		newCols[name] = series // Shallow copy - series are shared
-	}
-
-	newOrder := make([]string, len(df.ColumnOrder))
-	copy(newOrder, df.ColumnOrder)
-
-	newIndex := make([]string, len(df.Index))
-	copy(newIndex, df.Index)
-
-	return &DataFrame{
-		Columns:     newCols,
-		ColumnOrder: newOrder,
-		Index:       newIndex,
-	}
-}
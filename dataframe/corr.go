@@ -2,6 +2,7 @@ package dataframe
 
 import (
 	"errors"
+	"fmt"
 	"math"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -24,6 +25,44 @@ func (df *DataFrame) Corr() (*DataFrame, error) {
 	return df.pairwiseMatrix(true)
 }
 
+// CorrMethod computes a pairwise correlation matrix like Corr, using the
+// given method:
+//   - "pearson": linear correlation, the same as Corr
+//   - "spearman": rank correlation, i.e. the Pearson correlation of the
+//     average ranks (Rank with "average", ascending) of each pair's values
+//
+// As with Corr, each pair of columns only uses the rows where both are
+// non-null; for Spearman the ranks are computed over those rows. A pair with
+// fewer than minPeriods such rows (or fewer than two) yields null.
+//
+// This is analogous to df.corr(method=..., min_periods=...) in pandas.
+//
+// Example:
+//
+//	c, err := df.CorrMethod("spearman", 3)
+func (df *DataFrame) CorrMethod(method string, minPeriods int) (*DataFrame, error) {
+	var stat pairStat
+	switch method {
+	case "pearson":
+		stat = func(ax []float64, an []bool, bx []float64, bn []bool, n int) (float64, bool) {
+			if completeCount(an, bn, n) < minPeriods {
+				return 0, false
+			}
+			return pairwiseStat(ax, an, bx, bn, n, true)
+		}
+	case "spearman":
+		stat = func(ax []float64, an []bool, bx []float64, bn []bool, n int) (float64, bool) {
+			if completeCount(an, bn, n) < minPeriods {
+				return 0, false
+			}
+			return spearmanStat(ax, an, bx, bn, n)
+		}
+	default:
+		return nil, fmt.Errorf("CorrMethod: method must be 'pearson' or 'spearman', got '%s'", method)
+	}
+	return df.pairwiseMatrixFunc(stat)
+}
+
 // Cov computes the pairwise sample covariance matrix (ddof=1) over the numeric
 // columns of the DataFrame, structured like Corr.
 //
@@ -36,9 +75,21 @@ func (df *DataFrame) Cov() (*DataFrame, error) {
 	return df.pairwiseMatrix(false)
 }
 
+// pairStat computes a statistic between two columns given their values and
+// null masks. It returns false when the result is undefined.
+type pairStat func(ax []float64, an []bool, bx []float64, bn []bool, n int) (float64, bool)
+
 // pairwiseMatrix builds a correlation (corr=true) or covariance (corr=false)
 // matrix over numeric columns.
 func (df *DataFrame) pairwiseMatrix(corr bool) (*DataFrame, error) {
+	return df.pairwiseMatrixFunc(func(ax []float64, an []bool, bx []float64, bn []bool, n int) (float64, bool) {
+		return pairwiseStat(ax, an, bx, bn, n, corr)
+	})
+}
+
+// pairwiseMatrixFunc builds a square matrix of stat over all pairs of numeric
+// columns.
+func (df *DataFrame) pairwiseMatrixFunc(stat pairStat) (*DataFrame, error) {
 	if df == nil {
 		return nil, errors.New("pairwise: DataFrame is nil")
 	}
@@ -91,7 +142,7 @@ func (df *DataFrame) pairwiseMatrix(corr bool) (*DataFrame, error) {
 		a := data[colName]
 		for r, rowName := range numericCols {
 			b := data[rowName]
-			val, ok := pairwiseStat(a.vals, a.null, b.vals, b.null, rowCount, corr)
+			val, ok := stat(a.vals, a.null, b.vals, b.null, rowCount)
 			if !ok {
 				mask[r] = true
 			} else {
@@ -156,3 +207,39 @@ func pairwiseStat(ax []float64, an []bool, bx []float64, bn []bool, n int, corr
 	}
 	return cov / (stdA * stdB), true
 }
+
+// completeCount returns the number of rows where neither column is null.
+func completeCount(an, bn []bool, n int) int {
+	count := 0
+	for i := 0; i < n; i++ {
+		if !an[i] && !bn[i] {
+			count++
+		}
+	}
+	return count
+}
+
+// spearmanStat computes the Spearman rank correlation between two columns:
+// the Pearson correlation of the average ranks of their pairwise-complete
+// observations.
+func spearmanStat(ax []float64, an []bool, bx []float64, bn []bool, n int) (float64, bool) {
+	a := make([]float64, 0, n)
+	b := make([]float64, 0, n)
+	for i := 0; i < n; i++ {
+		if an[i] || bn[i] {
+			continue
+		}
+		a = append(a, ax[i])
+		b = append(b, bx[i])
+	}
+	rankA, err := collection.RankFloat64s(a, nil, collection.RankAverage, true)
+	if err != nil {
+		return 0, false
+	}
+	rankB, err := collection.RankFloat64s(b, nil, collection.RankAverage, true)
+	if err != nil {
+		return 0, false
+	}
+	noNulls := make([]bool, len(a))
+	return pairwiseStat(rankA, noNulls, rankB, noNulls, len(a), true)
+}
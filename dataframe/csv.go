@@ -0,0 +1,302 @@
+package dataframe
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// CSVWriteOptions configures WriteCSV and ToCSVWithOptions.
+type CSVWriteOptions struct {
+	// Separator is the field delimiter. The zero value means ','.
+	Separator rune
+	// UseCRLF terminates each line with \r\n instead of \n.
+	UseCRLF bool
+	// QuoteChar encloses quoted fields; a quote inside a quoted field is
+	// doubled. The zero value means '"'.
+	QuoteChar byte
+	// QuoteAll quotes every field, including the header. When false, only
+	// fields containing the separator, the quote character, a newline or a
+	// leading space are quoted.
+	QuoteAll bool
+}
+
+// csvRecordWriter is the subset of csv.Writer used by WriteCSV, so that
+// custom quoting can be swapped in when encoding/csv cannot express it.
+type csvRecordWriter interface {
+	Write(record []string) error
+	Flush()
+	Error() error
+}
+
+// newCSVRecordWriter returns an encoding/csv Writer for the default quoting
+// rules and a quotingCSVWriter for a custom QuoteChar or QuoteAll.
+func newCSVRecordWriter(w io.Writer, opts CSVWriteOptions) (csvRecordWriter, error) {
+	sep := opts.Separator
+	if sep == 0 {
+		sep = ','
+	}
+	quote := opts.QuoteChar
+	if quote == 0 {
+		quote = '"'
+	}
+	if sep == rune(quote) || sep == '\r' || sep == '\n' {
+		return nil, fmt.Errorf("invalid separator %q", sep)
+	}
+	if quote == '\r' || quote == '\n' {
+		return nil, fmt.Errorf("invalid quote character %q", quote)
+	}
+
+	if quote == '"' && !opts.QuoteAll {
+		cw := csv.NewWriter(w)
+		cw.Comma = sep
+		cw.UseCRLF = opts.UseCRLF
+		return cw, nil
+	}
+	return &quotingCSVWriter{
+		w:        bufio.NewWriter(w),
+		sep:      sep,
+		quote:    quote,
+		quoteAll: opts.QuoteAll,
+		useCRLF:  opts.UseCRLF,
+	}, nil
+}
+
+// quotingCSVWriter writes CSV records with a configurable quote character and
+// an option to quote every field, which encoding/csv does not support.
+type quotingCSVWriter struct {
+	w        *bufio.Writer
+	sep      rune
+	quote    byte
+	quoteAll bool
+	useCRLF  bool
+	err      error
+}
+
+func (q *quotingCSVWriter) Write(record []string) error {
+	if q.err != nil {
+		return q.err
+	}
+	quote := string(q.quote)
+	for i, field := range record {
+		if i > 0 {
+			q.w.WriteRune(q.sep)
+		}
+		if !q.quoteAll && !q.needsQuotes(field) {
+			q.w.WriteString(field)
+			continue
+		}
+		q.w.WriteString(quote)
+		q.w.WriteString(strings.ReplaceAll(field, quote, quote+quote))
+		q.w.WriteString(quote)
+	}
+	if q.useCRLF {
+		_, q.err = q.w.WriteString("\r\n")
+	} else {
+		q.err = q.w.WriteByte('\n')
+	}
+	return q.err
+}
+
+// needsQuotes reports whether field must be quoted to be read back intact.
+func (q *quotingCSVWriter) needsQuotes(field string) bool {
+	if field == "" {
+		return false
+	}
+	if strings.ContainsRune(field, q.sep) || strings.IndexByte(field, q.quote) >= 0 || strings.ContainsAny(field, "\r\n") {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(field)
+	return r == ' ' || r == '\t'
+}
+
+func (q *quotingCSVWriter) Flush() {
+	if err := q.w.Flush(); err != nil && q.err == nil {
+		q.err = err
+	}
+}
+
+func (q *quotingCSVWriter) Error() error {
+	return q.err
+}
+
+// plainCSVWriter joins fields with an arbitrary separator string and never
+// quotes them. ToCSV uses it for separators encoding/csv cannot express.
+type plainCSVWriter struct {
+	w   *bufio.Writer
+	sep string
+	err error
+}
+
+func (p *plainCSVWriter) Write(record []string) error {
+	if p.err != nil {
+		return p.err
+	}
+	for i, field := range record {
+		if i > 0 {
+			p.w.WriteString(p.sep)
+		}
+		p.w.WriteString(field)
+	}
+	p.err = p.w.WriteByte('\n')
+	return p.err
+}
+
+func (p *plainCSVWriter) Flush() {
+	if err := p.w.Flush(); err != nil && p.err == nil {
+		p.err = err
+	}
+}
+
+func (p *plainCSVWriter) Error() error {
+	return p.err
+}
+
+// WriteCSV streams the DataFrame as CSV to w, one row at a time, without
+// building the whole output in memory. This makes it suitable for large
+// DataFrames and for writers such as files, HTTP response bodies or gzip
+// compressors.
+//
+// The header row contains the column names in ColumnOrder. As with ToCSV, when
+// IndexName is set the index is written as the first column with IndexName as
+// its header. Null values are written as empty fields. Fields containing the
+// separator, the quote character or newlines are quoted, with internal quotes
+// doubled; set QuoteAll to quote every field.
+//
+// This is analogous to df.to_csv(buf) in pandas.
+//
+// Example:
+//
+//	gz := gzip.NewWriter(f)
+//	defer gz.Close()
+//	err := df.WriteCSV(gz, dataframe.CSVWriteOptions{Separator: ';'})
+func (df *DataFrame) WriteCSV(w io.Writer, opts CSVWriteOptions) error {
+	if df == nil {
+		return errors.New("WriteCSV: DataFrame is nil")
+	}
+	if w == nil {
+		return errors.New("WriteCSV: writer is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	cw, err := newCSVRecordWriter(w, opts)
+	if err != nil {
+		return fmt.Errorf("WriteCSV: %w", err)
+	}
+
+	if err := df.writeCSVRecords(cw); err != nil {
+		return fmt.Errorf("WriteCSV: %w", err)
+	}
+	return nil
+}
+
+// writeCSVRecords writes the header and every row of df to cw and flushes it.
+// The caller must hold df's read lock.
+func (df *DataFrame) writeCSVRecords(cw csvRecordWriter) error {
+	showIndex := df.IndexName != ""
+	width := len(df.ColumnOrder)
+	if showIndex {
+		width++
+	}
+	record := make([]string, 0, width)
+
+	if showIndex {
+		record = append(record, df.IndexName)
+	}
+	record = append(record, df.ColumnOrder...)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+		for _, colName := range df.ColumnOrder[1:] {
+			if s := df.Columns[colName]; s != nil && s.Len() < rowCount {
+				rowCount = s.Len()
+			}
+		}
+	}
+
+	for r := 0; r < rowCount; r++ {
+		record = record[:0]
+		if showIndex {
+			record = append(record, df.indexLabel(r))
+		}
+		for _, colName := range df.ColumnOrder {
+			series := df.Columns[colName]
+			if series.IsNull(r) {
+				record = append(record, "")
+				continue
+			}
+			val, err := series.At(r)
+			if err != nil {
+				return fmt.Errorf("column '%s' row %d: %w", colName, r, err)
+			}
+			record = append(record, fmt.Sprintf("%v", val))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// ToCSVWithOptions is like ToCSV but takes the full set of CSVWriteOptions,
+// including QuoteChar and QuoteAll. If filepath is empty the CSV is returned
+// as a string; otherwise it is written to the file and ("", nil) is returned.
+//
+// This is analogous to df.to_csv(path, sep=..., quotechar=...,
+// quoting=csv.QUOTE_ALL) in pandas.
+//
+// Example:
+//
+//	out, err := df.ToCSVWithOptions("", dataframe.CSVWriteOptions{QuoteAll: true})
+//	// "Name","City"
+//	// "Alice","Paris, FR"
+func (df *DataFrame) ToCSVWithOptions(filepath string, opts CSVWriteOptions) (string, error) {
+	if df == nil {
+		return "", errors.New("DataFrame is nil")
+	}
+
+	var buf bytes.Buffer
+	if err := df.WriteCSV(&buf, opts); err != nil {
+		return "", err
+	}
+	return csvOutput(filepath, &buf)
+}
+
+// toCSVPlain writes df with a separator that cannot be quoted around, such as
+// a multi-character one, joining fields as-is without any quoting.
+func (df *DataFrame) toCSVPlain(filepath, sep string) (string, error) {
+	df.RLock()
+	defer df.RUnlock()
+
+	var buf bytes.Buffer
+	if err := df.writeCSVRecords(&plainCSVWriter{w: bufio.NewWriter(&buf), sep: sep}); err != nil {
+		return "", err
+	}
+	return csvOutput(filepath, &buf)
+}
+
+// csvOutput writes buf to filepath and returns ("", nil), or returns buf as a
+// string when filepath is empty.
+func csvOutput(filepath string, buf *bytes.Buffer) (string, error) {
+	if filepath != "" {
+		if err := os.WriteFile(filepath, buf.Bytes(), 0644); err != nil {
+			return "", fmt.Errorf("failed to write CSV to file: %w", err)
+		}
+		return "", nil
+	}
+	return buf.String(), nil
+}
@@ -67,11 +67,11 @@ func (df *DataFrame) ToDatetime(column string, layout string) (*DataFrame, error
 	}
 	newCols[column] = newSeries
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // Dt returns a datetime accessor for a datetime column, enabling extraction of
@@ -171,6 +171,102 @@ func (df *DataFrame) NullCount() map[string]int {
 	return out
 }
 
+// ColumnStatistics holds summary statistics for a single column, as returned
+// by ColumnStats. Min, Max, Mean and Std are only computed for numeric columns;
+// for other columns, and for statistics that are undefined (e.g. Std with fewer
+// than two values), they are NaN.
+type ColumnStatistics struct {
+	Count     int // number of non-null values
+	NullCount int
+	Min       float64
+	Max       float64
+	Mean      float64
+	Std       float64 // sample standard deviation (ddof=1)
+	Type      reflect.Type
+}
+
+// ColumnStats computes summary statistics for the given columns (all columns
+// when columns is empty), keyed by column name. Numeric columns are summarized
+// in a single pass using Welford's online algorithm for the mean and variance,
+// instead of separate passes for each statistic. Null values are ignored.
+//
+// Example:
+//
+//	stats, err := df.ColumnStats([]string{"Age", "Salary"})
+//	fmt.Println(stats["Age"].Mean, stats["Age"].Std)
+func (df *DataFrame) ColumnStats(columns []string) (map[string]ColumnStatistics, error) {
+	if df == nil {
+		return nil, errors.New("ColumnStats: DataFrame is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	if len(columns) == 0 {
+		columns = df.ColumnOrder
+	}
+	for _, name := range columns {
+		if _, ok := df.Columns[name]; !ok {
+			return nil, fmt.Errorf("ColumnStats: column '%s' not found", name)
+		}
+	}
+
+	out := make(map[string]ColumnStatistics, len(columns))
+	for _, name := range columns {
+		series := df.Columns[name]
+		stats := ColumnStatistics{
+			NullCount: series.NullCount(),
+			Min:       math.NaN(),
+			Max:       math.NaN(),
+			Mean:      math.NaN(),
+			Std:       math.NaN(),
+			Type:      series.DType(),
+		}
+		stats.Count = series.Len() - stats.NullCount
+		if isNumericSeries(series) {
+			welfordStats(series, &stats)
+		}
+		out[name] = stats
+	}
+	return out, nil
+}
+
+// welfordStats fills in Min, Max, Mean and Std for a numeric series in one
+// pass over its non-null values.
+func welfordStats(series collection.Series, stats *ColumnStatistics) {
+	var n int
+	var mean, m2 float64
+	for i := 0; i < series.Len(); i++ {
+		if series.IsNull(i) {
+			continue
+		}
+		val, err := series.At(i)
+		if err != nil {
+			continue
+		}
+		x, ok := toFloat64(val)
+		if !ok {
+			continue
+		}
+		n++
+		if n == 1 {
+			stats.Min, stats.Max = x, x
+		} else {
+			stats.Min = math.Min(stats.Min, x)
+			stats.Max = math.Max(stats.Max, x)
+		}
+		delta := x - mean
+		mean += delta / float64(n)
+		m2 += delta * (x - mean)
+	}
+	if n > 0 {
+		stats.Mean = mean
+	}
+	if n > 1 {
+		stats.Std = math.Sqrt(m2 / float64(n-1))
+	}
+}
+
 // ValueCounts returns a new DataFrame containing the frequency of each unique
 // (non-null) value in the given column. The result has two columns: the original
 // column name (holding the unique values) and "count" (int64 frequencies). Rows
@@ -0,0 +1,93 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ToDict returns the DataFrame as a map of column name to its values, with
+// nil for null values. The slices are copies, so modifying them does not
+// affect the DataFrame.
+//
+// This is analogous to df.to_dict(orient="list") in pandas.
+//
+// Example:
+//
+//	data := df.ToDict()
+//	// data["Age"] == []any{int64(30), nil, int64(25)}
+func (df *DataFrame) ToDict() map[string][]any {
+	if df == nil {
+		return nil
+	}
+	df.RLock()
+	defer df.RUnlock()
+
+	out := make(map[string][]any, len(df.ColumnOrder))
+	for _, name := range df.ColumnOrder {
+		out[name] = df.Columns[name].ValuesCopy()
+	}
+	return out
+}
+
+// ToDictTyped returns the DataFrame split into typed column maps: float
+// columns (float64 or float32) go into floats, integer columns (int64 or
+// int32) into ints, and string columns into strings. Null floats become NaN.
+//
+// An error is returned if any column has another type (e.g. bool or any), or
+// if an integer or string column contains nulls, since those cannot be
+// represented in a plain slice. Use FillNA or AsType first in those cases.
+//
+// Example:
+//
+//	floats, ints, strs, err := df.ToDictTyped()
+func (df *DataFrame) ToDictTyped() (map[string][]float64, map[string][]int64, map[string][]string, error) {
+	if df == nil {
+		return nil, nil, nil, errors.New("ToDictTyped: DataFrame is nil")
+	}
+	df.RLock()
+	defer df.RUnlock()
+
+	floats := make(map[string][]float64)
+	ints := make(map[string][]int64)
+	strs := make(map[string][]string)
+
+	for _, name := range df.ColumnOrder {
+		series := df.Columns[name]
+		values := series.ValuesCopy()
+		switch series.DType().Kind() {
+		case reflect.Float64, reflect.Float32:
+			col := make([]float64, len(values))
+			for i, v := range values {
+				if v == nil {
+					col[i] = math.NaN()
+					continue
+				}
+				col[i], _ = toFloat64(v)
+			}
+			floats[name] = col
+		case reflect.Int64, reflect.Int32:
+			col := make([]int64, len(values))
+			for i, v := range values {
+				if v == nil {
+					return nil, nil, nil, fmt.Errorf("ToDictTyped: column '%s' has a null at row %d", name, i)
+				}
+				col[i] = toInt64(v)
+			}
+			ints[name] = col
+		case reflect.String:
+			col := make([]string, len(values))
+			for i, v := range values {
+				if v == nil {
+					return nil, nil, nil, fmt.Errorf("ToDictTyped: column '%s' has a null at row %d", name, i)
+				}
+				col[i] = v.(string)
+			}
+			strs[name] = col
+		default:
+			return nil, nil, nil, fmt.Errorf("ToDictTyped: column '%s' has unsupported type %s", name, dtypeName(series.DType()))
+		}
+	}
+	return floats, ints, strs, nil
+}
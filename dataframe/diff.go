@@ -0,0 +1,154 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// CellChange describes a single cell whose value differs between two
+// DataFrames compared with Diff.
+type CellChange struct {
+	Index  string // Row label
+	Column string // Column name
+	Old    any    // Value in the receiver (nil if null or the column is absent)
+	New    any    // Value in the other DataFrame (nil if null or the column is absent)
+}
+
+// DiffResult holds the differences between two DataFrames, as returned by
+// Diff.
+type DiffResult struct {
+	Added           *DataFrame   // Rows whose label is only in the other DataFrame
+	Removed         *DataFrame   // Rows whose label is only in the receiver
+	Modified        *DataFrame   // Rows present in both with different values, as in the other DataFrame
+	ModifiedDetails []CellChange // One entry per differing cell, in row then column order
+}
+
+// Diff compares the DataFrame with other and reports the rows that were
+// added, removed, or modified. Rows are matched by index label, then compared
+// cell by cell over the union of both column sets; a column missing from one
+// side compares as null, and a NaN that has not changed is not reported. Both
+// DataFrames must have a unique index.
+//
+// Added and Modified rows are taken from other; Removed rows are taken from
+// the receiver. Each result keeps the original index labels and column order.
+//
+// Example:
+//
+//	res, err := before.Diff(after)
+//	for _, c := range res.ModifiedDetails {
+//	    fmt.Printf("%s.%s: %v -> %v\n", c.Index, c.Column, c.Old, c.New)
+//	}
+func (df *DataFrame) Diff(other *DataFrame) (DiffResult, error) {
+	if df == nil {
+		return DiffResult{}, errors.New("Diff: DataFrame is nil")
+	}
+	if other == nil {
+		return DiffResult{}, errors.New("Diff: other DataFrame is nil")
+	}
+
+	df.RLock()
+	oldPos, err := uniqueIndexPositions(df)
+	df.RUnlock()
+	if err != nil {
+		return DiffResult{}, fmt.Errorf("Diff: %w", err)
+	}
+
+	other.RLock()
+	newPos, err := uniqueIndexPositions(other)
+	other.RUnlock()
+	if err != nil {
+		return DiffResult{}, fmt.Errorf("Diff: other %w", err)
+	}
+
+	columns := append([]string(nil), df.ColumnOrder...)
+	for _, name := range other.ColumnOrder {
+		if _, ok := df.Columns[name]; !ok {
+			columns = append(columns, name)
+		}
+	}
+
+	var removed, added, modified []int
+	var details []CellChange
+	for i, label := range df.Index {
+		if _, ok := newPos[label]; !ok {
+			removed = append(removed, i)
+		}
+	}
+	for j, label := range other.Index {
+		i, ok := oldPos[label]
+		if !ok {
+			added = append(added, j)
+			continue
+		}
+		changed := false
+		for _, name := range columns {
+			oldVal := cellValue(df, name, i)
+			newVal := cellValue(other, name, j)
+			if !valuesEqual(oldVal, newVal) {
+				details = append(details, CellChange{Index: label, Column: name, Old: oldVal, New: newVal})
+				changed = true
+			}
+		}
+		if changed {
+			modified = append(modified, j)
+		}
+	}
+
+	var res DiffResult
+	if res.Added, err = other.Slice(added); err != nil {
+		return DiffResult{}, fmt.Errorf("Diff: %w", err)
+	}
+	if res.Removed, err = df.Slice(removed); err != nil {
+		return DiffResult{}, fmt.Errorf("Diff: %w", err)
+	}
+	if res.Modified, err = other.Slice(modified); err != nil {
+		return DiffResult{}, fmt.Errorf("Diff: %w", err)
+	}
+	res.ModifiedDetails = details
+	return res, nil
+}
+
+// uniqueIndexPositions maps each index label to its row position, returning
+// an error if a label repeats. Caller must hold the read lock.
+func uniqueIndexPositions(df *DataFrame) (map[string]int, error) {
+	positions := make(map[string]int, len(df.Index))
+	for i, label := range df.Index {
+		if _, dup := positions[label]; dup {
+			return nil, fmt.Errorf("index contains duplicate label '%s'", label)
+		}
+		positions[label] = i
+	}
+	return positions, nil
+}
+
+// cellValue returns the value at (column, row), or nil if the column is
+// absent or the value is null.
+func cellValue(df *DataFrame, column string, row int) any {
+	series, ok := df.Columns[column]
+	if !ok || series.IsNull(row) {
+		return nil
+	}
+	val, err := series.At(row)
+	if err != nil {
+		return nil
+	}
+	return val
+}
+
+// valuesEqual reports whether two cell values are equal. Values of different
+// dynamic types are never equal, and NaN equals NaN of the same float type.
+func valuesEqual(a, b any) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if isNaNValue(a) && isNaNValue(b) {
+		return reflect.TypeOf(a) == reflect.TypeOf(b)
+	}
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		return ok && ta.Equal(tb)
+	}
+	return reflect.DeepEqual(a, b)
+}
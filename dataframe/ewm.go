@@ -0,0 +1,89 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// EWMWindow represents an exponentially weighted view over a DataFrame,
+// created by DataFrame.EWM.
+type EWMWindow struct {
+	df         *DataFrame
+	alpha      float64
+	adjust     bool
+	minPeriods int
+}
+
+// EWM creates an exponentially weighted window for computing smoothed
+// statistics. alpha is the smoothing factor (0 < alpha <= 1); adjust selects
+// the weighted-average form over the recursive one, and minPeriods is the
+// number of non-null values required before a result is produced. See
+// Float64Series.EWMMean for the exact formulas.
+//
+// Each aggregation converts the numeric columns to float64; non-numeric
+// columns are carried over unchanged.
+//
+// This is analogous to df.ewm(alpha=alpha, adjust=adjust,
+// min_periods=minPeriods) in pandas.
+//
+// Example:
+//
+//	smoothed, err := df.EWM(0.3, true, 0).Mean()
+func (df *DataFrame) EWM(alpha float64, adjust bool, minPeriods int) *EWMWindow {
+	return &EWMWindow{df: df, alpha: alpha, adjust: adjust, minPeriods: minPeriods}
+}
+
+// Mean computes the exponentially weighted moving average of each numeric
+// column.
+func (ew *EWMWindow) Mean() (*DataFrame, error) {
+	return ew.apply((*collection.Float64Series).EWMMean)
+}
+
+// Var computes the exponentially weighted moving variance of each numeric
+// column, with bias correction.
+func (ew *EWMWindow) Var() (*DataFrame, error) {
+	return ew.apply((*collection.Float64Series).EWMVar)
+}
+
+// Std computes the exponentially weighted moving standard deviation of each
+// numeric column, with bias correction.
+func (ew *EWMWindow) Std() (*DataFrame, error) {
+	return ew.apply((*collection.Float64Series).EWMStd)
+}
+
+// apply runs stat over every numeric column; non-numeric columns are passed
+// through unchanged.
+func (ew *EWMWindow) apply(stat func(*collection.Float64Series, float64, bool, int) (*collection.Float64Series, error)) (*DataFrame, error) {
+	if ew.df == nil {
+		return nil, errors.New("EWM: DataFrame is nil")
+	}
+
+	ew.df.RLock()
+	defer ew.df.RUnlock()
+
+	newCols := make(map[string]collection.Series, len(ew.df.Columns))
+	for _, name := range ew.df.ColumnOrder {
+		series := ew.df.Columns[name]
+		if !isNumericSeries(series) {
+			newCols[name] = series
+			continue
+		}
+		floats, err := collection.AsFloat64(series)
+		if err != nil {
+			return nil, fmt.Errorf("EWM: column '%s': %w", name, err)
+		}
+		result, err := stat(floats, ew.alpha, ew.adjust, ew.minPeriods)
+		if err != nil {
+			return nil, fmt.Errorf("EWM: %w", err)
+		}
+		newCols[name] = result
+	}
+
+	return ew.df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: append([]string(nil), ew.df.ColumnOrder...),
+		Index:       append([]string(nil), ew.df.Index...),
+	}, nil), nil
+}
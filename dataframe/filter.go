@@ -3,6 +3,10 @@ package dataframe
 import (
 	"errors"
 	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
 )
 
 // FilterOp represents a comparison operator used by DataFrame.Filter.
@@ -88,6 +92,90 @@ func (df *DataFrame) Where(predicate func(row map[string]any) bool) *FilterChain
 	return (&FilterChain{df: df}).Where(predicate)
 }
 
+// FilterParallel returns a new DataFrame holding the rows for which the
+// predicate returns true, evaluating the predicate on several goroutines. It
+// is the parallel counterpart of Where: the predicate receives a map of
+// column name to value for the row (null values are passed as nil), kept rows
+// retain their order, index labels and column types, and a predicate that
+// matches no rows yields an empty DataFrame with the same columns.
+//
+// Rows are split into contiguous chunks across workers goroutines; when
+// workers <= 0, runtime.NumCPU() is used. Each goroutine reuses its own row
+// map between calls, so the predicate must not retain it, and the predicate
+// must be safe for concurrent use.
+//
+// Example:
+//
+//	adults, err := df.FilterParallel(func(row map[string]any) bool {
+//	    age, _ := row["Age"].(int64)
+//	    return age >= 18
+//	}, 0)
+func (df *DataFrame) FilterParallel(predicate func(row map[string]any) bool, workers int) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("FilterParallel: DataFrame is nil")
+	}
+	if predicate == nil {
+		return nil, errors.New("FilterParallel: predicate must not be nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	workers = max(min(workers, rowCount), 1)
+	chunk := (rowCount + workers - 1) / workers
+
+	kept := make([][]int, workers)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		end := min(start+chunk, rowCount)
+		if start >= end {
+			break
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			row := make(map[string]any, len(df.ColumnOrder))
+			for i := start; i < end; i++ {
+				for _, colName := range df.ColumnOrder {
+					series := df.Columns[colName]
+					if series.IsNull(i) {
+						row[colName] = nil
+						continue
+					}
+					val, err := series.At(i)
+					if err != nil {
+						errs[w] = fmt.Errorf("FilterParallel: error reading column '%s' row %d: %w", colName, i, err)
+						return
+					}
+					row[colName] = val
+				}
+				if predicate(row) {
+					kept[w] = append(kept[w], i)
+				}
+			}
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	keep := make([]int, 0, rowCount)
+	for w := range kept {
+		if errs[w] != nil {
+			return nil, errs[w]
+		}
+		keep = append(keep, kept[w]...)
+	}
+	return df.sliceLocked(keep)
+}
+
 // Filter applies an additional comparison filter to the chain. If the chain
 // already holds an error, it is returned unchanged.
 func (c *FilterChain) Filter(column string, op FilterOp, value any) *FilterChain {
@@ -135,6 +223,98 @@ func (c *FilterChain) MustResult() *DataFrame {
 	return c.df
 }
 
+// SelectWhere returns a new DataFrame holding only the given columns and only
+// the rows where mask is true. Null mask entries are treated as false. An empty
+// columns slice selects all columns.
+//
+// It is equivalent to filtering by mask and then selecting columns, but reads
+// the mask once and copies only the requested columns, avoiding the
+// intermediate DataFrame with every column filtered.
+//
+// This is analogous to df.loc[mask, columns] in pandas.
+//
+// Example:
+//
+//	mask, _ := collection.NewBoolSeriesFromData([]bool{true, false, true}, nil)
+//	result, err := df.SelectWhere([]string{"Name", "Salary"}, mask)
+func (df *DataFrame) SelectWhere(columns []string, mask collection.Series) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("SelectWhere: DataFrame is nil")
+	}
+	if mask == nil {
+		return nil, errors.New("SelectWhere: mask is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	if len(columns) == 0 {
+		columns = df.ColumnOrder
+	}
+	for _, name := range columns {
+		if _, ok := df.Columns[name]; !ok {
+			return nil, fmt.Errorf("SelectWhere: column '%s' not found", name)
+		}
+	}
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+	if mask.Len() != rowCount {
+		return nil, fmt.Errorf("SelectWhere: mask length %d does not match row count %d", mask.Len(), rowCount)
+	}
+
+	keep := make([]int, 0, rowCount)
+	for i := 0; i < rowCount; i++ {
+		if mask.IsNull(i) {
+			continue
+		}
+		val, err := mask.At(i)
+		if err != nil {
+			return nil, fmt.Errorf("SelectWhere: error reading mask row %d: %w", i, err)
+		}
+		b, ok := val.(bool)
+		if !ok {
+			return nil, fmt.Errorf("SelectWhere: mask value at row %d is %T, not bool", i, val)
+		}
+		if b {
+			keep = append(keep, i)
+		}
+	}
+
+	newCols := make(map[string]collection.Series, len(columns))
+	for _, name := range columns {
+		series := df.Columns[name]
+		newSeries := collection.NewSeriesOfTypeWithSize(series.DType(), len(keep))
+		for i, idx := range keep {
+			if series.IsNull(idx) {
+				newSeries.SetNull(i)
+				continue
+			}
+			val, err := series.At(idx)
+			if err != nil {
+				return nil, fmt.Errorf("SelectWhere: column '%s' row %d: %w", name, idx, err)
+			}
+			if err := newSeries.Set(i, val); err != nil {
+				return nil, fmt.Errorf("SelectWhere: column '%s' row %d: %w", name, idx, err)
+			}
+		}
+		newCols[name] = newSeries
+	}
+
+	newIndex := make([]string, len(keep))
+	for i, idx := range keep {
+		newIndex[i] = df.indexLabel(idx)
+	}
+
+	return df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: append([]string(nil), columns...),
+		Index:       newIndex,
+	}, keep), nil
+}
+
 // filterOnce performs a single comparison filter and returns a new DataFrame.
 func (df *DataFrame) filterOnce(column string, op FilterOp, value any) (*DataFrame, error) {
 	if df == nil {
@@ -149,10 +329,10 @@ func (df *DataFrame) filterOnce(column string, op FilterOp, value any) (*DataFra
 	}
 
 	df.RLock()
+	defer df.RUnlock()
 
 	series, ok := df.Columns[column]
 	if !ok {
-		df.RUnlock()
 		return nil, fmt.Errorf("Filter: column '%s' not found", column)
 	}
 
@@ -165,13 +345,11 @@ func (df *DataFrame) filterOnce(column string, op FilterOp, value any) (*DataFra
 		}
 		val, err := series.At(i)
 		if err != nil {
-			df.RUnlock()
 			return nil, fmt.Errorf("Filter: error reading row %d: %w", i, err)
 		}
 
 		cmp, err := compareForFilter(val, value)
 		if err != nil {
-			df.RUnlock()
 			return nil, fmt.Errorf("Filter: %w", err)
 		}
 
@@ -180,9 +358,7 @@ func (df *DataFrame) filterOnce(column string, op FilterOp, value any) (*DataFra
 		}
 	}
 
-	df.RUnlock()
-
-	return df.Slice(keep)
+	return df.sliceLocked(keep)
 }
 
 // whereOnce performs a single predicate filter and returns a new DataFrame.
@@ -195,6 +371,7 @@ func (df *DataFrame) whereOnce(predicate func(row map[string]any) bool) (*DataFr
 	}
 
 	df.RLock()
+	defer df.RUnlock()
 
 	rowCount := 0
 	if len(df.ColumnOrder) > 0 {
@@ -213,7 +390,6 @@ func (df *DataFrame) whereOnce(predicate func(row map[string]any) bool) (*DataFr
 			}
 			val, err := series.At(i)
 			if err != nil {
-				df.RUnlock()
 				return nil, fmt.Errorf("Where: error reading column '%s' row %d: %w", colName, i, err)
 			}
 			row[colName] = val
@@ -223,9 +399,7 @@ func (df *DataFrame) whereOnce(predicate func(row map[string]any) bool) (*DataFr
 		}
 	}
 
-	df.RUnlock()
-
-	return df.Slice(keep)
+	return df.sliceLocked(keep)
 }
 
 // compareForFilter compares two non-nil values, treating all numeric kinds
@@ -1,6 +1,7 @@
 package dataframe
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -65,6 +66,75 @@ func (df *DataFrame) GroupBy(by []string, axis int) (*GroupBy, error) {
 	}, nil
 }
 
+// GroupByFunc groups the rows of the DataFrame by a key computed from each
+// row, rather than by column values. keyFn is called once per row with the row
+// position and the DataFrame, and returns that row's group key; an error from
+// keyFn aborts the grouping. This allows grouping by computed expressions such
+// as time buckets, value ranges or conditions spanning several columns.
+//
+// The key is stored in a grouping column named "group", which becomes the
+// first column of any aggregation. An error is returned if the DataFrame
+// already has a column with that name.
+//
+// This is analogous to df.groupby(lambda i: ...) in pandas.
+//
+// Example:
+//
+//	gb, err := df.GroupByFunc(func(i int, df *dataframe.DataFrame) (string, error) {
+//	    v, err := df.ILoc().At(i, 1)
+//	    if err != nil {
+//	        return "", err
+//	    }
+//	    if v.(float64) >= 100 {
+//	        return "large", nil
+//	    }
+//	    return "small", nil
+//	}, 0)
+//	sums, err := gb.Sum()
+func (df *DataFrame) GroupByFunc(keyFn func(rowIndex int, df *DataFrame) (string, error), axis int) (*GroupBy, error) {
+	const keyCol = "group"
+	if df == nil {
+		return nil, errors.New("GroupByFunc: DataFrame is nil")
+	}
+	if keyFn == nil {
+		return nil, errors.New("GroupByFunc: key function must not be nil")
+	}
+	if axis != 0 {
+		return nil, fmt.Errorf("GroupByFunc: axis %d is not supported yet, only axis 0 (rows) is supported", axis)
+	}
+
+	src := df.copy()
+	if _, exists := src.Columns[keyCol]; exists {
+		return nil, fmt.Errorf("GroupByFunc: column '%s' already exists", keyCol)
+	}
+
+	numRows := src.Len()
+	keys := make([]string, numRows)
+	groups := make(map[string][]int)
+	for i := 0; i < numRows; i++ {
+		key, err := keyFn(i, df)
+		if err != nil {
+			return nil, fmt.Errorf("GroupByFunc: row %d: %w", i, err)
+		}
+		keys[i] = key
+		groups[key] = append(groups[key], i)
+	}
+
+	keySeries, err := collection.NewStringSeriesFromData(keys, nil)
+	if err != nil {
+		return nil, fmt.Errorf("GroupByFunc: %w", err)
+	}
+	src.Columns[keyCol] = keySeries
+	src.ColumnOrder = append([]string{keyCol}, src.ColumnOrder...)
+
+	return &GroupBy{
+		df:       src,
+		groups:   groups,
+		axis:     axis,
+		colNames: []string{keyCol},
+	}, nil
+}
+
 // getSortedKeys returns the group keys sorted to ensure deterministic output order.
 func (gb *GroupBy) getSortedKeys() []string {
 	keys := make([]string, 0, len(gb.groups))
@@ -77,6 +147,41 @@ func (gb *GroupBy) getSortedKeys() []string {
 
 // aggregate applies a function to each column of each group.
 func (gb *GroupBy) aggregate(aggFunc func(collection.Series) (any, error)) (*DataFrame, error) {
+	return gb.aggregateRows(func(originalSeries collection.Series, indices []int) (any, error) {
+		return aggFunc(groupSeries(originalSeries, indices))
+	})
+}
+
+// groupSeries extracts the rows at indices of series into a new Series of the
+// same concrete type, so DateTime and Categorical columns stay typed.
+func groupSeries(series collection.Series, indices []int) collection.Series {
+	out, err := series.Slice(0, 0)
+	if err != nil {
+		out = collection.NewSeriesOfType(series.DType(), len(indices))
+	}
+	for _, idx := range indices {
+		val, err := series.At(idx)
+		if err != nil || series.IsNull(idx) || out.Append(val) != nil {
+			out.AppendNull()
+		}
+	}
+	return out
+}
+
+// keyColumn returns the values of the grouping column colName for each group
+// in sortedKeys, taken from the group's first row and typed like the source.
+func (gb *GroupBy) keyColumn(colName string, sortedKeys []string) collection.Series {
+	firstRows := make([]int, len(sortedKeys))
+	for i, key := range sortedKeys {
+		firstRows[i] = gb.groups[key][0]
+	}
+	return groupSeries(gb.df.Columns[colName], firstRows)
+}
+
+// aggregateRows applies a function to each column of each group. The function
+// receives the full column and the row positions of the group, so it can
+// compute its result in a single pass without copying the group's values.
+func (gb *GroupBy) aggregateRows(aggFunc func(s collection.Series, indices []int) (any, error)) (*DataFrame, error) {
 	sortedKeys := gb.getSortedKeys()
 	numGroups := len(sortedKeys)
 
@@ -88,9 +193,9 @@ func (gb *GroupBy) aggregate(aggFunc func(collection.Series) (any, error)) (*Dat
 	resultCols := make(map[string]collection.Series)
 	resultOrder := make([]string, 0)
 
-	// Add grouping columns first
+	// Add grouping columns first, keeping the type of each key column
 	for _, colName := range gb.colNames {
-		resultCols[colName], _ = collection.NewStringSeriesFromData(make([]string, numGroups), nil) // Using StringSeries for keys for now
+		resultCols[colName] = gb.keyColumn(colName, sortedKeys)
 		resultOrder = append(resultOrder, colName)
 	}
 
@@ -118,15 +223,6 @@ func (gb *GroupBy) aggregate(aggFunc func(collection.Series) (any, error)) (*Dat
 	for i, key := range sortedKeys {
 		indices := gb.groups[key]
 
-		// Set grouping column values
-		// We need to parse the key back or take from first row. Taking from first row is safer for types.
-		firstIdx := indices[0]
-		for _, colName := range gb.colNames {
-			val, _ := gb.df.Columns[colName].At(firstIdx)
-			// We are forcing StringSeries for grouping cols above, so convert to string
-			resultCols[colName].Set(i, fmt.Sprintf("%v", val))
-		}
-
 		// Calculate aggregation for other columns
 		for _, colName := range resultOrder {
 			isGroupingCol := false
@@ -140,27 +236,7 @@ func (gb *GroupBy) aggregate(aggFunc func(collection.Series) (any, error)) (*Dat
 				continue
 			}
 
-			// Extract series for this group
-			// Optimization: Avoid full Slice, just iterate indices
-			// But Series interface doesn't support random access iterator easily without Slice.
-			// Let's use Slice for correctness first.
-			// We need a Slice method on Series that takes indices?
-			// We implemented Slice on DataFrame, let's use that logic or just manually extract.
-
-			originalSeries := gb.df.Columns[colName]
-			// Create a temporary series for the group
-			// This is inefficient, but works.
-			groupSeries := collection.NewSeriesOfTypeWithSize(originalSeries.DType(), len(indices))
-			for k, idx := range indices {
-				val, _ := originalSeries.At(idx)
-				if originalSeries.IsNull(idx) {
-					groupSeries.SetNull(k)
-				} else {
-					groupSeries.Set(k, val)
-				}
-			}
-
-			val, err := aggFunc(groupSeries)
+			val, err := aggFunc(gb.df.Columns[colName], indices)
 			if err != nil {
 				// If aggregation fails (e.g. mean of strings), set to null
 				resultCols[colName].SetNull(i)
@@ -323,8 +399,113 @@ func (gb *GroupBy) Max() (*DataFrame, error) {
 	})
 }
 
+// Std computes the standard deviation of each group with ddof delta degrees
+// of freedom: ddof=1 gives the sample standard deviation (Bessel's
+// correction), ddof=0 the population standard deviation. Nulls are skipped.
+// A group with no more than ddof non-null values yields null.
+//
+// Each group is processed in a single pass with Welford's online algorithm,
+// which avoids the cancellation error of the naive sum-of-squares formula.
+// For each value x, with k values seen so far:
+//
+//	delta = x - mean
+//	mean += delta / k
+//	m2   += delta * (x - mean)
+//
+// and the result is sqrt(m2 / (n - ddof)).
+//
+// This is analogous to df.groupby(...).std(ddof=...) in pandas.
+//
+// Example:
+//
+//	stds, err := gb.Std(1)
+func (gb *GroupBy) Std(ddof int) (*DataFrame, error) {
+	if ddof < 0 {
+		return nil, fmt.Errorf("Std: ddof must be non-negative, got %d", ddof)
+	}
+	return gb.aggregateRows(func(s collection.Series, indices []int) (any, error) {
+		var mean, m2 float64
+		n := 0
+		for _, idx := range indices {
+			if s.IsNull(idx) {
+				continue
+			}
+			val, _ := s.At(idx)
+			x, ok := toFloat64(val)
+			if !ok {
+				return nil, fmt.Errorf("non-numeric type")
+			}
+			n++
+			delta := x - mean
+			mean += delta / float64(n)
+			m2 += delta * (x - mean)
+		}
+		if n <= ddof {
+			return nil, nil
+		}
+		return math.Sqrt(m2 / float64(n-ddof)), nil
+	})
+}
+
 // Apply applies a function to each group and combines the results.
 func (gb *GroupBy) Apply(f func(*DataFrame) (*DataFrame, error)) (*DataFrame, error) {
+	return gb.applyGroups(func(_ string, subDF *DataFrame) (*DataFrame, error) {
+		return f(subDF)
+	})
+}
+
+// Pipe applies fn to the GroupBy itself and returns its result. It is
+// equivalent to calling fn(gb) but lets grouped transformations be written as
+// a chain without intermediate variables; the returned DataFrame can be piped
+// further with DataFrame.Pipe.
+//
+// This is analogous to df.groupby(...).pipe(fn) in pandas.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Team"}, 0)
+//	result, err := gb.Pipe(normalizeWithinGroup)
+//	if err == nil {
+//	    result, err = result.Pipe(filterLargeGroups)
+//	}
+//
+//	// where: func normalizeWithinGroup(gb *dataframe.GroupBy) (*dataframe.DataFrame, error) { ... }
+func (gb *GroupBy) Pipe(fn func(*GroupBy) (*DataFrame, error)) (*DataFrame, error) {
+	if gb == nil {
+		return nil, errors.New("Pipe: GroupBy is nil")
+	}
+	if fn == nil {
+		return nil, errors.New("Pipe: fn must not be nil")
+	}
+	return fn(gb)
+}
+
+// PipeWithKey works like Apply, but also passes each group's key to fn so it
+// can apply group-conditional logic. Groups are visited in sorted key order,
+// and the non-nil results are concatenated with a fresh index.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Team"}, 0)
+//	result, err := gb.PipeWithKey(func(key string, sub *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+//	    if key == "Reserves" {
+//	        return nil, nil // drop this group
+//	    }
+//	    return sub, nil
+//	})
+func (gb *GroupBy) PipeWithKey(fn func(key string, subDF *DataFrame) (*DataFrame, error)) (*DataFrame, error) {
+	if gb == nil {
+		return nil, errors.New("PipeWithKey: GroupBy is nil")
+	}
+	if fn == nil {
+		return nil, errors.New("PipeWithKey: fn must not be nil")
+	}
+	return gb.applyGroups(fn)
+}
+
+// applyGroups calls f on the sub-DataFrame of each group in sorted key order
+// and concatenates the non-nil results.
+func (gb *GroupBy) applyGroups(f func(key string, subDF *DataFrame) (*DataFrame, error)) (*DataFrame, error) {
 	sortedKeys := gb.getSortedKeys()
 	var resultParts []*DataFrame
 
@@ -338,7 +519,7 @@ func (gb *GroupBy) Apply(f func(*DataFrame) (*DataFrame, error)) (*DataFrame, er
 		}
 
 		// Apply function
-		resDF, err := f(subDF)
+		resDF, err := f(key, subDF)
 		if err != nil {
 			return nil, err
 		}
@@ -355,3 +536,288 @@ func (gb *GroupBy) Apply(f func(*DataFrame) (*DataFrame, error)) (*DataFrame, er
 	// Combine results using Concat
 	return Concat(resultParts, ConcatOptions{IgnoreIndex: true})
 }
+
+// Head returns the first n rows of each group. Groups with fewer than n rows
+// are included in full. The selected rows keep their original order and index
+// labels.
+//
+// This is analogous to df.groupby(...).head(n) in pandas.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Team"}, 0)
+//	firstTwo, err := gb.Head(2)
+func (gb *GroupBy) Head(n int) (*DataFrame, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("Head: n must be non-negative, got %d", n)
+	}
+	return gb.selectRows(func(indices []int) []int {
+		if len(indices) > n {
+			return indices[:n]
+		}
+		return indices
+	})
+}
+
+// Tail returns the last n rows of each group. Groups with fewer than n rows
+// are included in full. The selected rows keep their original order and index
+// labels.
+//
+// This is analogous to df.groupby(...).tail(n) in pandas.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Team"}, 0)
+//	lastTwo, err := gb.Tail(2)
+func (gb *GroupBy) Tail(n int) (*DataFrame, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("Tail: n must be non-negative, got %d", n)
+	}
+	return gb.selectRows(func(indices []int) []int {
+		if len(indices) > n {
+			return indices[len(indices)-n:]
+		}
+		return indices
+	})
+}
+
+// selectRows picks row positions from each group in sorted key order and
+// returns them as a DataFrame in the original row order.
+func (gb *GroupBy) selectRows(pick func(indices []int) []int) (*DataFrame, error) {
+	var rows []int
+	for _, key := range gb.getSortedKeys() {
+		rows = append(rows, pick(gb.groups[key])...)
+	}
+	sort.Ints(rows)
+	return gb.df.Slice(rows)
+}
+
+// Mode returns the most frequent value(s) of every non-grouping column within
+// each group, as computed by collection.Mode. A group contributes as many rows as
+// its column with the most tied modes; shorter columns are padded with nulls.
+// Groups come out in key order, each row repeating the group's key values, and
+// column types are preserved. A column that is entirely null within a group
+// contributes only nulls.
+//
+// This is analogous to df.groupby(...).agg(pd.Series.mode) exploded into rows
+// in pandas.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Store"}, 0)
+//	modes, err := gb.Mode() // most common product per store
+func (gb *GroupBy) Mode() (*DataFrame, error) {
+	resultCols := make(map[string]collection.Series)
+	resultOrder := make([]string, 0, len(gb.df.ColumnOrder))
+	for _, colName := range gb.colNames {
+		resultCols[colName] = groupSeries(gb.df.Columns[colName], nil)
+		resultOrder = append(resultOrder, colName)
+	}
+	var valueCols []string
+	for _, colName := range gb.df.ColumnOrder {
+		if containsColumn(gb.colNames, colName) {
+			continue
+		}
+		resultCols[colName] = groupSeries(gb.df.Columns[colName], nil)
+		resultOrder = append(resultOrder, colName)
+		valueCols = append(valueCols, colName)
+	}
+
+	appendValue := func(dst collection.Series, src collection.Series, i int) error {
+		if src.IsNull(i) {
+			dst.AppendNull()
+			return nil
+		}
+		val, err := src.At(i)
+		if err != nil {
+			return err
+		}
+		return dst.Append(val)
+	}
+
+	rows := 0
+	for _, key := range gb.getSortedKeys() {
+		indices := gb.groups[key]
+		modes := make([]collection.Series, len(valueCols))
+		groupRows := 1
+		for j, colName := range valueCols {
+			m, err := collection.Mode(groupSeries(gb.df.Columns[colName], indices))
+			if err != nil {
+				return nil, fmt.Errorf("Mode: column '%s': %w", colName, err)
+			}
+			modes[j] = m
+			groupRows = max(groupRows, m.Len())
+		}
+
+		for r := 0; r < groupRows; r++ {
+			for _, colName := range gb.colNames {
+				if err := appendValue(resultCols[colName], gb.df.Columns[colName], indices[0]); err != nil {
+					return nil, fmt.Errorf("Mode: group key column '%s': %w", colName, err)
+				}
+			}
+			for j, colName := range valueCols {
+				if r >= modes[j].Len() {
+					resultCols[colName].AppendNull()
+					continue
+				}
+				if err := appendValue(resultCols[colName], modes[j], r); err != nil {
+					return nil, fmt.Errorf("Mode: column '%s': %w", colName, err)
+				}
+			}
+		}
+		rows += groupRows
+	}
+
+	index := make([]string, rows)
+	for i := range index {
+		index[i] = fmt.Sprintf("%d", i)
+	}
+	return &DataFrame{
+		Columns:     resultCols,
+		ColumnOrder: resultOrder,
+		Index:       index,
+	}, nil
+}
+
+// NUnique returns the number of distinct values of every non-grouping column
+// within each group, as computed by collection.NUnique, in Int64 columns. Nulls
+// are ignored unless dropNa is false, in which case they count as one value.
+// Groups come out in key order, preceded by the grouping columns.
+//
+// This is analogous to df.groupby(...).nunique(dropna=dropNa) in pandas.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Store"}, 0)
+//	counts, err := gb.NUnique(true) // distinct products per store
+func (gb *GroupBy) NUnique(dropNa bool) (*DataFrame, error) {
+	sortedKeys := gb.getSortedKeys()
+	numGroups := len(sortedKeys)
+
+	resultCols := make(map[string]collection.Series)
+	resultOrder := make([]string, 0, len(gb.df.ColumnOrder))
+	for _, colName := range gb.colNames {
+		resultCols[colName] = gb.keyColumn(colName, sortedKeys)
+		resultOrder = append(resultOrder, colName)
+	}
+	for _, colName := range gb.df.ColumnOrder {
+		if containsColumn(gb.colNames, colName) {
+			continue
+		}
+		counts := make([]int64, numGroups)
+		for i, key := range sortedKeys {
+			counts[i] = int64(collection.NUnique(groupSeries(gb.df.Columns[colName], gb.groups[key]), dropNa))
+		}
+		out, err := collection.NewInt64SeriesFromData(counts, nil)
+		if err != nil {
+			return nil, fmt.Errorf("NUnique: column '%s': %w", colName, err)
+		}
+		resultCols[colName] = out
+		resultOrder = append(resultOrder, colName)
+	}
+
+	index := make([]string, numGroups)
+	for i := range index {
+		index[i] = fmt.Sprintf("%d", i)
+	}
+	return &DataFrame{
+		Columns:     resultCols,
+		ColumnOrder: resultOrder,
+		Index:       index,
+	}, nil
+}
+
+// Rank ranks the values of each numeric column within each group. The result
+// has one Float64 column per numeric non-grouping column and one row per
+// original row, aligned to the original row positions and index labels.
+// Non-numeric columns are dropped. Null values stay null.
+//
+// method and ascending have the same meaning as in Float64Series.Rank
+// ("average", "min", "max", "first" or "dense").
+//
+// This is analogous to df.groupby(...).rank(method=..., ascending=...) in pandas.
+//
+// Example:
+//
+//	gb, _ := df.GroupBy([]string{"Team"}, 0)
+//	ranks, err := gb.Rank("average", false) // 1 = highest score within each team
+func (gb *GroupBy) Rank(method string, ascending bool) (*DataFrame, error) {
+	if _, err := collection.RankFloat64s(nil, nil, method, ascending); err != nil {
+		return nil, fmt.Errorf("Rank: %w", err)
+	}
+
+	rowCount := gb.df.Len()
+	resultCols := make(map[string]collection.Series)
+	resultOrder := make([]string, 0)
+
+	for _, colName := range gb.df.ColumnOrder {
+		if containsColumn(gb.colNames, colName) {
+			continue
+		}
+		series := gb.df.Columns[colName]
+		if !isNumericSeries(series) {
+			continue
+		}
+
+		values := make([]float64, rowCount)
+		mask := make([]bool, rowCount)
+		for i := 0; i < rowCount; i++ {
+			val, err := series.At(i)
+			if err != nil || series.IsNull(i) {
+				mask[i] = true
+				continue
+			}
+			f, ok := toFloat64(val)
+			if !ok {
+				mask[i] = true
+				continue
+			}
+			values[i] = f
+		}
+
+		ranks := make([]float64, rowCount)
+		for _, indices := range gb.groups {
+			groupValues := make([]float64, len(indices))
+			groupMask := make([]bool, len(indices))
+			for k, idx := range indices {
+				groupValues[k] = values[idx]
+				groupMask[k] = mask[idx]
+			}
+			groupRanks, err := collection.RankFloat64s(groupValues, groupMask, method, ascending)
+			if err != nil {
+				return nil, fmt.Errorf("Rank: %w", err)
+			}
+			for k, idx := range indices {
+				ranks[idx] = groupRanks[k]
+			}
+		}
+
+		rankSeries, err := collection.NewFloat64SeriesFromData(ranks, mask)
+		if err != nil {
+			return nil, fmt.Errorf("Rank: %w", err)
+		}
+		resultCols[colName] = rankSeries
+		resultOrder = append(resultOrder, colName)
+	}
+
+	index := make([]string, rowCount)
+	for i := range index {
+		index[i] = gb.df.indexLabel(i)
+	}
+
+	return &DataFrame{
+		Columns:     resultCols,
+		ColumnOrder: resultOrder,
+		Index:       index,
+	}, nil
+}
+
+// containsColumn reports whether name is in cols.
+func containsColumn(cols []string, name string) bool {
+	for _, c := range cols {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,144 @@
+package dataframe
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"time"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// Tags written before each value so that a null, a zero and an empty string
+// never hash the same way.
+const (
+	hashTagNull byte = iota
+	hashTagValue
+)
+
+// ColumnHash returns a 64-bit FNV-1a hash of the named column, covering its
+// dtype, every value and the null mask. It is a fast, non-cryptographic
+// fingerprint for change detection: equal columns hash equally, and a null
+// hashes differently from a zero or an empty string.
+//
+// Example:
+//
+//	before, _ := df.ColumnHash("Price")
+//	// ... transformation ...
+//	after, _ := df.ColumnHash("Price")
+//	changed := before != after
+func (df *DataFrame) ColumnHash(column string) (uint64, error) {
+	if df == nil {
+		return 0, errors.New("ColumnHash: DataFrame is nil")
+	}
+	df.RLock()
+	defer df.RUnlock()
+
+	series, ok := df.Columns[column]
+	if !ok {
+		return 0, fmt.Errorf("ColumnHash: column '%s' not found", column)
+	}
+	h := fnv.New64a()
+	hashSeries(h, series)
+	return h.Sum64(), nil
+}
+
+// Hash returns a 64-bit FNV-1a hash of the whole DataFrame, combining the
+// column order, each column's name and ColumnHash, and the index labels. Two
+// DataFrames with the same hash almost certainly hold the same data, which
+// makes it suitable as a cache or memoization key.
+//
+// Example:
+//
+//	key, err := df.Hash()
+//	if cached, ok := cache[key]; ok {
+//	    return cached
+//	}
+func (df *DataFrame) Hash() (uint64, error) {
+	if df == nil {
+		return 0, errors.New("Hash: DataFrame is nil")
+	}
+	df.RLock()
+	defer df.RUnlock()
+
+	h := fnv.New64a()
+	var buf [8]byte
+	for _, name := range df.ColumnOrder {
+		series, ok := df.Columns[name]
+		if !ok {
+			return 0, fmt.Errorf("Hash: column '%s' not found", name)
+		}
+		col := fnv.New64a()
+		hashSeries(col, series)
+		hashString(h, name)
+		binary.LittleEndian.PutUint64(buf[:], col.Sum64())
+		h.Write(buf[:])
+	}
+	binary.LittleEndian.PutUint64(buf[:], uint64(len(df.Index)))
+	h.Write(buf[:])
+	for _, label := range df.Index {
+		hashString(h, label)
+	}
+	return h.Sum64(), nil
+}
+
+// hashSeries writes the dtype, length and every value of s to h.
+func hashSeries(h hash.Hash64, s collection.Series) {
+	hashString(h, dtypeName(s.DType()))
+	var buf [8]byte
+	n := s.Len()
+	binary.LittleEndian.PutUint64(buf[:], uint64(n))
+	h.Write(buf[:])
+	for i := 0; i < n; i++ {
+		if s.IsNull(i) {
+			h.Write([]byte{hashTagNull})
+			continue
+		}
+		h.Write([]byte{hashTagValue})
+		v, _ := s.At(i)
+		hashValue(h, v)
+	}
+}
+
+// hashValue writes a binary encoding of v to h, falling back to its %T/%v
+// representation for types without a dedicated case.
+func hashValue(h hash.Hash64, v any) {
+	var buf [8]byte
+	switch x := v.(type) {
+	case float64:
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(x))
+	case float32:
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(float64(x)))
+	case int64:
+		binary.LittleEndian.PutUint64(buf[:], uint64(x))
+	case int32:
+		binary.LittleEndian.PutUint64(buf[:], uint64(x))
+	case int:
+		binary.LittleEndian.PutUint64(buf[:], uint64(x))
+	case bool:
+		if x {
+			buf[0] = 1
+		}
+	case string:
+		hashString(h, x)
+		return
+	case time.Time:
+		binary.LittleEndian.PutUint64(buf[:], uint64(x.UnixNano()))
+	default:
+		hashString(h, fmt.Sprintf("%T:%v", v, v))
+		return
+	}
+	h.Write(buf[:])
+}
+
+// hashString writes s to h prefixed with its length, so that adjacent strings
+// cannot run into each other.
+func hashString(h hash.Hash64, s string) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(len(s)))
+	h.Write(buf[:])
+	h.Write([]byte(s))
+}
@@ -3,6 +3,7 @@ package dataframe
 import (
 	"errors"
 	"fmt"
+	"reflect"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
 )
@@ -14,10 +15,23 @@ type LocIndexer struct {
 
 // iLocIndexer provides integer position-based indexing for DataFrames
 type iLocIndexer struct {
-	df *DataFrame
+	df   *DataFrame
+	deep bool
+}
+
+// Deep returns an indexer whose Col and Cols results hold copies of the
+// selected Series rather than sharing them with the DataFrame.
+//
+// Example:
+//
+//	col, err := df.ILoc().Deep().Col(0)
+func (il *iLocIndexer) Deep() *iLocIndexer {
+	return &iLocIndexer{df: il.df, deep: true}
 }
 
-// At returns a single value using row label and column name
+// At returns a single value using row label and column name. If the label
+// appears more than once in the index (see HasDuplicateIndex), the value from
+// the first matching row is returned.
 func (l *LocIndexer) At(rowLabel string, columnName string) (any, error) {
 	if l.df == nil {
 		return nil, errors.New("DataFrame is nil")
@@ -78,7 +92,9 @@ func (l *LocIndexer) IsNullAt(rowLabel string, columnName string) (bool, error)
 	return series.IsNull(rowIdx), nil
 }
 
-// Row returns a single row as a new DataFrame using row label
+// Row returns the row with the given label as a new DataFrame. If the label
+// appears more than once in the index (see HasDuplicateIndex), every matching
+// row is returned, in index order, as a multi-row DataFrame.
 func (l *LocIndexer) Row(rowLabel string) (*DataFrame, error) {
 	if l.df == nil {
 		return nil, errors.New("DataFrame is nil")
@@ -87,47 +103,22 @@ func (l *LocIndexer) Row(rowLabel string) (*DataFrame, error) {
 	l.df.RLock()
 	defer l.df.RUnlock()
 
-	// Find row index
-	rowIdx := -1
+	// Find all row indices carrying the label
+	var rowIndices []int
 	for i, label := range l.df.Index {
 		if label == rowLabel {
-			rowIdx = i
-			break
+			rowIndices = append(rowIndices, i)
 		}
 	}
-	if rowIdx == -1 {
+	if len(rowIndices) == 0 {
 		return nil, fmt.Errorf("row label '%s' not found in index", rowLabel)
 	}
 
-	// Create new DataFrame with single row, preserving null masks
-	newCols := make(map[string]collection.Series, len(l.df.ColumnOrder))
-	for _, colName := range l.df.ColumnOrder {
-		series := l.df.Columns[colName]
-		isNull := series.IsNull(rowIdx)
-		val, err := series.At(rowIdx)
-		if err != nil {
-			return nil, fmt.Errorf("error accessing column %s: %w", colName, err)
-		}
-
-		// Create new series with single value, preserving null status
-		var newSeries collection.Series
-		if isNull {
-			newSeries = collection.NewSeriesOfType(series.DType(), 1)
-			newSeries.AppendNull()
-		} else {
-			newSeries = collection.NewSeriesOfType(series.DType(), 1)
-			if err := newSeries.Append(val); err != nil {
-				return nil, fmt.Errorf("error creating series for column %s: %w", colName, err)
-			}
-		}
-		newCols[colName] = newSeries
+	labels := make([]string, len(rowIndices))
+	for i := range labels {
+		labels[i] = rowLabel
 	}
-
-	return &DataFrame{
-		Columns:     newCols,
-		ColumnOrder: append([]string(nil), l.df.ColumnOrder...),
-		Index:       []string{rowLabel},
-	}, nil
+	return l.df.takeRowsLocked(rowIndices, labels, l.df.ColumnOrder)
 }
 
 // Rows returns multiple rows as a new DataFrame using row labels
@@ -155,10 +146,16 @@ func (l *LocIndexer) Rows(rowLabels []string) (*DataFrame, error) {
 		}
 	}
 
-	// Create new DataFrame with selected rows, preserving null masks
-	newCols := make(map[string]collection.Series, len(l.df.ColumnOrder))
-	for _, colName := range l.df.ColumnOrder {
-		series := l.df.Columns[colName]
+	return l.df.takeRowsLocked(rowIndices, rowLabels, l.df.ColumnOrder)
+}
+
+// takeRowsLocked returns a new DataFrame holding the given columns at the rows
+// at rowIndices, labelled with labels and preserving null masks. Caller must
+// hold the read lock and have validated the columns.
+func (df *DataFrame) takeRowsLocked(rowIndices []int, labels []string, columns []string) (*DataFrame, error) {
+	newCols := make(map[string]collection.Series, len(columns))
+	for _, colName := range columns {
+		series := df.Columns[colName]
 		newSeries := collection.NewSeriesOfType(series.DType(), len(rowIndices))
 
 		for _, rowIdx := range rowIndices {
@@ -177,11 +174,137 @@ func (l *LocIndexer) Rows(rowLabels []string) (*DataFrame, error) {
 		newCols[colName] = newSeries
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
-		ColumnOrder: append([]string(nil), l.df.ColumnOrder...),
-		Index:       append([]string(nil), rowLabels...),
-	}, nil
+		ColumnOrder: append([]string(nil), columns...),
+		Index:       append([]string(nil), labels...),
+	}, rowIndices), nil
+}
+
+// AtColumns returns the values of the row with the given label at each of the
+// given columns, in order, with nil for nulls. As with At, the first row
+// carrying the label is used.
+//
+// This is analogous to df.loc[rowLabel, columns] in pandas.
+//
+// Example:
+//
+//	vals, err := df.Loc().AtColumns("alice", []string{"Age", "City"})
+func (l *LocIndexer) AtColumns(rowLabel string, columns []string) ([]any, error) {
+	if l.df == nil {
+		return nil, errors.New("DataFrame is nil")
+	}
+
+	l.df.RLock()
+	defer l.df.RUnlock()
+
+	rowIdx := -1
+	for i, label := range l.df.Index {
+		if label == rowLabel {
+			rowIdx = i
+			break
+		}
+	}
+	if rowIdx == -1 {
+		return nil, fmt.Errorf("row label '%s' not found in index", rowLabel)
+	}
+
+	values := make([]any, len(columns))
+	for i, colName := range columns {
+		series, ok := l.df.Columns[colName]
+		if !ok {
+			return nil, fmt.Errorf("column '%s' not found", colName)
+		}
+		if series.IsNull(rowIdx) {
+			continue
+		}
+		val, err := series.At(rowIdx)
+		if err != nil {
+			return nil, fmt.Errorf("error accessing column %s: %w", colName, err)
+		}
+		values[i] = val
+	}
+	return values, nil
+}
+
+// SubFrame returns a new DataFrame holding the given rows and columns, in the
+// order requested, preserving null masks. Each row label is matched against
+// its first occurrence in the index, as in Rows.
+//
+// This is analogous to df.loc[rowLabels, columns] in pandas.
+//
+// Example:
+//
+//	sub, err := df.Loc().SubFrame([]string{"alice", "bob"}, []string{"Age", "City"})
+func (l *LocIndexer) SubFrame(rowLabels []string, columns []string) (*DataFrame, error) {
+	if l.df == nil {
+		return nil, errors.New("DataFrame is nil")
+	}
+
+	l.df.RLock()
+	defer l.df.RUnlock()
+
+	for _, colName := range columns {
+		if _, ok := l.df.Columns[colName]; !ok {
+			return nil, fmt.Errorf("column '%s' not found", colName)
+		}
+	}
+
+	positions := make(map[string]int, len(l.df.Index))
+	for i := len(l.df.Index) - 1; i >= 0; i-- {
+		positions[l.df.Index[i]] = i
+	}
+	rowIndices := make([]int, len(rowLabels))
+	for i, label := range rowLabels {
+		pos, ok := positions[label]
+		if !ok {
+			return nil, fmt.Errorf("row label '%s' not found in index", label)
+		}
+		rowIndices[i] = pos
+	}
+
+	return l.df.takeRowsLocked(rowIndices, rowLabels, columns)
+}
+
+// RowRange returns the rows from the row labelled startLabel to the row
+// labelled endLabel, both inclusive, in index order. If startLabel appears
+// after endLabel, the result is empty. Labels are matched against their first
+// occurrence in the index.
+//
+// This is analogous to df.loc[startLabel:endLabel] in pandas.
+//
+// Example:
+//
+//	january, err := df.Loc().RowRange("2023-01-01", "2023-01-31")
+func (l *LocIndexer) RowRange(startLabel, endLabel string) (*DataFrame, error) {
+	if l.df == nil {
+		return nil, errors.New("DataFrame is nil")
+	}
+
+	l.df.RLock()
+	startIdx, endIdx := -1, -1
+	for i, label := range l.df.Index {
+		if startIdx == -1 && label == startLabel {
+			startIdx = i
+		}
+		if endIdx == -1 && label == endLabel {
+			endIdx = i
+		}
+	}
+	l.df.RUnlock()
+
+	if startIdx == -1 {
+		return nil, fmt.Errorf("row label '%s' not found in index", startLabel)
+	}
+	if endIdx == -1 {
+		return nil, fmt.Errorf("row label '%s' not found in index", endLabel)
+	}
+
+	rowPositions := make([]int, 0)
+	for i := startIdx; i <= endIdx; i++ {
+		rowPositions = append(rowPositions, i)
+	}
+	return (&iLocIndexer{df: l.df}).Rows(rowPositions)
 }
 
 // Col returns a single column as a Series reference
@@ -223,11 +346,11 @@ func (l *LocIndexer) Cols(columnNames []string) (*DataFrame, error) {
 		newCols[colName] = l.df.Columns[colName]
 	}
 
-	return &DataFrame{
+	return l.df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), columnNames...),
 		Index:       append([]string(nil), l.df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // At returns a single value using row and column positions
@@ -324,11 +447,11 @@ func (il *iLocIndexer) Row(rowPos int) (*DataFrame, error) {
 	}
 
 	rowLabel := il.df.Index[rowPos]
-	return &DataFrame{
+	return il.df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), il.df.ColumnOrder...),
 		Index:       []string{rowLabel},
-	}, nil
+	}, []int{rowPos}), nil
 }
 
 // Rows returns multiple rows at the given positions as a new DataFrame
@@ -381,11 +504,11 @@ func (il *iLocIndexer) Rows(rowPositions []int) (*DataFrame, error) {
 		newIndex[i] = il.df.Index[pos]
 	}
 
-	return &DataFrame{
+	return il.df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), il.df.ColumnOrder...),
 		Index:       newIndex,
-	}, nil
+	}, rowPositions), nil
 }
 
 // Range returns rows in the range [start, end) as a new DataFrame
@@ -445,14 +568,69 @@ func (il *iLocIndexer) Range(start int, end int) (*DataFrame, error) {
 		newIndex[i] = il.df.Index[pos]
 	}
 
-	return &DataFrame{
+	return il.df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), il.df.ColumnOrder...),
 		Index:       newIndex,
-	}, nil
+	}, rowPositions), nil
+}
+
+// RangeStep returns the rows at positions start, start+step, start+2*step, ...
+// up to but not including end, as a new DataFrame.
+//
+// A negative step walks backwards and requires start > end; use end = -1 to
+// include row 0. A step of 0 is an error.
+//
+// This is analogous to df.iloc[start:end:step] in pandas.
+//
+// Example:
+//
+//	everyOther, err := df.ILoc().RangeStep(0, df.Len(), 2)
+//	reversed, err := df.ILoc().RangeStep(df.Len()-1, -1, -1)
+func (il *iLocIndexer) RangeStep(start, end, step int) (*DataFrame, error) {
+	if il.df == nil {
+		return nil, errors.New("DataFrame is nil")
+	}
+	if step == 0 {
+		return nil, errors.New("step must not be zero")
+	}
+
+	il.df.RLock()
+	if len(il.df.ColumnOrder) == 0 {
+		il.df.RUnlock()
+		return nil, errors.New("DataFrame has no columns")
+	}
+	rowCount := il.df.Columns[il.df.ColumnOrder[0]].Len()
+	il.df.RUnlock()
+
+	var rowPositions []int
+	if step > 0 {
+		if start < 0 || start > rowCount {
+			return nil, fmt.Errorf("start position %d out of range [0, %d]", start, rowCount)
+		}
+		if end < start || end > rowCount {
+			return nil, fmt.Errorf("end position %d out of range [%d, %d]", end, start, rowCount)
+		}
+		for i := start; i < end; i += step {
+			rowPositions = append(rowPositions, i)
+		}
+	} else {
+		if start < 0 || start >= rowCount {
+			return nil, fmt.Errorf("start position %d out of range [0, %d)", start, rowCount)
+		}
+		if end < -1 || end > start {
+			return nil, fmt.Errorf("end position %d out of range [-1, %d]", end, start)
+		}
+		for i := start; i > end; i += step {
+			rowPositions = append(rowPositions, i)
+		}
+	}
+
+	return il.Rows(rowPositions)
 }
 
-// Col returns a single column at the given position as a Series reference
+// Col returns a single column at the given position as a Series reference,
+// or as a copy if the indexer was obtained with Deep
 func (il *iLocIndexer) Col(colPos int) (collection.Series, error) {
 	if il.df == nil {
 		return nil, errors.New("DataFrame is nil")
@@ -466,10 +644,15 @@ func (il *iLocIndexer) Col(colPos int) (collection.Series, error) {
 	}
 
 	colName := il.df.ColumnOrder[colPos]
+	if il.deep {
+		return cloneSeries(il.df.Columns[colName]), nil
+	}
 	return il.df.Columns[colName], nil
 }
 
-// Cols returns multiple columns at the given positions as a new DataFrame
+// Cols returns multiple columns at the given positions as a new DataFrame.
+// The Series are shared with the source (a view) unless the indexer was
+// obtained with Deep
 func (il *iLocIndexer) Cols(colPositions []int) (*DataFrame, error) {
 	if il.df == nil {
 		return nil, errors.New("DataFrame is nil")
@@ -491,15 +674,169 @@ func (il *iLocIndexer) Cols(colPositions []int) (*DataFrame, error) {
 		columnNames[i] = il.df.ColumnOrder[pos]
 	}
 
-	// Create new DataFrame with selected columns (zero-copy)
+	// Create new DataFrame with selected columns (zero-copy unless deep)
 	newCols := make(map[string]collection.Series, len(columnNames))
 	for _, colName := range columnNames {
-		newCols[colName] = il.df.Columns[colName]
+		if il.deep {
+			newCols[colName] = cloneSeries(il.df.Columns[colName])
+		} else {
+			newCols[colName] = il.df.Columns[colName]
+		}
 	}
 
-	return &DataFrame{
+	return il.df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: columnNames,
 		Index:       append([]string(nil), il.df.Index...),
-	}, nil
+	}, nil), nil
+}
+
+// SubFrame returns a new DataFrame holding the rows and columns at the given
+// positions, in the order requested, preserving null masks.
+//
+// This is analogous to df.iloc[rowPositions, colPositions] in pandas.
+//
+// Example:
+//
+//	features, err := df.ILoc().SubFrame([]int{0, 1, 2}, []int{1, 3})
+func (il *iLocIndexer) SubFrame(rowPositions []int, colPositions []int) (*DataFrame, error) {
+	if il.df == nil {
+		return nil, errors.New("DataFrame is nil")
+	}
+
+	il.df.RLock()
+	defer il.df.RUnlock()
+
+	return il.subFrameLocked(rowPositions, colPositions)
+}
+
+// SliceFrame returns the rectangular block of rows [rowStart, rowEnd) and
+// columns [colStart, colEnd) as a new DataFrame.
+//
+// This is analogous to df.iloc[rowStart:rowEnd, colStart:colEnd] in pandas.
+//
+// Example:
+//
+//	// All rows, every column except the first
+//	x, err := df.ILoc().SliceFrame(0, df.Len(), 1, len(df.ColumnOrder))
+func (il *iLocIndexer) SliceFrame(rowStart, rowEnd, colStart, colEnd int) (*DataFrame, error) {
+	if il.df == nil {
+		return nil, errors.New("DataFrame is nil")
+	}
+
+	il.df.RLock()
+	defer il.df.RUnlock()
+
+	rowCount := il.df.Len()
+	colCount := len(il.df.ColumnOrder)
+	if rowStart < 0 || rowStart > rowCount {
+		return nil, fmt.Errorf("row start position %d out of range [0, %d]", rowStart, rowCount)
+	}
+	if rowEnd < rowStart || rowEnd > rowCount {
+		return nil, fmt.Errorf("row end position %d out of range [%d, %d]", rowEnd, rowStart, rowCount)
+	}
+	if colStart < 0 || colStart > colCount {
+		return nil, fmt.Errorf("column start position %d out of range [0, %d]", colStart, colCount)
+	}
+	if colEnd < colStart || colEnd > colCount {
+		return nil, fmt.Errorf("column end position %d out of range [%d, %d]", colEnd, colStart, colCount)
+	}
+
+	rowPositions := make([]int, rowEnd-rowStart)
+	for i := range rowPositions {
+		rowPositions[i] = rowStart + i
+	}
+	colPositions := make([]int, colEnd-colStart)
+	for i := range colPositions {
+		colPositions[i] = colStart + i
+	}
+	return il.subFrameLocked(rowPositions, colPositions)
+}
+
+// subFrameLocked implements SubFrame. Caller must hold the read lock.
+func (il *iLocIndexer) subFrameLocked(rowPositions []int, colPositions []int) (*DataFrame, error) {
+	columns := make([]string, len(colPositions))
+	for i, pos := range colPositions {
+		if pos < 0 || pos >= len(il.df.ColumnOrder) {
+			return nil, fmt.Errorf("column position %d out of range [0, %d)", pos, len(il.df.ColumnOrder))
+		}
+		columns[i] = il.df.ColumnOrder[pos]
+	}
+
+	rowCount := il.df.Len()
+	labels := make([]string, len(rowPositions))
+	for i, pos := range rowPositions {
+		if pos < 0 || pos >= rowCount {
+			return nil, fmt.Errorf("row position %d out of range [0, %d)", pos, rowCount)
+		}
+		labels[i] = il.df.Index[pos]
+	}
+
+	return il.df.takeRowsLocked(rowPositions, labels, columns)
+}
+
+// Lookup returns a Series where position i holds the value at
+// (rowLabels[i], colNames[i]). It is a vectorized form of Loc().At(). Both
+// slices must have the same length. When every requested column shares the
+// same dtype the result has that dtype; otherwise it is an AnySeries. Null
+// values are preserved.
+//
+// This is analogous to the removed DataFrame.lookup in pandas and to NumPy
+// fancy indexing with paired row and column arrays.
+//
+// Example:
+//
+//	vals, err := df.Lookup([]string{"a", "b"}, []string{"price", "qty"})
+func (df *DataFrame) Lookup(rowLabels []string, colNames []string) (collection.Series, error) {
+	if df == nil {
+		return nil, errors.New("Lookup: DataFrame is nil")
+	}
+	if len(rowLabels) != len(colNames) {
+		return nil, fmt.Errorf("Lookup: rowLabels length (%d) must match colNames length (%d)", len(rowLabels), len(colNames))
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	// Map each label to its first position once so every lookup is O(1).
+	positions := make(map[string]int, len(df.Index))
+	for i, label := range df.Index {
+		if _, exists := positions[label]; !exists {
+			positions[label] = i
+		}
+	}
+
+	var dtype reflect.Type
+	for i, colName := range colNames {
+		series, ok := df.Columns[colName]
+		if !ok {
+			return nil, fmt.Errorf("Lookup: column '%s' not found", colName)
+		}
+		if i == 0 {
+			dtype = series.DType()
+		} else if dtype != series.DType() {
+			dtype = nil
+		}
+	}
+
+	result := collection.NewSeriesOfType(dtype, len(rowLabels))
+	for i, label := range rowLabels {
+		rowIdx, ok := positions[label]
+		if !ok {
+			return nil, fmt.Errorf("Lookup: row label '%s' not found in index", label)
+		}
+		series := df.Columns[colNames[i]]
+		if series.IsNull(rowIdx) {
+			result.AppendNull()
+			continue
+		}
+		val, err := series.At(rowIdx)
+		if err != nil {
+			return nil, fmt.Errorf("Lookup: %w", err)
+		}
+		if err := result.Append(val); err != nil {
+			return nil, fmt.Errorf("Lookup: %w", err)
+		}
+	}
+	return result, nil
 }
@@ -1,11 +1,15 @@
 package dataframe
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
 )
 
 // ToJSON serializes the DataFrame to JSON in records orientation (an array of
@@ -82,3 +86,144 @@ func (df *DataFrame) ToJSON(filepath string) (string, error) {
 
 	return buf.String(), nil
 }
+
+// WriteJSON streams the DataFrame as JSON to w without building the whole
+// document in memory. Supported orientations:
+//   - "records" (or ""): an array of row objects, one per row, exactly as
+//     produced by ToJSON
+//   - "columns": an object mapping each column name to the array of its
+//     values, e.g. {"Name":["Alice","Bob"],"Age":[30,25]}
+//
+// Column order is preserved and null values are written as JSON null. Output
+// is written through a small buffer, so w sees data as it is produced.
+//
+// This is analogous to df.to_json(buf, orient="records") in pandas; the
+// "columns" form matches df.to_dict(orient="list").
+//
+// Example:
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    w.Header().Set("Content-Type", "application/json")
+//	    if err := df.WriteJSON(w, "records"); err != nil {
+//	        log.Print(err)
+//	    }
+//	}
+func (df *DataFrame) WriteJSON(w io.Writer, orient string) error {
+	if df == nil {
+		return errors.New("WriteJSON: DataFrame is nil")
+	}
+	if w == nil {
+		return errors.New("WriteJSON: writer is nil")
+	}
+	if orient != "" && orient != "records" && orient != "columns" {
+		return fmt.Errorf("WriteJSON: unsupported orient '%s'", orient)
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+
+	keys := make([][]byte, len(df.ColumnOrder))
+	for c, colName := range df.ColumnOrder {
+		keyBytes, err := json.Marshal(colName)
+		if err != nil {
+			return fmt.Errorf("WriteJSON: marshaling key '%s': %w", colName, err)
+		}
+		keys[c] = keyBytes
+	}
+
+	bw := bufio.NewWriter(w)
+	var err error
+	if orient == "columns" {
+		err = df.writeJSONColumns(bw, keys, rowCount)
+	} else {
+		err = df.writeJSONRecords(bw, keys, rowCount)
+	}
+	if err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("WriteJSON: %w", err)
+	}
+	return nil
+}
+
+// writeJSONRecords writes rows as an array of objects. The caller holds the
+// read lock.
+func (df *DataFrame) writeJSONRecords(bw *bufio.Writer, keys [][]byte, rowCount int) error {
+	bw.WriteByte('[')
+	for r := 0; r < rowCount; r++ {
+		if r > 0 {
+			bw.WriteByte(',')
+		}
+		bw.WriteByte('{')
+		for c, colName := range df.ColumnOrder {
+			if c > 0 {
+				bw.WriteByte(',')
+			}
+			bw.Write(keys[c])
+			bw.WriteByte(':')
+			if err := writeJSONValue(bw, df.Columns[colName], r); err != nil {
+				return fmt.Errorf("WriteJSON: column '%s' row %d: %w", colName, r, err)
+			}
+		}
+		if _, err := bw.WriteString("}"); err != nil {
+			return fmt.Errorf("WriteJSON: %w", err)
+		}
+	}
+	if _, err := bw.WriteString("]"); err != nil {
+		return fmt.Errorf("WriteJSON: %w", err)
+	}
+	return nil
+}
+
+// writeJSONColumns writes an object of column name to value array. The
+// caller holds the read lock.
+func (df *DataFrame) writeJSONColumns(bw *bufio.Writer, keys [][]byte, rowCount int) error {
+	bw.WriteByte('{')
+	for c, colName := range df.ColumnOrder {
+		if c > 0 {
+			bw.WriteByte(',')
+		}
+		bw.Write(keys[c])
+		bw.WriteString(":[")
+		series := df.Columns[colName]
+		for r := 0; r < rowCount; r++ {
+			if r > 0 {
+				bw.WriteByte(',')
+			}
+			if err := writeJSONValue(bw, series, r); err != nil {
+				return fmt.Errorf("WriteJSON: column '%s' row %d: %w", colName, r, err)
+			}
+		}
+		if _, err := bw.WriteString("]"); err != nil {
+			return fmt.Errorf("WriteJSON: %w", err)
+		}
+	}
+	if _, err := bw.WriteString("}"); err != nil {
+		return fmt.Errorf("WriteJSON: %w", err)
+	}
+	return nil
+}
+
+// writeJSONValue writes the JSON encoding of row r of series, or null.
+func writeJSONValue(bw *bufio.Writer, series collection.Series, r int) error {
+	if series.IsNull(r) {
+		_, err := bw.WriteString("null")
+		return err
+	}
+	v, err := series.At(r)
+	if err != nil {
+		return err
+	}
+	valBytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = bw.Write(valBytes)
+	return err
+}
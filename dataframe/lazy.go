@@ -0,0 +1,305 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// lazyOpKind identifies the kind of a deferred LazyFrame operation.
+type lazyOpKind int
+
+const (
+	lazyFilter lazyOpKind = iota
+	lazySort
+	lazySelect
+)
+
+// lazyOp is a single deferred operation in a LazyFrame plan.
+type lazyOp struct {
+	kind    lazyOpKind
+	mask    collection.Series // lazyFilter
+	sort    SortOptions       // lazySort
+	columns []string          // lazySelect
+}
+
+// LazyFrame records operations on a DataFrame without running them. The plan
+// is executed by Collect, which fuses operations where it can:
+//   - all filters are applied first, in a single pass that ANDs their masks,
+//     so later sorts only see the rows that survive
+//   - that pass copies only the columns still needed by the rest of the plan
+//     (the selected columns plus any sort keys)
+//
+// Filter masks are aligned with the rows of the source DataFrame, not with the
+// rows at that point in the plan, which is what allows filters to move ahead
+// of sorts.
+//
+// Each method returns a new LazyFrame, so a plan can be extended in several
+// directions without affecting the original. The source DataFrame is never
+// modified.
+//
+// Example:
+//
+//	result, err := df.Lazy().
+//	    Filter(isActive).
+//	    SortValues(dataframe.SortOptions{By: []string{"Age"}}).
+//	    Select("Name", "Age").
+//	    Collect()
+type LazyFrame struct {
+	df  *DataFrame
+	ops []lazyOp
+}
+
+// Lazy returns a LazyFrame with an empty plan over the DataFrame.
+func (df *DataFrame) Lazy() *LazyFrame {
+	return &LazyFrame{df: df}
+}
+
+// Filter adds a filter keeping the rows where mask is true. mask must be a
+// boolean Series aligned with the rows of the source DataFrame; null entries
+// are treated as false.
+func (lf *LazyFrame) Filter(mask collection.Series) *LazyFrame {
+	return lf.with(lazyOp{kind: lazyFilter, mask: mask})
+}
+
+// SortValues adds a sort step. opts has the same meaning as in
+// DataFrame.SortValues, except that Inplace is ignored.
+func (lf *LazyFrame) SortValues(opts SortOptions) *LazyFrame {
+	opts.Inplace = false
+	return lf.with(lazyOp{kind: lazySort, sort: opts})
+}
+
+// Select adds a projection to the given columns.
+func (lf *LazyFrame) Select(columns ...string) *LazyFrame {
+	return lf.with(lazyOp{kind: lazySelect, columns: append([]string(nil), columns...)})
+}
+
+// with returns a copy of lf with op appended to its plan.
+func (lf *LazyFrame) with(op lazyOp) *LazyFrame {
+	ops := make([]lazyOp, len(lf.ops), len(lf.ops)+1)
+	copy(ops, lf.ops)
+	return &LazyFrame{df: lf.df, ops: append(ops, op)}
+}
+
+// lazyPlan is the optimized form of a LazyFrame plan.
+type lazyPlan struct {
+	masks   []collection.Series
+	scan    []string      // columns read from the source, in source order
+	sorts   []SortOptions // applied in order after filtering
+	project []string      // final column order
+}
+
+// optimize validates the plan against the source columns and fuses it into
+// a single filtered scan followed by the sorts and a final projection.
+func (lf *LazyFrame) optimize() (*lazyPlan, error) {
+	plan := &lazyPlan{}
+	current := append([]string(nil), lf.df.ColumnOrder...)
+	needed := make(map[string]bool)
+
+	for _, op := range lf.ops {
+		switch op.kind {
+		case lazyFilter:
+			if op.mask == nil {
+				return nil, errors.New("Filter: mask is nil")
+			}
+			plan.masks = append(plan.masks, op.mask)
+		case lazySort:
+			if len(op.sort.By) == 0 {
+				return nil, errors.New("SortValues: 'By' must contain at least one column name")
+			}
+			for _, col := range op.sort.By {
+				if !containsColumn(current, col) {
+					return nil, fmt.Errorf("SortValues: column '%s' not found", col)
+				}
+				needed[col] = true
+			}
+			plan.sorts = append(plan.sorts, op.sort)
+		case lazySelect:
+			if len(op.columns) == 0 {
+				return nil, errors.New("Select: at least one column name is required")
+			}
+			for _, col := range op.columns {
+				if !containsColumn(current, col) {
+					return nil, fmt.Errorf("Select: column '%s' not found", col)
+				}
+			}
+			current = op.columns
+		}
+	}
+
+	for _, col := range current {
+		needed[col] = true
+	}
+	for _, col := range lf.df.ColumnOrder {
+		if needed[col] {
+			plan.scan = append(plan.scan, col)
+		}
+	}
+	plan.project = current
+	return plan, nil
+}
+
+// Collect executes the plan and returns the resulting DataFrame. Errors from
+// any step, including invalid columns or masks, are reported here.
+func (lf *LazyFrame) Collect() (*DataFrame, error) {
+	if lf == nil || lf.df == nil {
+		return nil, errors.New("Collect: DataFrame is nil")
+	}
+
+	lf.df.RLock()
+	plan, err := lf.optimize()
+	rowCount := 0
+	if len(lf.df.ColumnOrder) > 0 {
+		rowCount = lf.df.Columns[lf.df.ColumnOrder[0]].Len()
+	}
+	lf.df.RUnlock()
+	if err != nil {
+		return nil, fmt.Errorf("Collect: %w", err)
+	}
+
+	// Fused filter + projection in one pass over the source.
+	var result *DataFrame
+	if len(plan.masks) > 0 {
+		keep, err := combineMasks(plan.masks, rowCount)
+		if err != nil {
+			return nil, fmt.Errorf("Collect: %w", err)
+		}
+		result, err = lf.df.SelectWhere(plan.scan, keep)
+		if err != nil {
+			return nil, fmt.Errorf("Collect: %w", err)
+		}
+	} else if len(plan.scan) > 0 {
+		result, err = lf.df.Select(plan.scan...)
+		if err != nil {
+			return nil, fmt.Errorf("Collect: %w", err)
+		}
+	} else {
+		result = lf.df.copy()
+	}
+
+	for _, opts := range plan.sorts {
+		result, err = result.SortValues(opts)
+		if err != nil {
+			return nil, fmt.Errorf("Collect: %w", err)
+		}
+	}
+
+	if !stringSlicesEqual(result.ColumnOrder, plan.project) && len(plan.project) > 0 {
+		result, err = result.Select(plan.project...)
+		if err != nil {
+			return nil, fmt.Errorf("Collect: %w", err)
+		}
+	}
+	result.IndexName = lf.df.IndexName
+	result.ColumnsName = lf.df.ColumnsName
+	return result, nil
+}
+
+// Explain returns a human-readable description of the recorded plan and of
+// the optimized plan that Collect will run.
+//
+// Example output:
+//
+//	Plan:
+//	  Filter(mask)
+//	  SortValues(by=[Age], ascending=[false])
+//	  Select([Name Age])
+//	Optimized:
+//	  Scan(columns=[Name Age], filters=1)
+//	  SortValues(by=[Age], ascending=[false])
+func (lf *LazyFrame) Explain() string {
+	if lf == nil || lf.df == nil {
+		return "LazyFrame(nil)"
+	}
+
+	var b strings.Builder
+	b.WriteString("Plan:\n")
+	if len(lf.ops) == 0 {
+		b.WriteString("  (empty)\n")
+	}
+	for _, op := range lf.ops {
+		switch op.kind {
+		case lazyFilter:
+			b.WriteString("  Filter(mask)\n")
+		case lazySort:
+			fmt.Fprintf(&b, "  %s\n", describeSort(op.sort))
+		case lazySelect:
+			fmt.Fprintf(&b, "  Select(%v)\n", op.columns)
+		}
+	}
+
+	lf.df.RLock()
+	plan, err := lf.optimize()
+	lf.df.RUnlock()
+
+	b.WriteString("Optimized:\n")
+	if err != nil {
+		fmt.Fprintf(&b, "  invalid plan: %v\n", err)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "  Scan(columns=%v, filters=%d)\n", plan.scan, len(plan.masks))
+	for _, opts := range plan.sorts {
+		fmt.Fprintf(&b, "  %s\n", describeSort(opts))
+	}
+	if !stringSlicesEqual(plan.scan, plan.project) {
+		fmt.Fprintf(&b, "  Select(%v)\n", plan.project)
+	}
+	return b.String()
+}
+
+// describeSort formats sort options for Explain.
+func describeSort(opts SortOptions) string {
+	asc := opts.Ascending
+	if len(asc) == 0 {
+		asc = []bool{true}
+	}
+	return fmt.Sprintf("SortValues(by=%v, ascending=%v)", opts.By, asc)
+}
+
+// combineMasks ANDs boolean masks of the given length into a single BoolSeries.
+// Null mask entries count as false.
+func combineMasks(masks []collection.Series, rowCount int) (collection.Series, error) {
+	keep := make([]bool, rowCount)
+	for i := range keep {
+		keep[i] = true
+	}
+	for n, mask := range masks {
+		if mask.Len() != rowCount {
+			return nil, fmt.Errorf("Filter %d: mask length %d does not match row count %d", n, mask.Len(), rowCount)
+		}
+		for i := 0; i < rowCount; i++ {
+			if !keep[i] {
+				continue
+			}
+			if mask.IsNull(i) {
+				keep[i] = false
+				continue
+			}
+			val, err := mask.At(i)
+			if err != nil {
+				return nil, fmt.Errorf("Filter %d: %w", n, err)
+			}
+			b, ok := val.(bool)
+			if !ok {
+				return nil, fmt.Errorf("Filter %d: mask value at row %d is %T, not bool", n, i, val)
+			}
+			keep[i] = b
+		}
+	}
+	return collection.NewBoolSeriesFromData(keep, nil)
+}
+
+// stringSlicesEqual reports whether a and b hold the same strings in order.
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
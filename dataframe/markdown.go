@@ -0,0 +1,129 @@
+package dataframe
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// WriteMarkdown streams the DataFrame to w as a GitHub-flavoured Markdown
+// table, one line per row. Unlike String, every row is written. The header
+// row holds the column names in ColumnOrder, preceded by the index when
+// IndexName is set; numeric columns are right-aligned. Null values are
+// written as "null", and "|" characters and newlines inside values are
+// escaped so that they cannot break the table.
+//
+// This is analogous to df.to_markdown(buf) in pandas.
+//
+// Example:
+//
+//	var b strings.Builder
+//	err := df.WriteMarkdown(&b)
+//	// | Name  | Age |
+//	// |:------|----:|
+//	// | Alice |  30 |
+func (df *DataFrame) WriteMarkdown(w io.Writer) error {
+	if df == nil {
+		return errors.New("WriteMarkdown: DataFrame is nil")
+	}
+	if w == nil {
+		return errors.New("WriteMarkdown: writer is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	showIndex := df.IndexName != ""
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+
+	// Format every cell first so that columns can be padded to a common width.
+	width := len(df.ColumnOrder)
+	if showIndex {
+		width++
+	}
+	header := make([]string, 0, width)
+	numeric := make([]bool, 0, width)
+	if showIndex {
+		header = append(header, escapeMarkdown(df.IndexName))
+		numeric = append(numeric, false)
+	}
+	for _, name := range df.ColumnOrder {
+		header = append(header, escapeMarkdown(name))
+		numeric = append(numeric, isNumericSeries(df.Columns[name]))
+	}
+	widths := make([]int, width)
+	for j, h := range header {
+		widths[j] = max(utf8.RuneCountInString(h), 3)
+	}
+	rows := make([][]string, rowCount)
+	for r := range rows {
+		row := make([]string, 0, width)
+		if showIndex {
+			row = append(row, escapeMarkdown(df.indexLabel(r)))
+		}
+		for _, name := range df.ColumnOrder {
+			series := df.Columns[name]
+			if series.IsNull(r) {
+				row = append(row, "null")
+				continue
+			}
+			val, err := series.At(r)
+			if err != nil {
+				return fmt.Errorf("WriteMarkdown: column '%s' row %d: %w", name, r, err)
+			}
+			row = append(row, escapeMarkdown(fmt.Sprintf("%v", val)))
+		}
+		for j, cell := range row {
+			widths[j] = max(widths[j], utf8.RuneCountInString(cell))
+		}
+		rows[r] = row
+	}
+
+	bw := bufio.NewWriter(w)
+	writeRow := func(cells []string) {
+		bw.WriteByte('|')
+		for j, cell := range cells {
+			pad := strings.Repeat(" ", widths[j]-utf8.RuneCountInString(cell))
+			if numeric[j] {
+				fmt.Fprintf(bw, " %s%s |", pad, cell)
+			} else {
+				fmt.Fprintf(bw, " %s%s |", cell, pad)
+			}
+		}
+		bw.WriteByte('\n')
+	}
+
+	writeRow(header)
+	bw.WriteByte('|')
+	for j := range header {
+		if numeric[j] {
+			fmt.Fprintf(bw, "%s:|", strings.Repeat("-", widths[j]+1))
+		} else {
+			fmt.Fprintf(bw, ":%s|", strings.Repeat("-", widths[j]+1))
+		}
+	}
+	bw.WriteByte('\n')
+	for _, row := range rows {
+		writeRow(row)
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("WriteMarkdown: %w", err)
+	}
+	return nil
+}
+
+// escapeMarkdown escapes the characters that would end a Markdown table cell.
+func escapeMarkdown(s string) string {
+	if !strings.ContainsAny(s, "|\r\n") {
+		return s
+	}
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.NewReplacer("\n", "<br>", "\r", "<br>").Replace(s)
+}
@@ -3,6 +3,7 @@ package dataframe
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -40,18 +41,18 @@ func (df *DataFrame) FillNA(value any) (*DataFrame, error) {
 			newCols[name] = series
 			continue
 		}
-		filled, err := fillSeries(series, coerced)
+		filled, err := collection.FillValue(series, coerced)
 		if err != nil {
 			return nil, fmt.Errorf("FillNA: column '%s': %w", name, err)
 		}
 		newCols[name] = filled
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // FillNAColumn returns a new DataFrame with null values in a single column
@@ -83,7 +84,7 @@ func (df *DataFrame) FillNAColumn(column string, value any) (*DataFrame, error)
 	if !ok {
 		return nil, fmt.Errorf("FillNAColumn: value of type %T is incompatible with column '%s'", value, column)
 	}
-	filled, err := fillSeries(series, coerced)
+	filled, err := collection.FillValue(series, coerced)
 	if err != nil {
 		return nil, fmt.Errorf("FillNAColumn: column '%s': %w", column, err)
 	}
@@ -94,11 +95,133 @@ func (df *DataFrame) FillNAColumn(column string, value any) (*DataFrame, error)
 	}
 	newCols[column] = filled
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
+}
+
+// FillNAValues returns a new DataFrame with null values replaced by value,
+// or, for the columns named in columnValues, by the value given there. A nil
+// value leaves the columns not named in columnValues unchanged, so
+// FillNAValues(nil, map[string]any{"A": 0}) fills column A only.
+//
+// Unlike FillNA, every fill value must be usable for its column: values are
+// coerced to the column's dtype as in FillNA (an int64 fills a float64
+// column), and an error is returned if a value is incompatible, such as a
+// non-string for a string column or a fractional float for an integer
+// column, or if columnValues names a missing column.
+// Filled columns keep their Series type.
+//
+// This is analogous to df.fillna(value) and df.fillna({col: value}) in pandas.
+//
+// Example:
+//
+//	filled, err := df.FillNAValues(0, map[string]any{"Name": "unknown"})
+func (df *DataFrame) FillNAValues(value any, columnValues map[string]any) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("FillNAValues: DataFrame is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	fills, err := df.resolveFillValues("FillNAValues", value, columnValues)
+	if err != nil {
+		return nil, err
+	}
+
+	newCols := make(map[string]collection.Series, len(df.Columns))
+	for name, series := range df.Columns {
+		fill, ok := fills[name]
+		if !ok {
+			newCols[name] = series
+			continue
+		}
+		filled, err := collection.FillValue(series, fill)
+		if err != nil {
+			return nil, fmt.Errorf("FillNAValues: column '%s': %w", name, err)
+		}
+		newCols[name] = filled
+	}
+
+	return df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: append([]string(nil), df.ColumnOrder...),
+		Index:       append([]string(nil), df.Index...),
+	}, nil), nil
+}
+
+// FillNAInPlace is the in-place form of FillNAValues: it sets the null cells
+// of the existing Series and returns nil on success. All fill values are
+// checked before any cell is written, so on error the DataFrame is left
+// unchanged.
+//
+// This is analogous to df.fillna(value, inplace=True) in pandas.
+//
+// Example:
+//
+//	err := df.FillNAInPlace(0.0, nil)
+func (df *DataFrame) FillNAInPlace(value any, columnValues map[string]any) error {
+	if df == nil {
+		return errors.New("FillNAInPlace: DataFrame is nil")
+	}
+
+	df.Lock()
+	defer df.Unlock()
+
+	fills, err := df.resolveFillValues("FillNAInPlace", value, columnValues)
+	if err != nil {
+		return err
+	}
+	// Validate against the element type before mutating anything.
+	for name, fill := range fills {
+		if _, err := collection.FillValue(df.Columns[name], fill); err != nil {
+			return fmt.Errorf("FillNAInPlace: column '%s': %w", name, err)
+		}
+	}
+
+	for name, fill := range fills {
+		series := df.Columns[name]
+		for i := 0; i < series.Len(); i++ {
+			if !series.IsNull(i) {
+				continue
+			}
+			if err := series.Set(i, fill); err != nil {
+				return fmt.Errorf("FillNAInPlace: column '%s' row %d: %w", name, i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveFillValues maps each column to fill to its value coerced to the
+// column's dtype, with columnValues overriding value. op prefixes error
+// messages. Must be called with a lock held.
+func (df *DataFrame) resolveFillValues(op string, value any, columnValues map[string]any) (map[string]any, error) {
+	for name := range columnValues {
+		if _, ok := df.Columns[name]; !ok {
+			return nil, fmt.Errorf("%s: column '%s' not found", op, name)
+		}
+	}
+
+	fills := make(map[string]any, len(df.Columns))
+	for _, name := range df.ColumnOrder {
+		fill, ok := columnValues[name]
+		if !ok {
+			fill = value
+		}
+		if fill == nil {
+			continue
+		}
+		coerced, ok := coerceForSeries(df.Columns[name], fill)
+		if !ok {
+			return nil, fmt.Errorf("%s: value of type %T is incompatible with column '%s' (%s)", op, fill, name, dtypeName(df.Columns[name].DType()))
+		}
+		fills[name] = coerced
+	}
+	return fills, nil
 }
 
 // FillNAMethod returns a new DataFrame with null values filled by propagation.
@@ -126,53 +249,161 @@ func (df *DataFrame) FillNAMethod(method string) (*DataFrame, error) {
 
 	newCols := make(map[string]collection.Series, len(df.Columns))
 	for name, series := range df.Columns {
-		clone, err := series.Slice(0, series.Len())
+		var (
+			filled collection.Series
+			err    error
+		)
+		if method == "ffill" {
+			filled, err = collection.FillForward(series)
+		} else { // bfill
+			filled, err = collection.FillBackward(series)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("FillNAMethod: column '%s': %w", name, err)
 		}
-		n := clone.Len()
+		newCols[name] = filled
+	}
 
-		if method == "ffill" {
-			var last any
-			haveLast := false
-			for i := 0; i < n; i++ {
-				if clone.IsNull(i) {
-					if haveLast {
-						if err := clone.Set(i, last); err != nil {
-							return nil, fmt.Errorf("FillNAMethod: column '%s': %w", name, err)
-						}
-					}
-				} else {
-					v, _ := clone.At(i)
-					last = v
-					haveLast = true
-				}
+	return df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: append([]string(nil), df.ColumnOrder...),
+		Index:       append([]string(nil), df.Index...),
+	}, nil), nil
+}
+
+// InterpolateColumn returns a new DataFrame with the null values of a single
+// Float64 or Int64 column filled from their non-null neighbours. Rows are
+// treated as equally spaced. Other columns are referenced unchanged.
+//
+// The method is one of:
+//   - "linear": interpolate linearly between the previous and next values
+//   - "nearest": use the closer of the previous and next values (ties use the
+//     previous value)
+//   - "pad": forward fill from the previous value
+//   - "backfill": backward fill from the next value
+//
+// "linear" and "nearest" only fill gaps that have a value on both sides, so
+// leading and trailing nulls stay null. limit caps how many consecutive nulls
+// are filled in each gap, counting from the previous value (from the next
+// value for "backfill"); limit <= 0 means no limit. With "linear" an Int64
+// column becomes a Float64 column; the other methods keep the column type and
+// copy neighbouring int64 values exactly, even beyond 2^53.
+//
+// This is analogous to df["col"].interpolate(method=..., limit=...) in pandas.
+//
+// Example:
+//
+//	filled, err := df.InterpolateColumn("Temperature", "linear", 3)
+func (df *DataFrame) InterpolateColumn(column string, method string, limit int) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("InterpolateColumn: DataFrame is nil")
+	}
+	switch method {
+	case "linear", "nearest", "pad", "backfill":
+	default:
+		return nil, fmt.Errorf("InterpolateColumn: method must be 'linear', 'nearest', 'pad' or 'backfill', got '%s'", method)
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	series, ok := df.Columns[column]
+	if !ok {
+		return nil, fmt.Errorf("InterpolateColumn: column '%s' not found", column)
+	}
+	kind := series.DType().Kind()
+	if kind != reflect.Float64 && kind != reflect.Int64 {
+		return nil, fmt.Errorf("InterpolateColumn: column '%s' must be float64 or int64, got %s", column, dtypeName(series.DType()))
+	}
+
+	// Non-linear methods copy an existing value, so source records which row
+	// each position takes its value from. Int64 results are then built from
+	// the original int64 values rather than through float64, which would
+	// round values beyond 2^53.
+	n := series.Len()
+	values := make([]float64, n)
+	source := make([]int, n)
+	mask := series.MaskCopy()
+	for i := 0; i < n; i++ {
+		source[i] = i
+		if !mask[i] {
+			v, _ := series.At(i)
+			values[i], _ = toFloat64(v)
+		}
+	}
+
+	for start := 0; start < n; start++ {
+		if !mask[start] {
+			continue
+		}
+		end := start
+		for end+1 < n && mask[end+1] {
+			end++
+		}
+		prev, next := start-1, end+1
+		hasPrev, hasNext := prev >= 0, next < n
+
+		from, to := start, end
+		if limit > 0 {
+			if method == "backfill" {
+				from = max(start, end-limit+1)
+			} else {
+				to = min(end, start+limit-1)
 			}
-		} else { // bfill
-			var next any
-			haveNext := false
-			for i := n - 1; i >= 0; i-- {
-				if clone.IsNull(i) {
-					if haveNext {
-						if err := clone.Set(i, next); err != nil {
-							return nil, fmt.Errorf("FillNAMethod: column '%s': %w", name, err)
-						}
-					}
+		}
+		for i := from; i <= to; i++ {
+			switch {
+			case method == "pad" && hasPrev:
+				source[i] = prev
+			case method == "backfill" && hasNext:
+				source[i] = next
+			case method == "linear" && hasPrev && hasNext:
+				frac := float64(i-prev) / float64(next-prev)
+				values[i] = values[prev] + frac*(values[next]-values[prev])
+			case method == "nearest" && hasPrev && hasNext:
+				if i-prev <= next-i {
+					source[i] = prev
 				} else {
-					v, _ := clone.At(i)
-					next = v
-					haveNext = true
+					source[i] = next
 				}
+			default:
+				continue
 			}
+			values[i] = values[source[i]]
+			mask[i] = false
 		}
-		newCols[name] = clone
+		start = end
 	}
 
-	return &DataFrame{
+	var filled collection.Series
+	var err error
+	if kind == reflect.Int64 && method != "linear" {
+		ints := make([]int64, n)
+		for i := range ints {
+			if !mask[i] {
+				v, _ := series.At(source[i])
+				ints[i], _ = v.(int64)
+			}
+		}
+		filled, err = collection.NewInt64SeriesFromData(ints, mask)
+	} else {
+		filled, err = collection.NewFloat64SeriesFromData(values, mask)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("InterpolateColumn: column '%s': %w", column, err)
+	}
+
+	newCols := make(map[string]collection.Series, len(df.Columns))
+	for name, s := range df.Columns {
+		newCols[name] = s
+	}
+	newCols[column] = filled
+
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // DropNA returns a new DataFrame with rows containing null values removed.
@@ -246,6 +477,129 @@ func (df *DataFrame) DropNA(how string, subset []string) (*DataFrame, error) {
 	return df.Slice(keep)
 }
 
+// DropNAAxis is the general form of DropNA: it removes rows (axis 0) or
+// columns (axis 1) containing null values.
+//
+// Parameters:
+//   - axis: 0 drops rows, 1 drops columns.
+//   - how: "any" (default) drops a row or column if any considered cell is
+//     null; "all" drops it only if every considered cell is null.
+//   - thresh: if > 0, keep only rows or columns with at least thresh non-null
+//     considered cells; how is then ignored.
+//   - subset: the cells to consider. For axis 0 these are column names, for
+//     axis 1 index labels. If empty, all cells are considered.
+//
+// Only the null masks are read; no values are materialised. Index labels of
+// surviving rows are preserved. Dropping columns returns a view whose Series
+// are shared with df (see View).
+//
+// This is analogous to df.dropna(axis=..., how=..., thresh=..., subset=...) in
+// pandas.
+//
+// Example:
+//
+//	cleaned, err := df.DropNAAxis(0, "any", 0, []string{"Age"})
+//	dense, err := df.DropNAAxis(1, "", 3, nil) // keep columns with >= 3 values
+func (df *DataFrame) DropNAAxis(axis int, how string, thresh int, subset []string) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("DropNAAxis: DataFrame is nil")
+	}
+	if axis != 0 && axis != 1 {
+		return nil, fmt.Errorf("DropNAAxis: axis must be 0 or 1, got %d", axis)
+	}
+	if how == "" {
+		how = "any"
+	}
+	if how != "any" && how != "all" {
+		return nil, fmt.Errorf("DropNAAxis: how must be 'any' or 'all', got '%s'", how)
+	}
+	if thresh < 0 {
+		return nil, fmt.Errorf("DropNAAxis: thresh must be >= 0, got %d", thresh)
+	}
+
+	// keepCells decides whether a row or column with nonNull of total
+	// considered cells survives.
+	keepCells := func(nonNull, total int) bool {
+		switch {
+		case thresh > 0:
+			return nonNull >= thresh
+		case how == "all":
+			return total == 0 || nonNull > 0
+		default:
+			return nonNull == total
+		}
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+
+	if axis == 0 {
+		cols, err := df.resolveSubset(subset, "DropNAAxis")
+		if err != nil {
+			return nil, err
+		}
+		keep := make([]int, 0, rowCount)
+		for i := 0; i < rowCount; i++ {
+			nonNull := 0
+			for _, c := range cols {
+				if !df.Columns[c].IsNull(i) {
+					nonNull++
+				}
+			}
+			if keepCells(nonNull, len(cols)) {
+				keep = append(keep, i)
+			}
+		}
+		return df.sliceLocked(keep)
+	}
+
+	rows := make([]int, 0, rowCount)
+	if len(subset) == 0 {
+		for i := 0; i < rowCount; i++ {
+			rows = append(rows, i)
+		}
+	} else {
+		positions := make(map[string][]int, len(df.Index))
+		for i, label := range df.Index {
+			positions[label] = append(positions[label], i)
+		}
+		for _, label := range subset {
+			pos, ok := positions[label]
+			if !ok {
+				return nil, fmt.Errorf("DropNAAxis: index label '%s' not found", label)
+			}
+			rows = append(rows, pos...)
+		}
+	}
+
+	newCols := make(map[string]collection.Series)
+	columnOrder := make([]string, 0, len(df.ColumnOrder))
+	for _, name := range df.ColumnOrder {
+		series := df.Columns[name]
+		nonNull := 0
+		for _, i := range rows {
+			if !series.IsNull(i) {
+				nonNull++
+			}
+		}
+		if keepCells(nonNull, len(rows)) {
+			newCols[name] = series
+			columnOrder = append(columnOrder, name)
+		}
+	}
+
+	return df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: columnOrder,
+		Index:       append([]string(nil), df.Index...),
+	}, nil), nil
+}
+
 // IsNA returns a new DataFrame of booleans where each cell is true if the
 // corresponding cell in the original DataFrame is null.
 //
@@ -262,6 +616,64 @@ func (df *DataFrame) NotNA() *DataFrame {
 	return df.nullMaskFrame(true)
 }
 
+// NullMask returns a new DataFrame with the same ColumnOrder and Index where
+// every column is a BoolSeries that is true where the original cell is null.
+// It is equivalent to IsNA.
+//
+// Example:
+//
+//	mask := df.NullMask()
+//	missing, _ := mask.Columns["Age"].At(2) // true if Age is null in row 2
+func (df *DataFrame) NullMask() *DataFrame {
+	return df.nullMaskFrame(false)
+}
+
+// NullCounts returns the number of null values per column (axis 0), keyed by
+// column name, or per row (axis 1), keyed by index label. An error is returned
+// for any other axis, or for axis 1 when the index has duplicate labels, since
+// their counts could not be told apart.
+//
+// This is analogous to df.isna().sum(axis=axis) in pandas.
+//
+// Example:
+//
+//	perColumn, err := df.NullCounts(0)
+//	perRow, err := df.NullCounts(1)
+func (df *DataFrame) NullCounts(axis int) (map[string]int, error) {
+	if df == nil {
+		return nil, errors.New("NullCounts: DataFrame is nil")
+	}
+	if axis != 0 && axis != 1 {
+		return nil, fmt.Errorf("NullCounts: axis must be 0 or 1, got %d", axis)
+	}
+	if axis == 0 {
+		return df.NullCount(), nil
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	rowCount := 0
+	if len(df.ColumnOrder) > 0 {
+		rowCount = df.Columns[df.ColumnOrder[0]].Len()
+	}
+	out := make(map[string]int, rowCount)
+	for i := 0; i < rowCount; i++ {
+		label := df.indexLabel(i)
+		if _, dup := out[label]; dup {
+			return nil, fmt.Errorf("NullCounts: duplicate index label '%s'", label)
+		}
+		count := 0
+		for _, name := range df.ColumnOrder {
+			if df.Columns[name].IsNull(i) {
+				count++
+			}
+		}
+		out[label] = count
+	}
+	return out, nil
+}
+
 // nullMaskFrame builds a boolean DataFrame from the null mask. When negate is
 // true the values are inverted (used by NotNA).
 func (df *DataFrame) nullMaskFrame(negate bool) *DataFrame {
@@ -293,34 +705,18 @@ func (df *DataFrame) nullMaskFrame(negate bool) *DataFrame {
 		newCols[name] = s
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}
-}
-
-// fillSeries clones a series and replaces its null values with the given
-// (already coerced) value.
-func fillSeries(series collection.Series, value any) (collection.Series, error) {
-	clone, err := series.Slice(0, series.Len())
-	if err != nil {
-		return nil, err
-	}
-	n := clone.Len()
-	for i := 0; i < n; i++ {
-		if clone.IsNull(i) {
-			if err := clone.Set(i, value); err != nil {
-				return nil, err
-			}
-		}
-	}
-	return clone, nil
+	}, nil)
 }
 
 // coerceForSeries converts a fill value to the series' dtype where possible.
 // It returns the coerced value and true on success, or (nil, false) if the value
-// is incompatible with the column type. Untyped (any) series accept any value.
+// is incompatible with the column type or would lose precision, such as a
+// fractional, NaN or infinite float or an int outside the int32 range for an
+// integer column. Untyped (any) series accept any value.
 func coerceForSeries(series collection.Series, value any) (any, bool) {
 	dt := series.DType()
 	if dt == nil {
@@ -332,15 +728,33 @@ func coerceForSeries(series collection.Series, value any) (any, bool) {
 			return f, true
 		}
 		return nil, false
+	case reflect.Float32:
+		if f, ok := toFloat64(value); ok {
+			return float32(f), true
+		}
+		return nil, false
 	case reflect.Int64, reflect.Int, reflect.Int32, reflect.Int16, reflect.Int8:
+		var i int64
 		switch value.(type) {
 		case int, int64, int32, int16, int8:
-			return toInt64(value), true
+			i = toInt64(value)
 		case float64, float32:
+			// Only whole floats within the int64 range convert without loss.
 			f, _ := toFloat64(value)
-			return int64(f), true
+			if f != math.Trunc(f) || f < -(1<<63) || f >= 1<<63 {
+				return nil, false
+			}
+			i = int64(f)
+		default:
+			return nil, false
 		}
-		return nil, false
+		if dt.Kind() == reflect.Int32 {
+			if i < math.MinInt32 || i > math.MaxInt32 {
+				return nil, false
+			}
+			return int32(i), true
+		}
+		return i, true
 	case reflect.String:
 		if s, ok := value.(string); ok {
 			return s, true
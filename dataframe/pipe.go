@@ -1,6 +1,13 @@
 package dataframe
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+)
 
 // Pipe applies fn to the DataFrame and returns its result, enabling fluent
 // method-chaining of custom operations. It is equivalent to calling fn(df) but
@@ -24,3 +31,188 @@ func (df *DataFrame) Pipe(fn func(*DataFrame) (*DataFrame, error)) (*DataFrame,
 	}
 	return fn(df)
 }
+
+// PipeTo writes the DataFrame to w in the given format, ending a pipeline
+// without building the output as a string first:
+//   - "csv": WriteCSV with default options
+//   - "json": WriteJSON with "records" orientation
+//   - "markdown": WriteMarkdown
+//   - "table": the ASCII table produced by String
+//
+// Example:
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//	    w.Header().Set("Content-Type", "text/csv")
+//	    if err := df.PipeTo(w, "csv"); err != nil {
+//	        log.Print(err)
+//	    }
+//	}
+func (df *DataFrame) PipeTo(w io.Writer, format string) error {
+	if df == nil {
+		return errors.New("PipeTo: DataFrame is nil")
+	}
+	if w == nil {
+		return errors.New("PipeTo: writer is nil")
+	}
+	switch format {
+	case "csv":
+		return df.WriteCSV(w, CSVWriteOptions{})
+	case "json":
+		return df.WriteJSON(w, "records")
+	case "markdown":
+		return df.WriteMarkdown(w)
+	case "table":
+		df.RLock()
+		defer df.RUnlock()
+		if _, err := io.WriteString(w, df.String()); err != nil {
+			return fmt.Errorf("PipeTo: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("PipeTo: unsupported format '%s' (use csv, json, markdown or table)", format)
+	}
+}
+
+// Pipeline is a reusable sequence of DataFrame transformations. Steps are
+// applied in the order they were added. The zero value is an empty pipeline
+// ready to use.
+//
+// Example:
+//
+//	clean := dataframe.NewPipeline().
+//	    StepNamed("dropNulls", dropNulls).
+//	    Step(normalize)
+//	result, err := clean.Run(df)
+//	log.Printf("ran %s", clean) // Pipeline[dropNulls -> main.normalize]
+type Pipeline struct {
+	steps []func(*DataFrame) (*DataFrame, error)
+	names []string
+}
+
+// NewPipeline returns an empty Pipeline.
+func NewPipeline() *Pipeline {
+	return &Pipeline{}
+}
+
+// Step appends fn to the pipeline and returns the pipeline for chaining. The
+// step is described by the name of fn's Go function in String.
+func (p *Pipeline) Step(fn func(*DataFrame) (*DataFrame, error)) *Pipeline {
+	return p.StepNamed(funcName(fn), fn)
+}
+
+// StepNamed appends fn to the pipeline under the given name, which is used by
+// String and in error messages, and returns the pipeline for chaining.
+func (p *Pipeline) StepNamed(name string, fn func(*DataFrame) (*DataFrame, error)) *Pipeline {
+	p.steps = append(p.steps, fn)
+	p.names = append(p.names, name)
+	return p
+}
+
+// Then returns a new Pipeline that runs the steps of p followed by the steps
+// of next. Neither p nor next is modified.
+func (p *Pipeline) Then(next *Pipeline) *Pipeline {
+	out := &Pipeline{}
+	for _, src := range []*Pipeline{p, next} {
+		if src == nil {
+			continue
+		}
+		out.steps = append(out.steps, src.steps...)
+		out.names = append(out.names, src.names...)
+	}
+	return out
+}
+
+// Len returns the number of steps in the pipeline.
+func (p *Pipeline) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.steps)
+}
+
+// Run applies each step to the result of the previous one, starting with df,
+// and returns the final DataFrame. It stops at the first step that returns an
+// error; the error names the failing step.
+func (p *Pipeline) Run(df *DataFrame) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("Pipeline: DataFrame is nil")
+	}
+	if p == nil {
+		return df, nil
+	}
+	cur := df
+	for i, fn := range p.steps {
+		next, err := p.runStep(i, fn, cur)
+		if err != nil {
+			return nil, err
+		}
+		cur = next
+	}
+	return cur, nil
+}
+
+// RunAll works like Run, but keeps going when a step fails: a failing step is
+// skipped and the next step receives the last successful result. It returns
+// that DataFrame together with all step errors joined by errors.Join, or a nil
+// error if every step succeeded.
+func (p *Pipeline) RunAll(df *DataFrame) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("Pipeline: DataFrame is nil")
+	}
+	if p == nil {
+		return df, nil
+	}
+	cur := df
+	var errs []error
+	for i, fn := range p.steps {
+		next, err := p.runStep(i, fn, cur)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		cur = next
+	}
+	return cur, errors.Join(errs...)
+}
+
+// runStep applies the i-th step to df, wrapping any error with the step name.
+func (p *Pipeline) runStep(i int, fn func(*DataFrame) (*DataFrame, error), df *DataFrame) (*DataFrame, error) {
+	if fn == nil {
+		return nil, fmt.Errorf("Pipeline: step %d (%s): fn must not be nil", i, p.names[i])
+	}
+	out, err := fn(df)
+	if err != nil {
+		return nil, fmt.Errorf("Pipeline: step %d (%s): %w", i, p.names[i], err)
+	}
+	if out == nil {
+		return nil, fmt.Errorf("Pipeline: step %d (%s) returned a nil DataFrame", i, p.names[i])
+	}
+	return out, nil
+}
+
+// String describes the pipeline as its step names in order, e.g.
+// "Pipeline[dropNulls -> main.normalize]", for logging.
+func (p *Pipeline) String() string {
+	if p == nil {
+		return "Pipeline[]"
+	}
+	return "Pipeline[" + strings.Join(p.names, " -> ") + "]"
+}
+
+// funcName returns the package-qualified name of a Go function without its
+// import path, such as "main.normalize" or "main.main.func1" for a closure.
+func funcName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if !v.IsValid() || v.IsNil() {
+		return "<nil>"
+	}
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return "<unknown>"
+	}
+	name := f.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
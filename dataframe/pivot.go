@@ -41,15 +41,37 @@ type PivotTableOptions struct {
 	// Default: "mean"
 	AggFunc AggFunc
 
+	// CustomAggFunc, when non-nil, aggregates each cell instead of AggFunc.
+	// It receives the non-null numeric values for one (index, column, value
+	// column) combination, e.g. to compute a geometric mean or a percentile.
+	// It cannot be combined with AggFunc.
+	CustomAggFunc func([]float64) float64
+
 	// FillValue is the value to use for missing combinations.
 	// If nil, missing values will remain null.
 	FillValue any
+
+	// Margins adds a totals row at the bottom and a totals column on the
+	// right (one per value column), aggregated with the same function.
+	Margins bool
+
+	// MarginsName labels the margins row and column. Default: "All".
+	MarginsName string
 }
 
 // PivotTable creates a spreadsheet-style pivot table as a DataFrame.
 //
 // The pivot table aggregates data based on the specified index and columns,
-// applying the aggregation function to the values.
+// applying the aggregation function to the values. With more than one Index
+// column, each result row is labelled by its composite key (the levels joined
+// with "\x00") and the levels are exposed through MultiIndex and IndexLevel.
+//
+// With Margins, a MarginsName column is appended for each value column and a
+// MarginsName row is appended at the bottom; the bottom-right cell holds the
+// grand total. As in pandas, margins aggregate the underlying values rather
+// than the aggregated cells, so a "mean" margin is the mean of all values in
+// that row or column, not the mean of the cell means. The margins row is
+// labelled MarginsName in the first Index column and in Index.
 //
 // Parameters:
 //   - opts: PivotTableOptions configuring the pivot operation
@@ -83,10 +105,23 @@ func (df *DataFrame) PivotTable(opts PivotTableOptions) (*DataFrame, error) {
 		return nil, fmt.Errorf("Values column(s) must be specified")
 	}
 
+	if opts.CustomAggFunc != nil && opts.AggFunc != "" {
+		return nil, fmt.Errorf("AggFunc and CustomAggFunc are mutually exclusive")
+	}
+
 	// Default aggregation function
 	if opts.AggFunc == "" {
 		opts.AggFunc = AggMean
 	}
+	if opts.MarginsName == "" {
+		opts.MarginsName = "All"
+	}
+	aggValues := func(values []float64) float64 {
+		if opts.CustomAggFunc != nil {
+			return opts.CustomAggFunc(values)
+		}
+		return aggregate(values, opts.AggFunc)
+	}
 
 	df.RLock()
 	defer df.RUnlock()
@@ -123,6 +158,9 @@ func (df *DataFrame) PivotTable(opts PivotTableOptions) (*DataFrame, error) {
 		sortedColumnValues = append(sortedColumnValues, v)
 	}
 	sort.Strings(sortedColumnValues)
+	if opts.Margins && columnValues[opts.MarginsName] {
+		return nil, fmt.Errorf("column value '%s' conflicts with the margins name", opts.MarginsName)
+	}
 
 	// Collect unique index combinations
 	indexKeys := make(map[string][]string) // key -> original index values
@@ -148,6 +186,11 @@ func (df *DataFrame) PivotTable(opts PivotTableOptions) (*DataFrame, error) {
 	// Build aggregation data structure
 	// Map: indexKey -> columnValue -> valueColumn -> []values
 	aggData := make(map[string]map[string]map[string][]float64)
+	// Raw values behind the margins: per row, per column and overall, each
+	// keyed by value column.
+	rowMargin := make(map[string]map[string][]float64)
+	colMargin := make(map[string]map[string][]float64)
+	grandMargin := make(map[string][]float64)
 	for i := 0; i < numRows; i++ {
 		// Build index key
 		keyParts := make([]string, len(opts.Index))
@@ -179,6 +222,17 @@ func (df *DataFrame) PivotTable(opts PivotTableOptions) (*DataFrame, error) {
 				floatVal, ok := toFloat64(val)
 				if ok {
 					aggData[indexKey][colValStr][valCol] = append(aggData[indexKey][colValStr][valCol], floatVal)
+					if opts.Margins {
+						if rowMargin[indexKey] == nil {
+							rowMargin[indexKey] = make(map[string][]float64)
+						}
+						if colMargin[colValStr] == nil {
+							colMargin[colValStr] = make(map[string][]float64)
+						}
+						rowMargin[indexKey][valCol] = append(rowMargin[indexKey][valCol], floatVal)
+						colMargin[colValStr][valCol] = append(colMargin[colValStr][valCol], floatVal)
+						grandMargin[valCol] = append(grandMargin[valCol], floatVal)
+					}
 				}
 			}
 		}
@@ -186,30 +240,62 @@ func (df *DataFrame) PivotTable(opts PivotTableOptions) (*DataFrame, error) {
 
 	// Build result DataFrame
 	numResultRows := len(sortedIndexKeys)
+	totalRows := numResultRows
+	if opts.Margins {
+		totalRows++
+	}
 
 	// Create index columns
 	resultCols := make(map[string]collection.Series)
 	for _, col := range opts.Index {
-		resultCols[col], _ = collection.NewStringSeriesFromData(make([]string, numResultRows), nil)
+		resultCols[col], _ = collection.NewStringSeriesFromData(make([]string, totalRows), nil)
 	}
 
 	// Create value columns (for each combination of value column and column value)
 	resultOrder := make([]string, 0, len(opts.Index))
 	resultOrder = append(resultOrder, opts.Index...)
 
+	outColumnValues := sortedColumnValues
+	if opts.Margins {
+		outColumnValues = append(append([]string(nil), sortedColumnValues...), opts.MarginsName)
+	}
+	var hierarchy map[string][2]string
+	if len(opts.Values) > 1 {
+		hierarchy = make(map[string][2]string, len(opts.Values)*len(outColumnValues))
+	}
 	for _, valCol := range opts.Values {
-		for _, colVal := range sortedColumnValues {
+		for _, colVal := range outColumnValues {
 			var colName string
 			if len(opts.Values) == 1 {
 				colName = colVal
 			} else {
 				colName = fmt.Sprintf("%s_%s", valCol, colVal)
+				hierarchy[colName] = [2]string{valCol, colVal}
 			}
-			resultCols[colName], _ = collection.NewFloat64SeriesFromData(make([]float64, numResultRows), nil)
+			resultCols[colName], _ = collection.NewFloat64SeriesFromData(make([]float64, totalRows), nil)
 			resultOrder = append(resultOrder, colName)
 		}
 	}
 
+	// setCell stores the aggregate of values, or the fill value when empty.
+	setCell := func(colName string, rowIdx int, values []float64) {
+		if len(values) == 0 {
+			if opts.FillValue != nil {
+				resultCols[colName].Set(rowIdx, opts.FillValue)
+			} else {
+				resultCols[colName].SetNull(rowIdx)
+			}
+			return
+		}
+		resultCols[colName].Set(rowIdx, aggValues(values))
+	}
+	cellName := func(valCol, colVal string) string {
+		if len(opts.Values) == 1 {
+			return colVal
+		}
+		return fmt.Sprintf("%s_%s", valCol, colVal)
+	}
+
 	// Fill in the data
 	for rowIdx, indexKey := range sortedIndexKeys {
 		// Set index column values
@@ -221,41 +307,287 @@ func (df *DataFrame) PivotTable(opts PivotTableOptions) (*DataFrame, error) {
 		// Set aggregated values
 		for _, valCol := range opts.Values {
 			for _, colVal := range sortedColumnValues {
-				var colName string
-				if len(opts.Values) == 1 {
-					colName = colVal
-				} else {
-					colName = fmt.Sprintf("%s_%s", valCol, colVal)
-				}
+				setCell(cellName(valCol, colVal), rowIdx, aggData[indexKey][colVal][valCol])
+			}
+			if opts.Margins {
+				setCell(cellName(valCol, opts.MarginsName), rowIdx, rowMargin[indexKey][valCol])
+			}
+		}
+	}
 
-				values := aggData[indexKey][colVal][valCol]
-				if len(values) == 0 {
-					if opts.FillValue != nil {
-						resultCols[colName].Set(rowIdx, opts.FillValue)
-					} else {
-						resultCols[colName].SetNull(rowIdx)
-					}
-				} else {
-					aggResult := aggregate(values, opts.AggFunc)
-					resultCols[colName].Set(rowIdx, aggResult)
-				}
+	// Fill the margins row: column totals and the grand total.
+	if opts.Margins {
+		resultCols[opts.Index[0]].Set(numResultRows, opts.MarginsName)
+		for _, valCol := range opts.Values {
+			for _, colVal := range sortedColumnValues {
+				setCell(cellName(valCol, colVal), numResultRows, colMargin[colVal][valCol])
 			}
+			setCell(cellName(valCol, opts.MarginsName), numResultRows, grandMargin[valCol])
 		}
 	}
 
-	// Create index
-	resultIndex := make([]string, numResultRows)
-	for i := 0; i < numResultRows; i++ {
-		resultIndex[i] = fmt.Sprintf("%d", i)
+	// Create index. With several Index columns, each row is labelled by its
+	// composite key and the individual levels are kept in MultiIndex.
+	resultIndex := make([]string, totalRows)
+	var multiIndex [][]string
+	if len(opts.Index) > 1 {
+		multiIndex = make([][]string, totalRows)
+	}
+	for i, indexKey := range sortedIndexKeys {
+		if multiIndex != nil {
+			resultIndex[i] = indexKey
+			multiIndex[i] = append([]string(nil), indexKeys[indexKey]...)
+		} else {
+			resultIndex[i] = fmt.Sprintf("%d", i)
+		}
+	}
+	if opts.Margins {
+		if multiIndex != nil {
+			levels := make([]string, len(opts.Index))
+			levels[0] = opts.MarginsName
+			resultIndex[numResultRows] = strings.Join(levels, "\x00")
+			multiIndex[numResultRows] = levels
+		} else {
+			resultIndex[numResultRows] = opts.MarginsName
+		}
 	}
 
 	return &DataFrame{
-		Columns:     resultCols,
-		ColumnOrder: resultOrder,
-		Index:       resultIndex,
+		Columns:         resultCols,
+		ColumnOrder:     resultOrder,
+		Index:           resultIndex,
+		ColumnHierarchy: hierarchy,
+		MultiIndex:      multiIndex,
 	}, nil
 }
 
+// FlattenColumns returns a new DataFrame in which every column listed in
+// ColumnHierarchy is renamed to its two levels joined by sep. Columns without
+// a hierarchy entry keep their names. The result has no ColumnHierarchy.
+// Column data is shared with the original DataFrame.
+//
+// This is analogous to
+// df.columns = [sep.join(c) for c in df.columns] in pandas.
+//
+// Example:
+//
+//	pivot, _ := df.PivotTable(dataframe.PivotTableOptions{
+//	    Index: []string{"A"}, Columns: "B", Values: []string{"C", "D"},
+//	})
+//	flat := pivot.FlattenColumns(".") // "C.one", "C.two", "D.one", ...
+func (df *DataFrame) FlattenColumns(sep string) *DataFrame {
+	if df == nil {
+		return nil
+	}
+	out := df.copy()
+
+	newCols := make(map[string]collection.Series, len(out.Columns))
+	for i, name := range out.ColumnOrder {
+		newName := name
+		if levels, ok := out.ColumnHierarchy[name]; ok {
+			newName = levels[0] + sep + levels[1]
+		}
+		newCols[newName] = out.Columns[name]
+		out.ColumnOrder[i] = newName
+	}
+	out.Columns = newCols
+	out.ColumnHierarchy = nil
+	return out
+}
+
+// UnflattenColumns is the inverse of FlattenColumns: it returns a new
+// DataFrame in which every column named "<level><sep><value>" is given a
+// ColumnHierarchy entry [level, value] and renamed to "<level>_<value>", the
+// naming PivotTable uses. levels lists the recognised first levels (e.g. the
+// pivot's Values), tried in order; if levels is empty, names are split at the
+// first occurrence of sep. Other columns keep their names. If the pivot-style
+// name would clash with another column, the flat name is kept as the key.
+// Column data is shared with the original DataFrame.
+//
+// Example:
+//
+//	flat := pivot.FlattenColumns(".") // "C.one", "C.two", ...
+//	back := flat.UnflattenColumns(".", []string{"C", "D"}) // "C_one", "C_two", ...
+func (df *DataFrame) UnflattenColumns(sep string, levels []string) *DataFrame {
+	if df == nil {
+		return nil
+	}
+	out := df.copy()
+	if sep == "" {
+		return out
+	}
+
+	split := func(name string) (string, string, bool) {
+		if len(levels) == 0 {
+			level, value, found := strings.Cut(name, sep)
+			return level, value, found && level != "" && value != ""
+		}
+		for _, level := range levels {
+			if value, found := strings.CutPrefix(name, level+sep); found && value != "" {
+				return level, value, true
+			}
+		}
+		return "", "", false
+	}
+
+	hierarchy := copyHierarchy(out.ColumnHierarchy)
+	if hierarchy == nil {
+		hierarchy = make(map[string][2]string)
+	}
+	newCols := make(map[string]collection.Series, len(out.Columns))
+	for i, name := range out.ColumnOrder {
+		newName := name
+		if level, value, ok := split(name); ok {
+			newName = fmt.Sprintf("%s_%s", level, value)
+			if _, clash := out.Columns[newName]; clash && newName != name {
+				newName = name
+			}
+			if _, taken := newCols[newName]; taken {
+				newName = name
+			}
+			hierarchy[newName] = [2]string{level, value}
+		}
+		newCols[newName] = out.Columns[name]
+		out.ColumnOrder[i] = newName
+	}
+	out.Columns = newCols
+	out.ColumnHierarchy = copyHierarchy(hierarchy)
+	return out
+}
+
+// FlattenIndex replaces the hierarchical column names listed in
+// ColumnHierarchy with their levels joined by sep. It is an alias for
+// FlattenColumns; use FlattenRowIndex for the row MultiIndex.
+//
+// Example:
+//
+//	flat := pivot.FlattenIndex(".") // "C.one", "C.two", "D.one", ...
+func (df *DataFrame) FlattenIndex(sep string) *DataFrame {
+	return df.FlattenColumns(sep)
+}
+
+// UnflattenIndex parses flat column names back into a ColumnHierarchy. It is
+// an alias for UnflattenColumns; use UnflattenRowIndex for the row index.
+//
+// Example:
+//
+//	back := flat.UnflattenIndex(".", []string{"C", "D"}) // "C_one", "C_two", ...
+func (df *DataFrame) UnflattenIndex(sep string, levels []string) *DataFrame {
+	return df.UnflattenColumns(sep, levels)
+}
+
+// FlattenRowIndex is the row-index counterpart of FlattenColumns: it returns
+// a new DataFrame whose index labels are the MultiIndex levels of each row
+// joined by sep, with no MultiIndex. A DataFrame with a flat index is
+// returned unchanged (as a copy). Column data is shared with the original
+// DataFrame.
+//
+// This is analogous to df.index = [sep.join(i) for i in df.index] in pandas.
+//
+// Example:
+//
+//	pivot, _ := df.PivotTable(dataframe.PivotTableOptions{
+//	    Index: []string{"Region", "Year"}, Columns: "Product", Values: []string{"Sales"},
+//	})
+//	flat := pivot.FlattenRowIndex("/") // "East/2023", "East/2024", ...
+func (df *DataFrame) FlattenRowIndex(sep string) *DataFrame {
+	if df == nil {
+		return nil
+	}
+	out := df.copy()
+	if out.MultiIndex == nil || len(out.MultiIndex) != len(out.Index) {
+		return out
+	}
+	for i, levels := range out.MultiIndex {
+		out.Index[i] = strings.Join(levels, sep)
+	}
+	out.MultiIndex = nil
+	return out
+}
+
+// UnflattenRowIndex is the inverse of FlattenRowIndex: it returns a new
+// DataFrame whose MultiIndex holds each index label split at sep, so that
+// IndexLevel can address the parts. levels names the index levels; when
+// given, each label is split into at most len(levels) parts (so sep may occur
+// in the last level) and IndexName is set to the names joined by sep. When
+// levels is empty, labels are split at every sep. Labels with fewer parts
+// than the others get empty trailing levels. The index labels themselves are
+// kept. Column data is shared with the original DataFrame.
+//
+// Example:
+//
+//	flat := pivot.FlattenRowIndex("/")                                // "East/2023", ...
+//	back := flat.UnflattenRowIndex("/", []string{"Region", "Year"})
+//	years, _ := back.IndexLevel(1)                                    // "2023", ...
+func (df *DataFrame) UnflattenRowIndex(sep string, levels []string) *DataFrame {
+	if df == nil {
+		return nil
+	}
+	out := df.copy()
+	if sep == "" {
+		return out
+	}
+
+	n := len(levels)
+	multi := make([][]string, len(out.Index))
+	width := n
+	for i, label := range out.Index {
+		if n > 0 {
+			multi[i] = strings.SplitN(label, sep, n)
+		} else {
+			multi[i] = strings.Split(label, sep)
+		}
+		width = max(width, len(multi[i]))
+	}
+	for i, parts := range multi {
+		for len(parts) < width {
+			parts = append(parts, "")
+		}
+		multi[i] = parts
+	}
+	out.MultiIndex = multi
+	if n > 0 {
+		out.IndexName = strings.Join(levels, sep)
+	}
+	return out
+}
+
+// LevelsOf returns the two column levels (value name and column value) of a
+// synthesized column. The boolean is false if col is not part of the
+// DataFrame's ColumnHierarchy.
+//
+// Example:
+//
+//	valueName, colValue, ok := pivot.LevelsOf("C_one") // "C", "one", true
+func (df *DataFrame) LevelsOf(col string) (string, string, bool) {
+	if df == nil {
+		return "", "", false
+	}
+	df.RLock()
+	defer df.RUnlock()
+
+	if _, exists := df.Columns[col]; !exists {
+		return "", "", false
+	}
+	levels, ok := df.ColumnHierarchy[col]
+	if !ok {
+		return "", "", false
+	}
+	return levels[0], levels[1], true
+}
+
+// copyHierarchy returns a copy of a ColumnHierarchy map, or nil if it is empty.
+func copyHierarchy(h map[string][2]string) map[string][2]string {
+	if len(h) == 0 {
+		return nil
+	}
+	out := make(map[string][2]string, len(h))
+	for k, v := range h {
+		out[k] = v
+	}
+	return out
+}
+
 // aggregate applies the aggregation function to a slice of values.
 func aggregate(values []float64, aggFunc AggFunc) float64 {
 	if len(values) == 0 {
@@ -343,7 +675,8 @@ func toFloat64(val any) (float64, bool) {
 // MeltOptions configures the behavior of the Melt function.
 type MeltOptions struct {
 	// IdVars specifies the column(s) to use as identifier variables.
-	// These columns will be kept as-is in the output.
+	// These columns will be kept as-is in the output, in the order they
+	// appear in the DataFrame regardless of the order listed here.
 	IdVars []string
 
 	// ValueVars specifies the column(s) to unpivot.
@@ -357,12 +690,23 @@ type MeltOptions struct {
 	// ValueName is the name for the value column.
 	// Default: "value"
 	ValueName string
+
+	// DropNa drops output rows whose value is null, which avoids a separate
+	// filtering step for sparse data.
+	DropNa bool
+
+	// IgnoreIndex labels the output rows 0, 1, ..., n-1. When false, each
+	// output row keeps the index label of the row it came from, so labels
+	// repeat once per melted column.
+	IgnoreIndex bool
 }
 
 // Melt unpivots a DataFrame from wide to long format.
 //
 // This operation transforms columns into rows, keeping identifier variables
-// fixed while "melting" the specified value columns.
+// fixed while "melting" the specified value columns. Each output row keeps the
+// index label of its source row unless opts.IgnoreIndex is set, and rows with
+// null values are dropped when opts.DropNa is set.
 //
 // Parameters:
 //   - opts: MeltOptions configuring the melt operation
@@ -415,6 +759,15 @@ func (df *DataFrame) Melt(opts MeltOptions) (*DataFrame, error) {
 		idVarsSet[col] = true
 	}
 
+	// Id columns appear in their original column order, whatever order the
+	// caller listed them in.
+	idVars := make([]string, 0, len(idVarsSet))
+	for _, col := range df.ColumnOrder {
+		if idVarsSet[col] {
+			idVars = append(idVars, col)
+		}
+	}
+
 	// Determine ValueVars
 	var valueVars []string
 	if len(opts.ValueVars) == 0 {
@@ -438,16 +791,29 @@ func (df *DataFrame) Melt(opts MeltOptions) (*DataFrame, error) {
 		return nil, fmt.Errorf("no columns to melt")
 	}
 
+	// Collect the (row, value column) pairs that make up the output.
+	type meltCell struct {
+		row    int
+		valCol string
+	}
 	numRows := df.Len()
-	numValueVars := len(valueVars)
-	resultRows := numRows * numValueVars
+	cells := make([]meltCell, 0, numRows*len(valueVars))
+	for i := 0; i < numRows; i++ {
+		for _, valCol := range valueVars {
+			if opts.DropNa && df.Columns[valCol].IsNull(i) {
+				continue
+			}
+			cells = append(cells, meltCell{row: i, valCol: valCol})
+		}
+	}
+	resultRows := len(cells)
 
 	// Create result series
 	resultCols := make(map[string]collection.Series)
-	resultOrder := make([]string, 0, len(opts.IdVars)+2)
+	resultOrder := make([]string, 0, len(idVars)+2)
 
 	// ID columns (will be repeated for each value var)
-	for _, col := range opts.IdVars {
+	for _, col := range idVars {
 		// Determine type from source column
 		srcSeries := df.Columns[col]
 		resultCols[col] = collection.NewSeriesOfTypeWithSize(srcSeries.DType(), resultRows)
@@ -463,40 +829,38 @@ func (df *DataFrame) Melt(opts MeltOptions) (*DataFrame, error) {
 	resultOrder = append(resultOrder, opts.ValueName)
 
 	// Fill in the data
-	resultIdx := 0
-	for i := 0; i < numRows; i++ {
-		for _, valCol := range valueVars {
-			// Copy ID values
-			for _, idCol := range opts.IdVars {
-				srcSeries := df.Columns[idCol]
-				if srcSeries.IsNull(i) {
-					resultCols[idCol].SetNull(resultIdx)
-				} else {
-					val, _ := srcSeries.At(i)
-					resultCols[idCol].Set(resultIdx, val)
-				}
-			}
-
-			// Set variable name
-			resultCols[opts.VarName].Set(resultIdx, valCol)
+	resultIndex := make([]string, resultRows)
+	for resultIdx, cell := range cells {
+		i := cell.row
 
-			// Set value
-			srcSeries := df.Columns[valCol]
+		// Copy ID values
+		for _, idCol := range idVars {
+			srcSeries := df.Columns[idCol]
 			if srcSeries.IsNull(i) {
-				resultCols[opts.ValueName].AppendNull()
+				resultCols[idCol].SetNull(resultIdx)
 			} else {
 				val, _ := srcSeries.At(i)
-				resultCols[opts.ValueName].Append(val)
+				resultCols[idCol].Set(resultIdx, val)
 			}
+		}
+
+		// Set variable name
+		resultCols[opts.VarName].Set(resultIdx, cell.valCol)
 
-			resultIdx++
+		// Set value
+		srcSeries := df.Columns[cell.valCol]
+		if srcSeries.IsNull(i) {
+			resultCols[opts.ValueName].AppendNull()
+		} else {
+			val, _ := srcSeries.At(i)
+			resultCols[opts.ValueName].Append(val)
 		}
-	}
 
-	// Create index
-	resultIndex := make([]string, resultRows)
-	for i := 0; i < resultRows; i++ {
-		resultIndex[i] = fmt.Sprintf("%d", i)
+		if opts.IgnoreIndex {
+			resultIndex[resultIdx] = fmt.Sprintf("%d", resultIdx)
+		} else {
+			resultIndex[resultIdx] = df.indexLabel(i)
+		}
 	}
 
 	return &DataFrame{
@@ -505,3 +869,177 @@ func (df *DataFrame) Melt(opts MeltOptions) (*DataFrame, error) {
 		Index:       resultIndex,
 	}, nil
 }
+
+// CrossTabulate computes a frequency table of two columns: one row per unique
+// value of rowColumn and one column per unique value of colColumn, each cell
+// counting the rows with that pair of values. Rows where either value is null
+// are ignored. Unique values are sorted by value (numbers numerically) and
+// formatted with %v for the labels. The result's
+// Index holds the rowColumn values, with IndexName set to rowColumn and
+// ColumnsName to colColumn.
+//
+// normalize controls the cell values:
+//   - "": raw counts (Int64 columns)
+//   - "index": each row is divided by its total, so rows sum to 1
+//   - "columns": each column is divided by its total, so columns sum to 1
+//   - "all": every cell is divided by the grand total
+//
+// With margins, an "All" column and an "All" row holding the row, column and
+// grand totals are added (normalized in the same way as the other cells). An
+// error is returned if either column already holds the value "All".
+//
+// This is analogous to pd.crosstab(df[rowColumn], df[colColumn],
+// normalize=..., margins=...) in pandas.
+//
+// Example:
+//
+//	table, err := df.CrossTabulate("Region", "Product", "", true)
+func (df *DataFrame) CrossTabulate(rowColumn string, colColumn string, normalize string, margins bool) (*DataFrame, error) {
+	if df == nil {
+		return nil, fmt.Errorf("CrossTabulate: DataFrame is nil")
+	}
+	switch normalize {
+	case "", "index", "columns", "all":
+	default:
+		return nil, fmt.Errorf("CrossTabulate: normalize must be '', 'index', 'columns' or 'all', got '%s'", normalize)
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	rowSeries, ok := df.Columns[rowColumn]
+	if !ok {
+		return nil, fmt.Errorf("CrossTabulate: column '%s' not found", rowColumn)
+	}
+	colSeries, ok := df.Columns[colColumn]
+	if !ok {
+		return nil, fmt.Errorf("CrossTabulate: column '%s' not found", colColumn)
+	}
+
+	// counts is keyed by the %v form of each value; rowVals and colVals keep
+	// the typed value behind each key so the keys sort in value order.
+	counts := make(map[string]map[string]int64)
+	rowVals := make(map[string]any)
+	colVals := make(map[string]any)
+	numRows := df.Len()
+	for i := 0; i < numRows; i++ {
+		if rowSeries.IsNull(i) || colSeries.IsNull(i) {
+			continue
+		}
+		rv, _ := rowSeries.At(i)
+		cv, _ := colSeries.At(i)
+		rowKey, colKey := fmt.Sprintf("%v", rv), fmt.Sprintf("%v", cv)
+		if counts[rowKey] == nil {
+			counts[rowKey] = make(map[string]int64)
+			rowVals[rowKey] = rv
+		}
+		counts[rowKey][colKey]++
+		colVals[colKey] = cv
+	}
+
+	rowKeys := sortedValueKeys(rowVals)
+	colKeys := sortedValueKeys(colVals)
+
+	// Build the table as a matrix, with the margins in the last row/column.
+	numR, numC := len(rowKeys), len(colKeys)
+	if margins {
+		if _, ok := rowVals["All"]; ok {
+			return nil, fmt.Errorf("CrossTabulate: row value 'All' conflicts with the margins row")
+		}
+		if _, ok := colVals["All"]; ok {
+			return nil, fmt.Errorf("CrossTabulate: column value 'All' conflicts with the margins column")
+		}
+		numR++
+		numC++
+	}
+	table := make([][]float64, numR)
+	for r := range table {
+		table[r] = make([]float64, numC)
+	}
+	rowTotals := make([]float64, len(rowKeys))
+	colTotals := make([]float64, len(colKeys))
+	var grand float64
+	for r, rk := range rowKeys {
+		for c, ck := range colKeys {
+			n := float64(counts[rk][ck])
+			table[r][c] = n
+			rowTotals[r] += n
+			colTotals[c] += n
+			grand += n
+		}
+	}
+	if margins {
+		for r := range rowKeys {
+			table[r][numC-1] = rowTotals[r]
+		}
+		for c := range colKeys {
+			table[numR-1][c] = colTotals[c]
+		}
+		table[numR-1][numC-1] = grand
+	}
+
+	if normalize != "" {
+		for r := 0; r < numR; r++ {
+			for c := 0; c < numC; c++ {
+				denom := grand
+				switch {
+				case normalize == "index" && r < len(rowKeys):
+					denom = rowTotals[r]
+				case normalize == "columns" && c < len(colKeys):
+					denom = colTotals[c]
+				}
+				if denom != 0 {
+					table[r][c] /= denom
+				}
+			}
+		}
+	}
+
+	index := append([]string(nil), rowKeys...)
+	order := append([]string(nil), colKeys...)
+	if margins {
+		index = append(index, "All")
+		order = append(order, "All")
+	}
+	resultCols := make(map[string]collection.Series, numC)
+	for c, name := range order {
+		if normalize == "" {
+			data := make([]int64, numR)
+			for r := range data {
+				data[r] = int64(table[r][c])
+			}
+			resultCols[name], _ = collection.NewInt64SeriesFromData(data, nil)
+		} else {
+			data := make([]float64, numR)
+			for r := range data {
+				data[r] = table[r][c]
+			}
+			resultCols[name], _ = collection.NewFloat64SeriesFromData(data, nil)
+		}
+	}
+
+	return &DataFrame{
+		Columns:     resultCols,
+		ColumnOrder: order,
+		Index:       index,
+		IndexName:   rowColumn,
+		ColumnsName: colColumn,
+	}, nil
+}
+
+// sortedValueKeys returns the keys of values ordered by their typed values.
+// Keys whose values cannot be compared (mixed types) fall back to string
+// order.
+func sortedValueKeys(values map[string]any) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		if cmp, err := compareForFilter(values[keys[a]], values[keys[b]]); err == nil && cmp != 0 {
+			return cmp < 0
+		}
+		return keys[a] < keys[b]
+	})
+	return keys
+}
@@ -0,0 +1,114 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// Resample groups the rows of a time-indexed DataFrame into time buckets and
+// returns a GroupBy over those buckets, so that chaining Mean, Sum, Min or
+// Max downsamples the data.
+//
+// Every index label is parsed as a datetime, trying the same layouts as
+// ToDatetime (RFC3339, "2006-01-02 15:04:05", "2006-01-02", ...). rule is a
+// pandas frequency string:
+//   - "H": hourly, labelled by the start of the hour
+//   - "D": daily, labelled by the date
+//   - "W": weekly, weeks ending on Sunday, labelled by that Sunday
+//   - "M": monthly, labelled by the last day of the month
+//   - "Q": quarterly, labelled by the last day of the quarter
+//   - "A" or "Y": annually, labelled by December 31st
+//
+// The bucket label is stored in a grouping column named after IndexName (or
+// "index" if IndexName is empty), which becomes the first column of any
+// aggregation. Labels are formatted as "2006-01-02", or "2006-01-02 15:04:05"
+// for hourly buckets, so groups come out in chronological order. Only
+// buckets that contain at least one row appear in the result.
+//
+// This is analogous to df.resample(rule) in pandas.
+//
+// Example:
+//
+//	gb, err := df.Resample("M")
+//	monthly, err := gb.Sum()
+func (df *DataFrame) Resample(rule string) (*GroupBy, error) {
+	if df == nil {
+		return nil, errors.New("Resample: DataFrame is nil")
+	}
+	bucket, err := resampleBucket(rule)
+	if err != nil {
+		return nil, fmt.Errorf("Resample: %w", err)
+	}
+
+	src := df.copy()
+	keyCol := src.IndexName
+	if keyCol == "" {
+		keyCol = "index"
+	}
+	if _, exists := src.Columns[keyCol]; exists {
+		return nil, fmt.Errorf("Resample: column '%s' already exists; set IndexName to another name", keyCol)
+	}
+
+	rowCount := src.Len()
+	if len(src.Index) != rowCount {
+		return nil, fmt.Errorf("Resample: index length %d does not match row count %d", len(src.Index), rowCount)
+	}
+	keys := make([]string, rowCount)
+	for i, label := range src.Index {
+		t, err := parseDateTime(label, "")
+		if err != nil {
+			return nil, fmt.Errorf("Resample: index label at row %d: %w", i, err)
+		}
+		keys[i] = bucket(t)
+	}
+
+	keySeries, err := collection.NewStringSeriesFromData(keys, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Resample: %w", err)
+	}
+	src.Columns[keyCol] = keySeries
+	src.ColumnOrder = append([]string{keyCol}, src.ColumnOrder...)
+
+	return src.GroupBy([]string{keyCol}, 0)
+}
+
+// resampleBucket returns a function mapping a time to its bucket label for
+// the given frequency rule.
+func resampleBucket(rule string) (func(time.Time) string, error) {
+	const dateLayout = "2006-01-02"
+	switch strings.ToUpper(rule) {
+	case "H":
+		return func(t time.Time) string {
+			return t.Truncate(time.Hour).Format("2006-01-02 15:04:05")
+		}, nil
+	case "D":
+		return func(t time.Time) string {
+			return t.Format(dateLayout)
+		}, nil
+	case "W":
+		return func(t time.Time) string {
+			daysToSunday := (7 - int(t.Weekday())) % 7
+			return t.AddDate(0, 0, daysToSunday).Format(dateLayout)
+		}, nil
+	case "M":
+		return func(t time.Time) string {
+			// Day 0 of the next month is the last day of this one.
+			return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Format(dateLayout)
+		}, nil
+	case "Q":
+		return func(t time.Time) string {
+			quarterEnd := time.Month((int(t.Month())-1)/3*3 + 3)
+			return time.Date(t.Year(), quarterEnd+1, 0, 0, 0, 0, 0, t.Location()).Format(dateLayout)
+		}, nil
+	case "A", "Y":
+		return func(t time.Time) string {
+			return time.Date(t.Year(), time.December, 31, 0, 0, 0, 0, t.Location()).Format(dateLayout)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported rule '%s' (use H, D, W, M, Q, A or Y)", rule)
+	}
+}
@@ -13,7 +13,8 @@ import (
 // joining the values of the given columns with the separator (default "_").
 //
 // Unlike pandas' true hierarchical MultiIndex, GPandas represents the composite
-// index as a single joined string label per row. The source columns are kept in
+// index as a single joined string label per row; the individual levels are
+// available through MultiIndex and IndexLevel. The source columns are kept in
 // the DataFrame so the operation is non-destructive and reversible.
 //
 // This is analogous to df.set_index([...]) with a flattened label.
@@ -50,6 +51,7 @@ func (df *DataFrame) SetMultiIndex(columns []string, sep ...string) (*DataFrame,
 	}
 
 	newIndex := make([]string, rowCount)
+	levels := make([][]string, rowCount)
 	for i := 0; i < rowCount; i++ {
 		parts := make([]string, len(columns))
 		for j, c := range columns {
@@ -62,6 +64,7 @@ func (df *DataFrame) SetMultiIndex(columns []string, sep ...string) (*DataFrame,
 			parts[j] = fmt.Sprintf("%v", v)
 		}
 		newIndex[i] = strings.Join(parts, separator)
+		levels[i] = parts
 	}
 
 	// Share column Series (zero-copy); only the index changes.
@@ -74,12 +77,63 @@ func (df *DataFrame) SetMultiIndex(columns []string, sep ...string) (*DataFrame,
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       newIndex,
+		MultiIndex:  levels,
 	}, nil
 }
 
+// IndexLevel returns the labels of level i of the row index, one per row.
+// For a DataFrame with a MultiIndex, level i is the i-th component of each
+// row's composite label. For a flat index, level 0 is the index itself.
+//
+// This is analogous to df.index.get_level_values(i) in pandas.
+//
+// Example:
+//
+//	pivot, _ := df.PivotTable(dataframe.PivotTableOptions{
+//	    Index: []string{"Region", "Year"}, Columns: "Product", Values: []string{"Sales"},
+//	})
+//	years, err := pivot.IndexLevel(1)
+func (df *DataFrame) IndexLevel(i int) ([]string, error) {
+	if df == nil {
+		return nil, errors.New("IndexLevel: DataFrame is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	if df.MultiIndex == nil {
+		if i != 0 {
+			return nil, fmt.Errorf("IndexLevel: level %d out of range for a flat index", i)
+		}
+		return append([]string(nil), df.Index...), nil
+	}
+
+	out := make([]string, len(df.MultiIndex))
+	for r, labels := range df.MultiIndex {
+		if i < 0 || i >= len(labels) {
+			return nil, fmt.Errorf("IndexLevel: level %d out of range (row %d has %d levels)", i, r, len(labels))
+		}
+		out[r] = labels[i]
+	}
+	return out, nil
+}
+
+// copyMultiIndex returns a deep copy of a MultiIndex (nil stays nil).
+func copyMultiIndex(m [][]string) [][]string {
+	if m == nil {
+		return nil
+	}
+	out := make([][]string, len(m))
+	for i, labels := range m {
+		out[i] = append([]string(nil), labels...)
+	}
+	return out
+}
+
 // Stack reshapes the DataFrame from wide to long format, producing a DataFrame
 // with three columns: "index" (the original row label), "variable" (the former
 // column name), and "value" (the cell value). Each non-null cell becomes one row.
+// Use StackWithOptions to rename the columns or keep nulls.
 //
 // This is analogous to df.stack() (with the default dropna behaviour).
 //
@@ -87,9 +141,53 @@ func (df *DataFrame) SetMultiIndex(columns []string, sep ...string) (*DataFrame,
 //
 //	long, err := df.Stack()
 func (df *DataFrame) Stack() (*DataFrame, error) {
+	return df.StackWithOptions(StackOptions{DropNa: true})
+}
+
+// StackOptions configures StackWithOptions.
+type StackOptions struct {
+	// VarName is the name of the column holding the former column names.
+	// Default: "variable".
+	VarName string
+
+	// ValueName is the name of the column holding the cell values.
+	// Default: "value".
+	ValueName string
+
+	// DropNa excludes null cells from the result. When false, every cell
+	// becomes a row and the result has exactly nRows * nCols rows, with null
+	// cells producing nulls in the value column.
+	DropNa bool
+}
+
+// StackWithOptions is like Stack but lets the caller name the variable and
+// value columns and choose whether null cells are kept. The row label column
+// is always named "index"; VarName and ValueName must differ from it and from
+// each other.
+//
+// This is analogous to df.stack(dropna=...) followed by reset_index() in
+// pandas, or to Melt with every column as a value variable and the index as
+// the id variable.
+//
+// Example:
+//
+//	long, err := df.StackWithOptions(dataframe.StackOptions{
+//	    VarName:   "metric",
+//	    ValueName: "reading",
+//	})
+func (df *DataFrame) StackWithOptions(opts StackOptions) (*DataFrame, error) {
 	if df == nil {
 		return nil, errors.New("Stack: DataFrame is nil")
 	}
+	if opts.VarName == "" {
+		opts.VarName = "variable"
+	}
+	if opts.ValueName == "" {
+		opts.ValueName = "value"
+	}
+	if opts.VarName == "index" || opts.ValueName == "index" || opts.VarName == opts.ValueName {
+		return nil, fmt.Errorf("Stack: column names 'index', '%s' and '%s' must be distinct", opts.VarName, opts.ValueName)
+	}
 
 	df.RLock()
 	defer df.RUnlock()
@@ -110,12 +208,17 @@ func (df *DataFrame) Stack() (*DataFrame, error) {
 		}
 		for _, colName := range df.ColumnOrder {
 			series := df.Columns[colName]
+			var v any
 			if series.IsNull(i) {
-				continue // drop nulls, matching pandas default
-			}
-			v, err := series.At(i)
-			if err != nil {
-				return nil, fmt.Errorf("Stack: column '%s' row %d: %w", colName, i, err)
+				if opts.DropNa {
+					continue
+				}
+			} else {
+				var err error
+				v, err = series.At(i)
+				if err != nil {
+					return nil, fmt.Errorf("Stack: column '%s' row %d: %w", colName, i, err)
+				}
 			}
 			indexVals = append(indexVals, label)
 			variableVals = append(variableVals, colName)
@@ -138,11 +241,11 @@ func (df *DataFrame) Stack() (*DataFrame, error) {
 
 	return &DataFrame{
 		Columns: map[string]collection.Series{
-			"index":    indexSeries,
-			"variable": variableSeries,
-			"value":    valueSeries,
+			"index":        indexSeries,
+			opts.VarName:   variableSeries,
+			opts.ValueName: valueSeries,
 		},
-		ColumnOrder: []string{"index", "variable", "value"},
+		ColumnOrder: []string{"index", opts.VarName, opts.ValueName},
 		Index:       idx,
 	}, nil
 }
@@ -152,12 +255,36 @@ func (df *DataFrame) Stack() (*DataFrame, error) {
 // values become row labels, distinct "variable" values become columns (sorted),
 // and "value" fills the cells. Missing combinations are null.
 //
+// An error listing the ambiguous (index, variable) combinations is returned if
+// any combination occurs more than once; use UnstackColumn with an AggFunc to
+// aggregate such duplicates instead.
+//
 // This is analogous to df.unstack() as the inverse of Stack.
 //
 // Example:
 //
 //	wide, err := long.Unstack()
 func (df *DataFrame) Unstack() (*DataFrame, error) {
+	return df.UnstackColumn("variable", "")
+}
+
+// UnstackColumn is like Unstack but spreads the distinct values of column,
+// instead of "variable", into new columns. The DataFrame must also have
+// "index" and "value" columns.
+//
+// When aggFunc is empty, each (index, column value) combination must occur at
+// most once; otherwise an error lists the ambiguous combinations rather than
+// silently keeping one of the values. When aggFunc is set, every cell is the
+// aggregate of the values sharing its combination, using the same functions
+// as GroupBy.Agg (AggSum, AggMean, AggCount, AggFirst, ...).
+//
+// This is analogous to df.pivot_table(index="index", columns=column,
+// values="value", aggfunc=aggFunc) in pandas.
+//
+// Example:
+//
+//	wide, err := long.UnstackColumn("month", dataframe.AggSum)
+func (df *DataFrame) UnstackColumn(column string, aggFunc AggFunc) (*DataFrame, error) {
 	if df == nil {
 		return nil, errors.New("Unstack: DataFrame is nil")
 	}
@@ -165,14 +292,14 @@ func (df *DataFrame) Unstack() (*DataFrame, error) {
 	df.RLock()
 	defer df.RUnlock()
 
-	for _, required := range []string{"index", "variable", "value"} {
+	for _, required := range []string{"index", column, "value"} {
 		if _, ok := df.Columns[required]; !ok {
 			return nil, fmt.Errorf("Unstack: required column '%s' not found (expects the output of Stack)", required)
 		}
 	}
 
 	indexSeries := df.Columns["index"]
-	variableSeries := df.Columns["variable"]
+	variableSeries := df.Columns[column]
 	valueSeries := df.Columns["value"]
 	rowCount := indexSeries.Len()
 
@@ -180,8 +307,9 @@ func (df *DataFrame) Unstack() (*DataFrame, error) {
 	rowOrder := make([]string, 0)
 	rowSeen := make(map[string]bool)
 	colSeen := make(map[string]bool)
-	// cell maps "rowLabel\x00colName" -> value
-	cell := make(map[string]any)
+	// cellRows maps "rowLabel\x00colName" -> source row positions
+	cellRows := make(map[string][]int)
+	var duplicates []string
 
 	for i := 0; i < rowCount; i++ {
 		rv, _ := indexSeries.At(i)
@@ -195,11 +323,21 @@ func (df *DataFrame) Unstack() (*DataFrame, error) {
 		}
 		colSeen[colName] = true
 
-		var val any
-		if !valueSeries.IsNull(i) {
-			val, _ = valueSeries.At(i)
+		key := rowLabel + "\x00" + colName
+		cellRows[key] = append(cellRows[key], i)
+		if len(cellRows[key]) == 2 {
+			duplicates = append(duplicates, fmt.Sprintf("(%s, %s)", rowLabel, colName))
+		}
+	}
+	if len(duplicates) > 0 && aggFunc == "" {
+		const maxListed = 5
+		listed := duplicates
+		more := ""
+		if len(listed) > maxListed {
+			listed = listed[:maxListed]
+			more = fmt.Sprintf(" and %d more", len(duplicates)-maxListed)
 		}
-		cell[rowLabel+"\x00"+colName] = val
+		return nil, fmt.Errorf("Unstack: duplicate entries for (index, %s) %s%s; pass an AggFunc to aggregate them", column, strings.Join(listed, ", "), more)
 	}
 
 	colOrder := make([]string, 0, len(colSeen))
@@ -213,10 +351,18 @@ func (df *DataFrame) Unstack() (*DataFrame, error) {
 	for _, colName := range colOrder {
 		values := make([]any, len(rowOrder))
 		for r, rowLabel := range rowOrder {
-			if v, ok := cell[rowLabel+"\x00"+colName]; ok {
-				values[r] = v
-			} else {
+			rows, ok := cellRows[rowLabel+"\x00"+colName]
+			switch {
+			case !ok:
 				values[r] = nil
+			case aggFunc != "":
+				v, err := aggregateGroup(valueSeries, rows, aggFunc)
+				if err != nil {
+					return nil, fmt.Errorf("Unstack: %w", err)
+				}
+				values[r] = v
+			case !valueSeries.IsNull(rows[0]):
+				values[r], _ = valueSeries.At(rows[0])
 			}
 		}
 		s, err := seriesFromAnyValues(values)
@@ -232,3 +378,70 @@ func (df *DataFrame) Unstack() (*DataFrame, error) {
 		Index:       rowOrder,
 	}, nil
 }
+
+// Broadcast returns a new DataFrame of n rows, each a copy of the single row
+// of df, with every column tiled via collection.Tile so that column types are
+// kept. The index repeats the row's label n times, or is reset to "0".."n-1"
+// if ignoreIndex is true. An error is returned unless df has exactly one row.
+//
+// This is useful for expanding a one-row template of default values before
+// assigning per-row columns, and is analogous to
+// pd.concat([df] * n, ignore_index=ignoreIndex) in pandas.
+//
+// Example:
+//
+//	defaults, _ := gpandas.FromDict(map[string][]any{"Status": {"new"}, "Score": {0}}, nil)
+//	frame, err := defaults.Broadcast(100, true)
+func (df *DataFrame) Broadcast(n int, ignoreIndex ...bool) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("Broadcast: DataFrame is nil")
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("Broadcast: n must be non-negative, got %d", n)
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	if rows := df.Len(); rows != 1 {
+		return nil, fmt.Errorf("Broadcast: DataFrame must have exactly one row, got %d", rows)
+	}
+
+	newCols := make(map[string]collection.Series, len(df.Columns))
+	for _, name := range df.ColumnOrder {
+		tiled, err := collection.Tile(df.Columns[name], n)
+		if err != nil {
+			return nil, fmt.Errorf("Broadcast: column '%s': %w", name, err)
+		}
+		newCols[name] = tiled
+	}
+
+	result := &DataFrame{
+		Columns:         newCols,
+		ColumnOrder:     append([]string(nil), df.ColumnOrder...),
+		Index:           make([]string, n),
+		ColumnsName:     df.ColumnsName,
+		ColumnHierarchy: df.ColumnHierarchy,
+	}
+	if len(ignoreIndex) > 0 && ignoreIndex[0] {
+		for i := range result.Index {
+			result.Index[i] = fmt.Sprintf("%d", i)
+		}
+		return result, nil
+	}
+	result.IndexName = df.IndexName
+	label := "0"
+	if len(df.Index) > 0 {
+		label = df.Index[0]
+	}
+	for i := range result.Index {
+		result.Index[i] = label
+	}
+	if len(df.MultiIndex) == 1 {
+		result.MultiIndex = make([][]string, n)
+		for i := range result.MultiIndex {
+			result.MultiIndex[i] = append([]string(nil), df.MultiIndex[0]...)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,122 @@
+package dataframe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ColumnSchema describes the expected structure and content of one column.
+type ColumnSchema struct {
+	Name          string       // Column name
+	DType         reflect.Type // Expected Series dtype; nil accepts any dtype
+	Nullable      bool         // Whether null values are allowed
+	MinVal        any          // Inclusive lower bound; nil means unbounded
+	MaxVal        any          // Inclusive upper bound; nil means unbounded
+	AllowedValues []any        // Permitted non-null values; empty allows any value
+}
+
+// Schema describes the expected columns of a DataFrame. Columns not listed in
+// the schema are ignored by Validate.
+type Schema struct {
+	Columns []ColumnSchema
+}
+
+// ValidationError describes a single schema violation found by Validate.
+// Row is -1 for column-level violations (missing column, wrong dtype).
+type ValidationError struct {
+	Column  string
+	Row     int
+	Index   string
+	Message string
+}
+
+// Error implements the error interface.
+func (e ValidationError) Error() string {
+	if e.Row < 0 {
+		return fmt.Sprintf("column '%s': %s", e.Column, e.Message)
+	}
+	return fmt.Sprintf("column '%s', row '%s': %s", e.Column, e.Index, e.Message)
+}
+
+// Validate checks the DataFrame against schema and returns every violation
+// found: missing columns, wrong dtypes, nulls in non-nullable columns, values
+// outside [MinVal, MaxVal], and values not in AllowedValues. A nil or empty
+// result means the DataFrame conforms to the schema.
+//
+// Range and membership checks compare numbers by value, so an int64 column
+// can be bounded with float64 limits.
+//
+// Example:
+//
+//	schema := dataframe.Schema{Columns: []dataframe.ColumnSchema{
+//	    {Name: "age", DType: reflect.TypeOf(int64(0)), MinVal: int64(0), MaxVal: int64(150)},
+//	    {Name: "status", DType: reflect.TypeOf(""), AllowedValues: []any{"active", "inactive"}},
+//	}}
+//	for _, v := range df.Validate(schema) {
+//	    log.Println(v)
+//	}
+func (df *DataFrame) Validate(schema Schema) []ValidationError {
+	if df == nil {
+		return []ValidationError{{Row: -1, Message: "DataFrame is nil"}}
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	var errs []ValidationError
+	for _, col := range schema.Columns {
+		series, ok := df.Columns[col.Name]
+		if !ok {
+			errs = append(errs, ValidationError{Column: col.Name, Row: -1, Message: "column is missing"})
+			continue
+		}
+		if col.DType != nil && series.DType() != col.DType {
+			errs = append(errs, ValidationError{
+				Column:  col.Name,
+				Row:     -1,
+				Message: fmt.Sprintf("expected dtype %v, got %v", col.DType, series.DType()),
+			})
+		}
+
+		for i := 0; i < series.Len(); i++ {
+			violation := func(msg string) {
+				errs = append(errs, ValidationError{Column: col.Name, Row: i, Index: df.indexLabel(i), Message: msg})
+			}
+			if series.IsNull(i) {
+				if !col.Nullable {
+					violation("null value in non-nullable column")
+				}
+				continue
+			}
+			val, err := series.At(i)
+			if err != nil {
+				violation(err.Error())
+				continue
+			}
+			if col.MinVal != nil {
+				if cmp, err := compareForFilter(val, col.MinVal); err != nil || cmp < 0 {
+					violation(fmt.Sprintf("value %v is below minimum %v", val, col.MinVal))
+				}
+			}
+			if col.MaxVal != nil {
+				if cmp, err := compareForFilter(val, col.MaxVal); err != nil || cmp > 0 {
+					violation(fmt.Sprintf("value %v is above maximum %v", val, col.MaxVal))
+				}
+			}
+			if len(col.AllowedValues) > 0 && !valueAllowed(val, col.AllowedValues) {
+				violation(fmt.Sprintf("value %v is not an allowed value", val))
+			}
+		}
+	}
+	return errs
+}
+
+// valueAllowed reports whether val equals one of allowed.
+func valueAllowed(val any, allowed []any) bool {
+	for _, a := range allowed {
+		if cmp, err := compareForFilter(val, a); err == nil && cmp == 0 {
+			return true
+		}
+	}
+	return false
+}
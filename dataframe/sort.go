@@ -3,9 +3,11 @@ package dataframe
 import (
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
 )
@@ -62,9 +64,10 @@ type SortOptions struct {
 //   - error: nil if successful, otherwise an error describing what went wrong
 //
 // Supported column types for comparison:
-//   - float64, int64, string, bool (true > false)
+//   - float64, float32, int64, int32, int, string, bool (true > false)
+//   - time.Time (chronological)
 //
-// Null values are handled according to NaPosition:
+// Null values, and NaN in float columns, are handled according to NaPosition:
 //   - NaLast (default): nulls are placed after all non-null values
 //   - NaFirst: nulls are placed before all non-null values
 //
@@ -92,13 +95,48 @@ type SortOptions struct {
 //	    IgnoreIndex: true,
 //	})
 func (df *DataFrame) SortValues(opts SortOptions) (*DataFrame, error) {
+	indices, err := df.argSort("SortValues", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reorder every column once using the sorted permutation
+	return df.reorderByIndices(indices, opts.Inplace, opts.IgnoreIndex)
+}
+
+// ArgSort returns the row permutation that sorts the DataFrame according to
+// opts: the i-th element is the position of the row that SortValues would put
+// at position i. The sort is stable, so rows with equal keys keep their
+// original relative order. Inplace and IgnoreIndex are ignored. The DataFrame
+// is not modified.
+//
+// Sorting works on this integer permutation rather than moving values inside
+// each Series, so a sort over k key columns compares rows without touching
+// the other columns, and the permutation is applied to every column once.
+//
+// This is analogous to np.lexsort / Series.argsort in pandas.
+//
+// Example:
+//
+//	order, err := df.ArgSort(dataframe.SortOptions{
+//	    By:        []string{"Department", "Salary"},
+//	    Ascending: []bool{true, false},
+//	})
+//	top, err := df.Slice(order[:10])
+func (df *DataFrame) ArgSort(opts SortOptions) ([]int, error) {
+	return df.argSort("ArgSort", opts)
+}
+
+// argSort builds the stable sort permutation for opts; op prefixes error
+// messages.
+func (df *DataFrame) argSort(op string, opts SortOptions) ([]int, error) {
 	if df == nil {
-		return nil, errors.New("SortValues: DataFrame is nil")
+		return nil, errors.New(op + ": DataFrame is nil")
 	}
 
 	// Validate By
 	if len(opts.By) == 0 {
-		return nil, errors.New("SortValues: 'By' must contain at least one column name")
+		return nil, errors.New(op + ": 'By' must contain at least one column name")
 	}
 
 	df.RLock()
@@ -107,7 +145,7 @@ func (df *DataFrame) SortValues(opts SortOptions) (*DataFrame, error) {
 	for _, col := range opts.By {
 		if _, ok := df.Columns[col]; !ok {
 			df.RUnlock()
-			return nil, fmt.Errorf("SortValues: column '%s' not found in DataFrame", col)
+			return nil, fmt.Errorf("%s: column '%s' not found in DataFrame", op, col)
 		}
 	}
 
@@ -128,7 +166,7 @@ func (df *DataFrame) SortValues(opts SortOptions) (*DataFrame, error) {
 		}
 	} else if len(ascending) != len(opts.By) {
 		df.RUnlock()
-		return nil, fmt.Errorf("SortValues: length of 'Ascending' (%d) must match length of 'By' (%d) or be 1", len(opts.Ascending), len(opts.By))
+		return nil, fmt.Errorf("%s: length of 'Ascending' (%d) must match length of 'By' (%d) or be 1", op, len(opts.Ascending), len(opts.By))
 	}
 
 	// Default NaPosition
@@ -138,7 +176,7 @@ func (df *DataFrame) SortValues(opts SortOptions) (*DataFrame, error) {
 	}
 	if naPosition != NaLast && naPosition != NaFirst {
 		df.RUnlock()
-		return nil, fmt.Errorf("SortValues: NaPosition must be 'last' or 'first', got '%s'", naPosition)
+		return nil, fmt.Errorf("%s: NaPosition must be 'last' or 'first', got '%s'", op, naPosition)
 	}
 
 	// Build row indices
@@ -166,6 +204,12 @@ func (df *DataFrame) SortValues(opts SortOptions) (*DataFrame, error) {
 			mask:   series.MaskCopy(),
 			asc:    ascending[i],
 		}
+		// NaN is unordered, so it is placed with the nulls
+		for r, v := range sortCols[i].values {
+			if isNaNValue(v) {
+				sortCols[i].mask[r] = true
+			}
+		}
 	}
 
 	df.RUnlock()
@@ -214,11 +258,10 @@ func (df *DataFrame) SortValues(opts SortOptions) (*DataFrame, error) {
 	})
 
 	if sortErr != nil {
-		return nil, fmt.Errorf("SortValues: comparison error: %w", sortErr)
+		return nil, fmt.Errorf("%s: comparison error: %w", op, sortErr)
 	}
 
-	// Reorder using the sorted indices
-	return df.reorderByIndices(indices, opts.Inplace, opts.IgnoreIndex)
+	return indices, nil
 }
 
 // SortIndex sorts the DataFrame by its index labels.
@@ -311,7 +354,16 @@ func (df *DataFrame) reorderByIndices(indices []int, inplace bool, ignoreIndex b
 		}
 	}
 
-	columnOrder := append([]string(nil), df.ColumnOrder...)
+	out := df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: append([]string(nil), df.ColumnOrder...),
+		Index:       newIndex,
+	}, indices)
+	if ignoreIndex {
+		// A reset index has no name or levels.
+		out.IndexName = ""
+		out.MultiIndex = nil
+	}
 
 	df.RUnlock()
 
@@ -319,15 +371,13 @@ func (df *DataFrame) reorderByIndices(indices []int, inplace bool, ignoreIndex b
 		df.Lock()
 		df.Columns = newCols
 		df.Index = newIndex
+		df.IndexName = out.IndexName
+		df.MultiIndex = out.MultiIndex
 		df.Unlock()
 		return nil, nil
 	}
 
-	return &DataFrame{
-		Columns:     newCols,
-		ColumnOrder: columnOrder,
-		Index:       newIndex,
-	}, nil
+	return out, nil
 }
 
 // compareValues compares two non-nil values and returns:
@@ -364,12 +414,33 @@ func compareValues(a, b any) (int, error) {
 		}
 		return strings.Compare(av, bv), nil
 
+	case float32:
+		bv, ok := b.(float32)
+		if !ok {
+			return 0, fmt.Errorf("type mismatch: cannot compare %T and %T", a, b)
+		}
+		return compareOrdered(av, bv), nil
+
+	case int32:
+		bv, ok := b.(int32)
+		if !ok {
+			return 0, fmt.Errorf("type mismatch: cannot compare %T and %T", a, b)
+		}
+		return compareOrdered(av, bv), nil
+
 	case bool:
 		bv, ok := b.(bool)
 		if !ok {
 			return 0, fmt.Errorf("type mismatch: cannot compare %T and %T", a, b)
 		}
 		return compareBool(av, bv), nil
+
+	case time.Time:
+		bv, ok := b.(time.Time)
+		if !ok {
+			return 0, fmt.Errorf("type mismatch: cannot compare %T and %T", a, b)
+		}
+		return av.Compare(bv), nil
 	}
 
 	// Fallback: compare by string representation
@@ -382,7 +453,7 @@ func compareValues(a, b any) (int, error) {
 }
 
 // compareOrdered compares two ordered (numeric) values.
-func compareOrdered[T ~int | ~int64 | ~float64](a, b T) int {
+func compareOrdered[T ~int | ~int32 | ~int64 | ~float32 | ~float64](a, b T) int {
 	if a < b {
 		return -1
 	}
@@ -392,6 +463,17 @@ func compareOrdered[T ~int | ~int64 | ~float64](a, b T) int {
 	return 0
 }
 
+// isNaNValue reports whether v is a float NaN.
+func isNaNValue(v any) bool {
+	switch f := v.(type) {
+	case float64:
+		return math.IsNaN(f)
+	case float32:
+		return math.IsNaN(float64(f))
+	}
+	return false
+}
+
 // compareBool compares two booleans. false < true.
 func compareBool(a, b bool) int {
 	if a == b {
@@ -4,6 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
 )
 
 // Unique returns the distinct values of a column in order of first appearance.
@@ -54,7 +57,8 @@ func (df *DataFrame) Unique(column string) ([]any, error) {
 	return out, nil
 }
 
-// NUnique returns the number of distinct non-null values in a column.
+// NUnique returns the number of distinct non-null values in a column, as
+// computed by collection.NUnique.
 //
 // This is analogous to df["col"].nunique() in pandas (nulls excluded).
 //
@@ -74,19 +78,7 @@ func (df *DataFrame) NUnique(column string) (int, error) {
 		return 0, fmt.Errorf("NUnique: column '%s' not found", column)
 	}
 
-	n := series.Len()
-	seen := make(map[any]bool)
-	for i := 0; i < n; i++ {
-		if series.IsNull(i) {
-			continue
-		}
-		val, err := series.At(i)
-		if err != nil {
-			return 0, fmt.Errorf("NUnique: error reading row %d: %w", i, err)
-		}
-		seen[val] = true
-	}
-	return len(seen), nil
+	return collection.NUnique(series, true), nil
 }
 
 // Duplicated returns a boolean slice marking duplicate rows, aligned to row
@@ -196,6 +188,48 @@ func (df *DataFrame) DropDuplicates(subset []string, keep string) (*DataFrame, e
 	return df.Slice(keep_indices)
 }
 
+// HasDuplicateIndex reports whether any index label appears more than once.
+// Label-based access is ambiguous for such labels: Loc().At returns the value
+// from the first matching row, while Loc().Row returns every matching row.
+//
+// This is analogous to not df.index.is_unique in pandas.
+//
+// Example:
+//
+//	if df.HasDuplicateIndex() {
+//	    df.ResetIndex()
+//	}
+func (df *DataFrame) HasDuplicateIndex() bool {
+	return len(df.DuplicateIndexLabels()) > 0
+}
+
+// DuplicateIndexLabels returns the index labels that appear more than once,
+// each listed once in order of first appearance. It returns nil when every
+// label is unique.
+//
+// This is analogous to df.index[df.index.duplicated()].unique() in pandas.
+//
+// Example:
+//
+//	dups := df.DuplicateIndexLabels() // e.g. ["a", "c"]
+func (df *DataFrame) DuplicateIndexLabels() []string {
+	if df == nil {
+		return nil
+	}
+	df.RLock()
+	defer df.RUnlock()
+
+	counts := make(map[string]int, len(df.Index))
+	var dups []string
+	for _, label := range df.Index {
+		counts[label]++
+		if counts[label] == 2 {
+			dups = append(dups, label)
+		}
+	}
+	return dups
+}
+
 // resolveSubset validates and returns the columns to consider. An empty subset
 // means all columns. Must be called with the read lock held.
 func (df *DataFrame) resolveSubset(subset []string, op string) ([]string, error) {
@@ -211,24 +245,37 @@ func (df *DataFrame) resolveSubset(subset []string, op string) ([]string, error)
 }
 
 // rowKey builds a string key for a row from the given columns, with explicit
-// null handling so nulls never collide with real values. Must be called with the
-// read lock held.
+// null handling so nulls never collide with real values. Each value is tagged
+// with its Go type and prefixed with its length, so int64(1) and "1" differ and
+// no string value can run into the next column. Float zeros and datetimes are
+// normalised so that -0 equals 0 and equal instants in different locations
+// match. Must be called with the read lock held.
 func (df *DataFrame) rowKey(row int, cols []string) (string, error) {
 	var b strings.Builder
-	for j, c := range cols {
-		if j > 0 {
-			b.WriteByte('\x01')
-		}
+	for _, c := range cols {
 		series := df.Columns[c]
 		if series.IsNull(row) {
-			b.WriteString("\x00NULL\x00")
+			b.WriteString("\x00")
 			continue
 		}
 		val, err := series.At(row)
 		if err != nil {
 			return "", fmt.Errorf("error reading column '%s' row %d: %w", c, row, err)
 		}
-		fmt.Fprintf(&b, "%v", val)
+		switch v := val.(type) {
+		case float64:
+			if v == 0 {
+				val = float64(0)
+			}
+		case float32:
+			if v == 0 {
+				val = float32(0)
+			}
+		case time.Time:
+			val = v.UTC().Round(0)
+		}
+		str := fmt.Sprintf("%v", val)
+		fmt.Fprintf(&b, "%T:%d:%s", val, len(str), str)
 	}
 	return b.String(), nil
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
 )
@@ -11,12 +12,19 @@ import (
 // RollingWindow represents a fixed-size rolling view over a DataFrame, created
 // by DataFrame.Rolling. Aggregations are computed over a trailing window of rows.
 type RollingWindow struct {
-	df     *DataFrame
-	window int
+	df         *DataFrame
+	window     int
+	minPeriods int // 0 means the full window is required
 }
 
 // Rolling creates a rolling window of the given size for computing moving
-// aggregations. The window must be at least 1.
+// aggregations. The window must be at least 1. By default a result is only
+// produced once the window holds window non-null values; use MinPeriods to
+// relax this.
+//
+// Each aggregation processes the numeric columns in parallel, one goroutine
+// per column, and produces float64 results. Non-numeric columns are carried
+// over unchanged.
 //
 // This is analogous to df.rolling(window) in pandas.
 //
@@ -27,42 +35,75 @@ func (df *DataFrame) Rolling(window int) *RollingWindow {
 	return &RollingWindow{df: df, window: window}
 }
 
+// MinPeriods returns a copy of the rolling window that produces a result
+// wherever the trailing window holds at least n non-null values, instead of
+// requiring a full window. n must be between 1 and the window size.
+//
+// This is analogous to df.rolling(window, min_periods=n) in pandas.
+//
+// Example:
+//
+//	// Averages over up to 7 rows, starting from the first row
+//	result, err := df.Rolling(7).MinPeriods(1).Mean()
+func (rw *RollingWindow) MinPeriods(n int) *RollingWindow {
+	out := *rw
+	out.minPeriods = n
+	return &out
+}
+
 // Mean computes the rolling mean over each numeric column.
 func (rw *RollingWindow) Mean() (*DataFrame, error) {
-	return rw.apply("mean")
+	return rw.apply("mean", 0)
 }
 
 // Sum computes the rolling sum over each numeric column.
 func (rw *RollingWindow) Sum() (*DataFrame, error) {
-	return rw.apply("sum")
+	return rw.apply("sum", 0)
 }
 
 // Min computes the rolling minimum over each numeric column.
 func (rw *RollingWindow) Min() (*DataFrame, error) {
-	return rw.apply("min")
+	return rw.apply("min", 0)
 }
 
 // Max computes the rolling maximum over each numeric column.
 func (rw *RollingWindow) Max() (*DataFrame, error) {
-	return rw.apply("max")
+	return rw.apply("max", 0)
 }
 
-// Std computes the rolling sample standard deviation (ddof=1) over each numeric
-// column.
-func (rw *RollingWindow) Std() (*DataFrame, error) {
-	return rw.apply("std")
+// Std computes the rolling standard deviation over each numeric column with
+// ddof delta degrees of freedom. ddof defaults to 1 (the sample standard
+// deviation); pass 0 for the population standard deviation. A window with no
+// more than ddof values yields NaN.
+func (rw *RollingWindow) Std(ddof ...int) (*DataFrame, error) {
+	d := 1
+	if len(ddof) > 0 {
+		d = ddof[0]
+	}
+	if d < 0 {
+		return nil, fmt.Errorf("Rolling: ddof must be non-negative, got %d", d)
+	}
+	return rw.apply("std", d)
 }
 
 // apply computes the given rolling statistic. Numeric columns produce float64
-// results; non-numeric columns are passed through unchanged. A result is null
-// for any position that does not have a full window of non-null values.
-func (rw *RollingWindow) apply(stat string) (*DataFrame, error) {
+// results, each computed in its own goroutine; non-numeric columns are passed
+// through unchanged. A result is null for any position whose trailing window
+// holds fewer than minPeriods non-null values.
+func (rw *RollingWindow) apply(stat string, ddof int) (*DataFrame, error) {
 	if rw.df == nil {
 		return nil, errors.New("Rolling: DataFrame is nil")
 	}
 	if rw.window < 1 {
 		return nil, fmt.Errorf("Rolling: window must be >= 1, got %d", rw.window)
 	}
+	minPeriods := rw.minPeriods
+	if minPeriods == 0 {
+		minPeriods = rw.window
+	}
+	if minPeriods < 1 || minPeriods > rw.window {
+		return nil, fmt.Errorf("Rolling: min periods must be between 1 and %d, got %d", rw.window, minPeriods)
+	}
 
 	rw.df.RLock()
 	defer rw.df.RUnlock()
@@ -72,67 +113,86 @@ func (rw *RollingWindow) apply(stat string) (*DataFrame, error) {
 		rowCount = rw.df.Columns[rw.df.ColumnOrder[0]].Len()
 	}
 
-	newCols := make(map[string]collection.Series, len(rw.df.Columns))
-	for _, name := range rw.df.ColumnOrder {
+	results := make([]collection.Series, len(rw.df.ColumnOrder))
+	errs := make([]error, len(rw.df.ColumnOrder))
+	var wg sync.WaitGroup
+	for c, name := range rw.df.ColumnOrder {
 		series := rw.df.Columns[name]
 		if !isNumericSeries(series) {
 			// Pass through non-numeric columns unchanged (zero-copy).
-			newCols[name] = series
+			results[c] = series
 			continue
 		}
-
-		data := make([]float64, rowCount)
-		mask := make([]bool, rowCount)
-		for i := 0; i < rowCount; i++ {
-			if i+1 < rw.window {
-				mask[i] = true // not enough history yet
-				continue
-			}
-			// Collect the trailing window [i-window+1, i].
+		wg.Add(1)
+		go func(c int, name string, series collection.Series) {
+			defer wg.Done()
+			data := make([]float64, rowCount)
+			mask := make([]bool, rowCount)
 			vals := make([]float64, 0, rw.window)
-			full := true
-			for j := i - rw.window + 1; j <= i; j++ {
-				if series.IsNull(j) {
-					full = false
-					break
+			for i := 0; i < rowCount; i++ {
+				// Collect the non-null values of the trailing window
+				// [i-window+1, i].
+				vals = vals[:0]
+				for j := max(0, i-rw.window+1); j <= i; j++ {
+					if series.IsNull(j) {
+						continue
+					}
+					v, _ := series.At(j)
+					if f, ok := toFloat64(v); ok {
+						vals = append(vals, f)
+					}
 				}
-				v, _ := series.At(j)
-				f, ok := toFloat64(v)
-				if !ok {
-					full = false
-					break
+				if len(vals) < minPeriods {
+					mask[i] = true
+					continue
 				}
-				vals = append(vals, f)
+				data[i] = computeRollingStat(stat, vals, ddof)
 			}
-			if !full {
-				mask[i] = true
-				continue
+			s, err := collection.NewFloat64SeriesFromData(data, mask)
+			if err != nil {
+				errs[c] = fmt.Errorf("Rolling: column '%s': %w", name, err)
+				return
 			}
-			data[i] = computeRollingStat(stat, vals)
-		}
-		s, err := collection.NewFloat64SeriesFromData(data, mask)
-		if err != nil {
-			return nil, fmt.Errorf("Rolling: column '%s': %w", name, err)
+			results[c] = s
+		}(c, name, series)
+	}
+	wg.Wait()
+
+	newCols := make(map[string]collection.Series, len(rw.df.Columns))
+	for c, name := range rw.df.ColumnOrder {
+		if errs[c] != nil {
+			return nil, errs[c]
 		}
-		newCols[name] = s
+		newCols[name] = results[c]
 	}
 
-	return &DataFrame{
+	return rw.df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), rw.df.ColumnOrder...),
 		Index:       append([]string(nil), rw.df.Index...),
-	}, nil
+	}, nil), nil
 }
 
-// computeRollingStat computes a single statistic over a full window of values.
-func computeRollingStat(stat string, vals []float64) float64 {
+// computeRollingStat computes a single statistic over the non-empty values of
+// a window. ddof is only used by "std".
+func computeRollingStat(stat string, vals []float64, ddof int) float64 {
 	switch stat {
 	case "sum":
 		return sumFloats(vals)
 	case "mean":
 		return sumFloats(vals) / float64(len(vals))
 	case "std":
-		return stdSample(vals)
+		n := len(vals)
+		if n <= ddof {
+			return math.NaN()
+		}
+		mean := sumFloats(vals) / float64(n)
+		var ss float64
+		for _, v := range vals {
+			d := v - mean
+			ss += d * d
+		}
+		return math.Sqrt(ss / float64(n-ddof))
 	case "min":
 		m := vals[0]
 		for _, v := range vals[1:] {
@@ -191,11 +251,68 @@ func (df *DataFrame) Shift(periods int) (*DataFrame, error) {
 		newCols[name] = shifted
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
+		Columns:     newCols,
+		ColumnOrder: append([]string(nil), df.ColumnOrder...),
+		Index:       append([]string(nil), df.Index...),
+	}, nil), nil
+}
+
+// PctChange returns a new DataFrame in which the given numeric columns hold
+// the fractional change from the value periods rows earlier, as computed by
+// Float64Series.PctChange. If columns is empty every numeric column is
+// converted. Other columns are carried over unchanged. An error is returned
+// if a listed column does not exist or is not numeric.
+//
+// This is analogous to df.pct_change(periods) in pandas.
+//
+// Example:
+//
+//	// Daily returns of every price column
+//	returns, err := prices.PctChange(1, nil)
+func (df *DataFrame) PctChange(periods int, columns []string) (*DataFrame, error) {
+	if df == nil {
+		return nil, errors.New("PctChange: DataFrame is nil")
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	selected := make(map[string]bool, len(columns))
+	for _, name := range columns {
+		series, ok := df.Columns[name]
+		if !ok {
+			return nil, fmt.Errorf("PctChange: column '%s' not found", name)
+		}
+		if !isNumericSeries(series) {
+			return nil, fmt.Errorf("PctChange: column '%s' is not numeric", name)
+		}
+		selected[name] = true
+	}
+
+	newCols := make(map[string]collection.Series, len(df.Columns))
+	for _, name := range df.ColumnOrder {
+		series := df.Columns[name]
+		if (len(columns) > 0 && !selected[name]) || !isNumericSeries(series) {
+			newCols[name] = series
+			continue
+		}
+		floats, err := collection.AsFloat64(series)
+		if err != nil {
+			return nil, fmt.Errorf("PctChange: column '%s': %w", name, err)
+		}
+		changed, err := floats.PctChange(periods)
+		if err != nil {
+			return nil, fmt.Errorf("PctChange: column '%s': %w", name, err)
+		}
+		newCols[name] = changed
+	}
+
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
 
 // CumSum returns a new DataFrame with the cumulative sum of each numeric column.
@@ -276,9 +393,9 @@ func (df *DataFrame) cumulative(op string) (*DataFrame, error) {
 		newCols[name] = s
 	}
 
-	return &DataFrame{
+	return df.withAxisMeta(&DataFrame{
 		Columns:     newCols,
 		ColumnOrder: append([]string(nil), df.ColumnOrder...),
 		Index:       append([]string(nil), df.Index...),
-	}, nil
+	}, nil), nil
 }
@@ -7,6 +7,7 @@ require github.com/olekukonko/tablewriter v1.1.2 // direct
 require (
 	cloud.google.com/go/bigquery v1.65.0
 	github.com/DATA-DOG/go-sqlmock v1.5.2
+	github.com/apache/arrow/go/v15 v15.0.2
 	github.com/denisenkom/go-mssqldb v0.12.3
 	github.com/go-echarts/go-echarts/v2 v2.7.0
 	github.com/joho/godotenv v1.5.1
@@ -24,7 +25,6 @@ require (
 	cloud.google.com/go/compute/metadata v0.9.0 // indirect
 	cloud.google.com/go/iam v1.2.2 // indirect
 	github.com/andybalholm/brotli v1.1.1 // indirect
-	github.com/apache/arrow/go/v15 v15.0.2 // indirect
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/clipperhouse/displaywidth v0.6.0 // indirect
 	github.com/clipperhouse/stringish v0.1.1 // indirect
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"runtime"
 	"sync"
+	"time"
 
 	"github.com/apoplexi24/gpandas/dataframe"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -28,9 +29,29 @@ type IntCol []int64
 // BoolColumn represents a slice of bool values.
 type BoolCol []bool
 
+// Int32Col represents a slice of int32 values.
+type Int32Col []int32
+
+// Float32Col represents a slice of float32 values.
+type Float32Col []float32
+
 // Column represents a slice of any type.
 type Column []any
 
+// NumericTypes returns the DTypes of the Float64 and Int64 series, for use
+// with DataFrame.SelectDtypes.
+//
+// Returns:
+//
+//	A new slice holding reflect.TypeOf(float64(0)) and reflect.TypeOf(int64(0))
+//
+// Example:
+//
+//	summary, err := df.SelectDtypes(gpandas.NumericTypes(), nil)
+func NumericTypes() []reflect.Type {
+	return []reflect.Type{reflect.TypeOf(float64(0)), reflect.TypeOf(int64(0))}
+}
+
 // TypeColumn represents a slice of a comparable type T.
 type TypeColumn[T comparable] []T
 
@@ -59,7 +80,8 @@ func FloatColumn(col []any) ([]float64, error) {
 // - Ensures type definitions exist for all columns
 //
 // The data is then converted to the internal DataFrame format, creating typed Series
-// based on the specified column types (FloatCol, IntCol, StringCol, BoolCol).
+// based on the specified column types (FloatCol, IntCol, StringCol, BoolCol,
+// Int32Col, Float32Col).
 // Null values (nil) are properly tracked using the boolean mask approach.
 //
 // Parameters:
@@ -171,6 +193,36 @@ func (GoPandas) DataFrame(columns []string, data []Column, columns_types map[str
 			}
 			series, err = collection.NewBoolSeriesFromData(boolData, mask)
 
+		case Int32Col:
+			// Create Int32Series
+			intData := make([]int32, rowCount)
+			mask := make([]bool, rowCount)
+			for j := 0; j < rowCount; j++ {
+				if data[i][j] == nil {
+					mask[j] = true
+				} else if v, ok := data[i][j].(int32); ok {
+					intData[j] = v
+				} else {
+					return nil, fmt.Errorf("type mismatch in column %s at row %d: expected int32, got %T", colName, j, data[i][j])
+				}
+			}
+			series, err = collection.NewInt32SeriesFromData(intData, mask)
+
+		case Float32Col:
+			// Create Float32Series
+			floatData := make([]float32, rowCount)
+			mask := make([]bool, rowCount)
+			for j := 0; j < rowCount; j++ {
+				if data[i][j] == nil {
+					mask[j] = true
+				} else if v, ok := data[i][j].(float32); ok {
+					floatData[j] = v
+				} else {
+					return nil, fmt.Errorf("type mismatch in column %s at row %d: expected float32, got %T", colName, j, data[i][j])
+				}
+			}
+			series, err = collection.NewFloat32SeriesFromData(floatData, mask)
+
 		default:
 			// Fallback to AnySeries for unknown types
 			values := make([]any, rowCount)
@@ -533,3 +585,97 @@ func NewEmptyDataFrame(columns []string, columnTypes map[string]reflect.Type) *d
 		Index:       []string{},
 	}
 }
+
+// NewDataFrameFromSchema creates a DataFrame from column data and enforces the
+// given schema during creation.
+//
+// data[i] holds the values of schema.Columns[i]; nil values become nulls.
+// Each column is stored in a Series of the schema's DType (int and int64
+// values are converted for float64 columns, and int values for int64
+// columns). A nil DType stores the column as an untyped Series. After
+// construction the DataFrame is checked with Validate, and all violations are
+// returned together as a single error.
+//
+// Parameters:
+//
+//	schema: The expected column names, types and constraints
+//	data: A slice of Columns, one per schema column, in schema order
+//
+// Returns:
+//
+//	A pointer to a DataFrame that conforms to the schema, or an error
+func NewDataFrameFromSchema(schema dataframe.Schema, data []Column) (*dataframe.DataFrame, error) {
+	if len(schema.Columns) == 0 {
+		return nil, errors.New("schema must define at least one column")
+	}
+	if len(data) != len(schema.Columns) {
+		return nil, fmt.Errorf("number of data columns (%d) does not match schema columns (%d)", len(data), len(schema.Columns))
+	}
+
+	rowCount := len(data[0])
+	cols := make(map[string]collection.Series, len(schema.Columns))
+	order := make([]string, len(schema.Columns))
+	for i, col := range schema.Columns {
+		if _, dup := cols[col.Name]; dup {
+			return nil, fmt.Errorf("duplicate column name '%s' in schema", col.Name)
+		}
+		if len(data[i]) != rowCount {
+			return nil, fmt.Errorf("inconsistent row count: column '%s' has %d rows, expected %d", col.Name, len(data[i]), rowCount)
+		}
+
+		var series collection.Series
+		switch {
+		case col.DType == nil:
+			series = collection.NewAnySeries(rowCount)
+		case col.DType == reflect.TypeOf(time.Time{}):
+			series = collection.NewDateTimeSeries(rowCount)
+		default:
+			series = collection.NewSeriesOfType(col.DType, rowCount)
+		}
+		for r, val := range data[i] {
+			if val == nil {
+				series.AppendNull()
+				continue
+			}
+			if err := series.Append(schemaCoerce(val, col.DType)); err != nil {
+				return nil, fmt.Errorf("column '%s', row %d: %w", col.Name, r, err)
+			}
+		}
+		cols[col.Name] = series
+		order[i] = col.Name
+	}
+
+	df, err := NewDataFrameFromSeries(cols, order)
+	if err != nil {
+		return nil, err
+	}
+	if violations := df.Validate(schema); len(violations) > 0 {
+		errs := make([]error, len(violations))
+		for i, v := range violations {
+			errs[i] = v
+		}
+		return nil, fmt.Errorf("schema validation failed: %w", errors.Join(errs...))
+	}
+	return df, nil
+}
+
+// schemaCoerce converts integer values to the numeric dtype of a schema column.
+func schemaCoerce(val any, dtype reflect.Type) any {
+	if dtype == nil {
+		return val
+	}
+	switch dtype.Kind() {
+	case reflect.Float64:
+		switch v := val.(type) {
+		case int:
+			return float64(v)
+		case int64:
+			return float64(v)
+		}
+	case reflect.Int64:
+		if v, ok := val.(int); ok {
+			return int64(v)
+		}
+	}
+	return val
+}
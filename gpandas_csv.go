@@ -0,0 +1,184 @@
+package gpandas
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// TypeInferenceOptions configures the column type inference performed by
+// Read_csv_opts when CSVReadOptions.InferTypes is set.
+type TypeInferenceOptions struct {
+	// MaxRowsToScan limits how many non-empty values per column are inspected
+	// to choose a type. Zero means all rows. If a value beyond the scanned
+	// rows does not parse as the chosen type, the next broader type is tried
+	// (int64, then float64, then bool and the date formats), so a "1.5" after
+	// many integers yields a Float64Series; only a column that fits none of
+	// them is kept as strings.
+	MaxRowsToScan int
+
+	// DateFormats lists time.Parse layouts tried, in order, for columns that
+	// are not numeric or boolean. A column whose values all parse with one
+	// layout becomes a DateTimeSeries. Nil disables date detection.
+	DateFormats []string
+}
+
+// CSVReadOptions configures Read_csv_opts.
+type CSVReadOptions struct {
+	// InferTypes converts each column to the most specific type its values
+	// allow: int64, then float64, then bool, then (optionally) datetime,
+	// falling back to string. Empty strings become nulls in typed columns.
+	InferTypes bool
+
+	// Inference tunes the type inference pass.
+	Inference TypeInferenceOptions
+}
+
+// Read_csv_opts reads a CSV file like Read_csv and then, when
+// opts.InferTypes is set, converts every column to an inferred type.
+//
+// Inference tries each type in turn (int64, float64, bool, then each layout
+// in opts.Inference.DateFormats) and picks the first one that every scanned
+// non-empty value parses as. Columns without any non-empty values, or whose
+// values match none of the types, stay StringSeries. This applies the same
+// conversions as Read_csv_typed without requiring the column types up front.
+//
+// This is analogous to pandas.read_csv(path), which infers dtypes by default.
+//
+// Example:
+//
+//	df, err := gp.Read_csv_opts("sales.csv", gpandas.CSVReadOptions{
+//	    InferTypes: true,
+//	    Inference: gpandas.TypeInferenceOptions{
+//	        MaxRowsToScan: 1000,
+//	        DateFormats:   []string{"2006-01-02", time.RFC3339},
+//	    },
+//	})
+func (gp GoPandas) Read_csv_opts(filepath string, opts CSVReadOptions) (*dataframe.DataFrame, error) {
+	df, err := gp.Read_csv(filepath)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.InferTypes {
+		return df, nil
+	}
+
+	for _, colName := range df.ColumnOrder {
+		strSeries, ok := df.Columns[colName].(*collection.StringSeries)
+		if !ok {
+			continue
+		}
+		if inferred := inferStringColumn(strSeries.StringValues(), opts.Inference); inferred != nil {
+			df.Columns[colName] = inferred
+		}
+	}
+	return df, nil
+}
+
+// csvBoolValues maps the accepted boolean spellings to their values, matching
+// the BoolCol conversion in Read_csv_typed (minus "1"/"0", which infer as int).
+var csvBoolValues = map[string]bool{
+	"true": true, "True": true, "TRUE": true,
+	"false": false, "False": false, "FALSE": false,
+}
+
+// inferStringColumn returns values converted to the most specific matching
+// type, or nil if the column should stay a StringSeries.
+func inferStringColumn(values []string, opts TypeInferenceOptions) collection.Series {
+	parsers := []func(string) (any, bool){
+		func(v string) (any, bool) {
+			n, err := strconv.ParseInt(v, 10, 64)
+			return n, err == nil
+		},
+		func(v string) (any, bool) {
+			f, err := strconv.ParseFloat(v, 64)
+			return f, err == nil
+		},
+		func(v string) (any, bool) {
+			b, ok := csvBoolValues[v]
+			return b, ok
+		},
+	}
+	for _, layout := range opts.DateFormats {
+		parsers = append(parsers, func(v string) (any, bool) {
+			t, err := time.Parse(layout, v)
+			return t, err == nil
+		})
+	}
+
+	for _, parse := range parsers {
+		if !scanMatches(values, opts.MaxRowsToScan, parse) {
+			continue
+		}
+		converted, ok := convertColumn(values, parse)
+		if !ok {
+			// A value past the scanned rows did not fit; widen to the next
+			// type whose scan matches.
+			continue
+		}
+		return converted
+	}
+	return nil
+}
+
+// scanMatches reports whether the first maxRows non-empty values (all when
+// maxRows <= 0) parse successfully. A column with no non-empty values never
+// matches.
+func scanMatches(values []string, maxRows int, parse func(string) (any, bool)) bool {
+	scanned := 0
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		if _, ok := parse(v); !ok {
+			return false
+		}
+		scanned++
+		if maxRows > 0 && scanned >= maxRows {
+			break
+		}
+	}
+	return scanned > 0
+}
+
+// convertColumn parses every value into a typed Series, with empty strings as
+// nulls. It reports false if any non-empty value fails to parse.
+func convertColumn(values []string, parse func(string) (any, bool)) (collection.Series, bool) {
+	var out collection.Series
+	for _, v := range values {
+		if v == "" {
+			continue
+		}
+		sample, ok := parse(v)
+		if !ok {
+			return nil, false
+		}
+		if _, isTime := sample.(time.Time); isTime {
+			out = collection.NewDateTimeSeries(len(values))
+		} else {
+			out = collection.NewSeriesOfType(reflect.TypeOf(sample), len(values))
+		}
+		break
+	}
+	if out == nil {
+		return nil, false
+	}
+
+	for _, v := range values {
+		if v == "" {
+			out.AppendNull()
+			continue
+		}
+		val, ok := parse(v)
+		if !ok {
+			return nil, false
+		}
+		if err := out.Append(val); err != nil {
+			return nil, false
+		}
+	}
+	return out, true
+}
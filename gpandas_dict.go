@@ -0,0 +1,117 @@
+package gpandas
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// FromDict creates a DataFrame from a map of column name to values. The type
+// of each column is inferred with AnySeries.Infer, so a column of ints (or
+// strings holding ints) becomes an Int64Series, a column of time.Time becomes
+// a DateTimeSeries, and so on. nil values become nulls.
+//
+// This is the inverse of DataFrame.ToDict and is analogous to
+// pd.DataFrame.from_dict(data) in pandas.
+//
+// Parameters:
+//
+//	data: A map of column names to their values
+//	columnOrder: The columns to include, in order. If nil, all columns are used, sorted alphabetically
+//
+// Returns:
+//
+//	A pointer to a DataFrame with a default index, or an error if a column is missing or the column lengths differ
+//
+// Example:
+//
+//	df, err := gpandas.FromDict(map[string][]any{
+//	    "name": {"Alice", "Bob"},
+//	    "age":  {30, nil},
+//	}, []string{"name", "age"})
+func FromDict(data map[string][]any, columnOrder []string) (*dataframe.DataFrame, error) {
+	if len(data) == 0 {
+		return nil, errors.New("at least one column is required")
+	}
+	if columnOrder == nil {
+		columnOrder = sortedKeys(data)
+	}
+
+	cols := make(map[string]collection.Series, len(columnOrder))
+	for _, name := range columnOrder {
+		values, ok := data[name]
+		if !ok {
+			return nil, fmt.Errorf("column '%s' not found in data map", name)
+		}
+		raw, err := collection.NewAnySeriesFromData(values, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed creating series for column %s: %w", name, err)
+		}
+		series, err := raw.Infer()
+		if err != nil {
+			return nil, fmt.Errorf("failed inferring type of column %s: %w", name, err)
+		}
+		cols[name] = series
+	}
+	return NewDataFrameFromSeries(cols, append([]string(nil), columnOrder...))
+}
+
+// FromDictTyped creates a DataFrame from typed column maps without any type
+// inference: floats become Float64Series, ints Int64Series and strs
+// StringSeries. Columns are sorted alphabetically across all three maps.
+//
+// Parameters:
+//
+//	floats: A map of column names to float64 values (may be nil)
+//	ints: A map of column names to int64 values (may be nil)
+//	strs: A map of column names to string values (may be nil)
+//
+// Returns:
+//
+//	A pointer to a DataFrame with a default index, or an error if a column name appears in more than one map or the column lengths differ
+func FromDictTyped(floats map[string][]float64, ints map[string][]int64, strs map[string][]string) (*dataframe.DataFrame, error) {
+	cols := make(map[string]collection.Series, len(floats)+len(ints)+len(strs))
+	add := func(name string, series collection.Series, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed creating series for column %s: %w", name, err)
+		}
+		if _, exists := cols[name]; exists {
+			return fmt.Errorf("duplicate column name: %s", name)
+		}
+		cols[name] = series
+		return nil
+	}
+
+	for name, values := range floats {
+		s, err := collection.NewFloat64SeriesFromData(values, nil)
+		if err := add(name, s, err); err != nil {
+			return nil, err
+		}
+	}
+	for name, values := range ints {
+		s, err := collection.NewInt64SeriesFromData(values, nil)
+		if err := add(name, s, err); err != nil {
+			return nil, err
+		}
+	}
+	for name, values := range strs {
+		s, err := collection.NewStringSeriesFromData(values, nil)
+		if err := add(name, s, err); err != nil {
+			return nil, err
+		}
+	}
+	return NewDataFrameFromSeries(cols, sortedKeys(cols))
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
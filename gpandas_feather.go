@@ -0,0 +1,142 @@
+package gpandas
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/apache/arrow/go/v15/arrow"
+	"github.com/apache/arrow/go/v15/arrow/array"
+	"github.com/apache/arrow/go/v15/arrow/ipc"
+	"github.com/apache/arrow/go/v15/arrow/memory"
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// Read_feather reads a Feather v2 file (the Arrow IPC file format) into a
+// DataFrame. Compressed (LZ4 or ZSTD) and uncompressed files are supported.
+//
+// Arrow types are mapped to Series as follows: float16/32/64 -> Float64,
+// signed and unsigned integers -> Int64, bool -> Bool, utf8/large_utf8 ->
+// String, and timestamp/date32/date64 -> DateTime. Null masks are preserved.
+// Other Arrow types return an error. A default integer index is created.
+//
+// Example:
+//
+//	df, err := gp.Read_feather("data.feather")
+func (GoPandas) Read_feather(filepath string) (*dataframe.DataFrame, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %w", err)
+	}
+	defer f.Close()
+
+	r, err := ipc.NewFileReader(f, ipc.WithAllocator(memory.NewGoAllocator()))
+	if err != nil {
+		return nil, fmt.Errorf("error opening feather file: %w", err)
+	}
+	defer r.Close()
+
+	schema := r.Schema()
+	fields := schema.Fields()
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("feather file has no columns")
+	}
+
+	columns := make(map[string]collection.Series, len(fields))
+	order := make([]string, len(fields))
+	for i, field := range fields {
+		s, err := newSeriesForArrowType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("column '%s': %w", field.Name, err)
+		}
+		columns[field.Name] = s
+		order[i] = field.Name
+	}
+
+	for i := 0; i < r.NumRecords(); i++ {
+		record, err := r.Record(i)
+		if err != nil {
+			return nil, fmt.Errorf("error reading record batch %d: %w", i, err)
+		}
+		for c, name := range order {
+			if err := appendArrowColumn(columns[name], record.Column(c)); err != nil {
+				return nil, fmt.Errorf("column '%s': %w", name, err)
+			}
+		}
+	}
+
+	return NewDataFrameFromSeries(columns, order)
+}
+
+// newSeriesForArrowType returns an empty Series able to hold an Arrow type.
+func newSeriesForArrowType(dt arrow.DataType) (collection.Series, error) {
+	switch dt.ID() {
+	case arrow.FLOAT16, arrow.FLOAT32, arrow.FLOAT64:
+		return collection.NewFloat64Series(0), nil
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return collection.NewInt64Series(0), nil
+	case arrow.BOOL:
+		return collection.NewBoolSeries(0), nil
+	case arrow.STRING, arrow.LARGE_STRING:
+		return collection.NewStringSeries(0), nil
+	case arrow.TIMESTAMP, arrow.DATE32, arrow.DATE64:
+		return collection.NewDateTimeSeries(0), nil
+	default:
+		return nil, fmt.Errorf("unsupported arrow type %s", dt)
+	}
+}
+
+// appendArrowColumn appends every value of an Arrow array to s.
+func appendArrowColumn(s collection.Series, arr arrow.Array) error {
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			s.AppendNull()
+			continue
+		}
+		var val any
+		switch a := arr.(type) {
+		case *array.Float16:
+			val = float64(a.Value(i).Float32())
+		case *array.Float32:
+			val = float64(a.Value(i))
+		case *array.Float64:
+			val = a.Value(i)
+		case *array.Int8:
+			val = int64(a.Value(i))
+		case *array.Int16:
+			val = int64(a.Value(i))
+		case *array.Int32:
+			val = int64(a.Value(i))
+		case *array.Int64:
+			val = a.Value(i)
+		case *array.Uint8:
+			val = int64(a.Value(i))
+		case *array.Uint16:
+			val = int64(a.Value(i))
+		case *array.Uint32:
+			val = int64(a.Value(i))
+		case *array.Uint64:
+			val = int64(a.Value(i))
+		case *array.Boolean:
+			val = a.Value(i)
+		case *array.String:
+			val = a.Value(i)
+		case *array.LargeString:
+			val = a.Value(i)
+		case *array.Timestamp:
+			unit := a.DataType().(*arrow.TimestampType).Unit
+			val = a.Value(i).ToTime(unit)
+		case *array.Date32:
+			val = a.Value(i).ToTime()
+		case *array.Date64:
+			val = a.Value(i).ToTime()
+		default:
+			return fmt.Errorf("unsupported arrow array %T", arr)
+		}
+		if err := s.Append(val); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package gpandas
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+// RangeIndex creates a DataFrame with no columns whose index holds the
+// integers from start up to (but not including) stop in steps of step, as
+// decimal strings. Columns of matching length can then be added with
+// DataFrame.Assign; a column of any other length is rejected.
+//
+// This is analogous to pd.DataFrame(index=pd.RangeIndex(start, stop, step,
+// name=name)) in pandas.
+//
+// Parameters:
+//
+//	start: The first index value
+//	stop: The end of the range (exclusive)
+//	step: The difference between consecutive values; negative for a descending range
+//	name: The index name stored in IndexName (may be empty)
+//
+// Returns:
+//
+//	A pointer to a DataFrame with the range index and no columns, or an error if step is 0
+//
+// Example:
+//
+//	df, err := gpandas.RangeIndex(0, 100, 1, "id")
+//	err = df.Assign("Score", scores) // scores must have 100 values
+func RangeIndex(start, stop, step int, name string) (*dataframe.DataFrame, error) {
+	if step == 0 {
+		return nil, errors.New("step must not be zero")
+	}
+	index := []string{}
+	for v := start; (step > 0 && v < stop) || (step < 0 && v > stop); v += step {
+		index = append(index, strconv.Itoa(v))
+	}
+	return &dataframe.DataFrame{
+		Columns:     make(map[string]collection.Series),
+		ColumnOrder: []string{},
+		Index:       index,
+		IndexName:   name,
+	}, nil
+}
+
+// DatetimeIndex creates a DataFrame with a single DateTimeSeries column
+// holding periods timestamps spaced freq apart, starting at start. freq is a
+// pandas frequency string:
+//   - "S": seconds
+//   - "T" or "min": minutes
+//   - "H": hours
+//   - "D": days
+//   - "W": weeks
+//   - "M": calendar months
+//   - "A" or "Y": calendar years
+//
+// An integer multiple may precede the unit, as in "15min" or "2H". Day and
+// longer steps are calendar steps, so they keep the wall-clock time of start
+// across daylight saving changes. Monthly and yearly steps keep the day of
+// month of start, clamped to the last day of shorter months: a Jan 31 start
+// gives Feb 29 (or 28), Mar 31, Apr 30, and so on.
+//
+// The column is named name ("timestamp" if empty) and the DataFrame gets a
+// default integer index, which makes it a scaffold for SetIndex or Resample.
+//
+// This is analogous to pd.DataFrame({name: pd.date_range(start, periods=periods,
+// freq=freq)}) in pandas.
+//
+// Parameters:
+//
+//	start: The first timestamp
+//	periods: The number of timestamps to generate
+//	freq: The spacing between timestamps
+//	name: The column name
+//
+// Returns:
+//
+//	A pointer to a DataFrame with one datetime column, or an error if periods is negative or freq is not recognised
+//
+// Example:
+//
+//	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	df, err := gpandas.DatetimeIndex(start, 24, "H", "hour")
+func DatetimeIndex(start time.Time, periods int, freq string, name string) (*dataframe.DataFrame, error) {
+	if periods < 0 {
+		return nil, fmt.Errorf("periods must be non-negative, got %d", periods)
+	}
+	step, err := parseDateFreq(freq)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = "timestamp"
+	}
+
+	times := make([]time.Time, periods)
+	for i := range times {
+		times[i] = step(start, i)
+	}
+	series, err := collection.NewDateTimeSeriesFromData(times, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating series for column %s: %w", name, err)
+	}
+	return NewDataFrameFromSeries(map[string]collection.Series{name: series}, []string{name})
+}
+
+// parseDateFreq returns a function giving the i-th timestamp after start for
+// a frequency string such as "D" or "15min".
+func parseDateFreq(freq string) (func(start time.Time, i int) time.Time, error) {
+	digits := len(freq) - len(strings.TrimLeft(freq, "0123456789"))
+	mult := 1
+	if digits > 0 {
+		n, err := strconv.Atoi(freq[:digits])
+		if err != nil || n == 0 {
+			return nil, fmt.Errorf("invalid frequency '%s'", freq)
+		}
+		mult = n
+	}
+
+	var unit time.Duration
+	switch freq[digits:] {
+	case "S":
+		unit = time.Second
+	case "T", "min":
+		unit = time.Minute
+	case "H":
+		unit = time.Hour
+	case "D":
+		return func(start time.Time, i int) time.Time { return start.AddDate(0, 0, i*mult) }, nil
+	case "W":
+		return func(start time.Time, i int) time.Time { return start.AddDate(0, 0, 7*i*mult) }, nil
+	case "M":
+		return func(start time.Time, i int) time.Time { return addMonthsClamped(start, i*mult) }, nil
+	case "A", "Y":
+		return func(start time.Time, i int) time.Time { return addMonthsClamped(start, 12*i*mult) }, nil
+	default:
+		return nil, fmt.Errorf("unsupported frequency '%s' (use S, T/min, H, D, W, M, A or Y)", freq)
+	}
+	return func(start time.Time, i int) time.Time {
+		return start.Add(time.Duration(i*mult) * unit)
+	}, nil
+}
+
+// addMonthsClamped adds months to t, keeping its day of month unless the
+// target month is shorter, in which case the last day of that month is used.
+// Unlike time.AddDate it never rolls over into the following month.
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(day, lastDay)-1)
+}
@@ -2,6 +2,7 @@ package gpandas
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -80,3 +81,208 @@ func (GoPandas) Read_parquet(filepath string) (*dataframe.DataFrame, error) {
 
 	return dataframe.NewDataFrameFromColumns(order, cols)
 }
+
+// ParquetReadOptions configures Read_parquet_opts.
+type ParquetReadOptions struct {
+	// Columns lists the columns to decode, in output order. Other column
+	// chunks are never read from the file. Empty means all columns in schema
+	// order.
+	Columns []string
+
+	// RowGroupFilter, if set, is called once per row group with the minimum
+	// and maximum value of each selected column (taken from the file's page
+	// index). Returning false skips the row group without decoding it.
+	// Columns without statistics are absent from the maps.
+	RowGroupFilter func(min, max map[string]any) bool
+
+	// BatchSize is the number of values decoded per read call, which sizes the
+	// scratch buffer reused across pages. It does not bound the memory of the
+	// result: every selected value is still materialised in the returned
+	// DataFrame. Zero or negative uses 1024.
+	BatchSize int
+}
+
+// Read_parquet_opts reads a Parquet file into a DataFrame, decoding only the
+// requested columns and skipping row groups rejected by opts.RowGroupFilter.
+//
+// Unlike Read_parquet followed by Select or a filter, pruning happens at the
+// reader level: skipped columns and row groups are never decoded. Only flat
+// (non-nested, non-repeated) columns are supported. Column types are mapped as
+// in Read_parquet, and null values in optional columns become nulls.
+//
+// Example:
+//
+//	df, err := gp.Read_parquet_opts("events.parquet", gpandas.ParquetReadOptions{
+//	    Columns: []string{"user_id", "amount"},
+//	    RowGroupFilter: func(min, max map[string]any) bool {
+//	        hi, ok := max["amount"].(float64)
+//	        return !ok || hi >= 100
+//	    },
+//	})
+func (GoPandas) Read_parquet_opts(filepath string, opts ParquetReadOptions) (*dataframe.DataFrame, error) {
+	f, err := os.Open(filepath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error stating file: %w", err)
+	}
+
+	pf, err := parquet.OpenFile(f, info.Size())
+	if err != nil {
+		return nil, fmt.Errorf("error opening parquet file: %w", err)
+	}
+	schema := pf.Schema()
+
+	order := opts.Columns
+	if len(order) == 0 {
+		for _, field := range schema.Fields() {
+			order = append(order, field.Name())
+		}
+	}
+	if len(order) == 0 {
+		return nil, fmt.Errorf("parquet file has no columns")
+	}
+
+	leafIndex := make(map[string]int, len(order))
+	for _, name := range order {
+		leaf, ok := schema.Lookup(name)
+		if !ok || leaf.Node == nil || !leaf.Node.Leaf() {
+			return nil, fmt.Errorf("column '%s' not found in parquet schema", name)
+		}
+		if leaf.MaxRepetitionLevel > 0 {
+			return nil, fmt.Errorf("column '%s' is repeated, which is not supported", name)
+		}
+		leafIndex[name] = leaf.ColumnIndex
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1024
+	}
+	buf := make([]parquet.Value, batchSize)
+
+	cols := make(map[string]dataframe.Column, len(order))
+	for _, rg := range pf.RowGroups() {
+		chunks := rg.ColumnChunks()
+
+		if opts.RowGroupFilter != nil {
+			mins, maxs := rowGroupStats(chunks, order, leafIndex)
+			if !opts.RowGroupFilter(mins, maxs) {
+				continue
+			}
+		}
+
+		for _, name := range order {
+			values, err := readColumnChunk(chunks[leafIndex[name]], buf)
+			if err != nil {
+				return nil, fmt.Errorf("error reading column '%s': %w", name, err)
+			}
+			cols[name] = append(cols[name], values...)
+		}
+	}
+
+	for _, name := range order {
+		if cols[name] == nil {
+			cols[name] = dataframe.Column{}
+		}
+	}
+	return dataframe.NewDataFrameFromColumns(order, cols)
+}
+
+// rowGroupStats returns the minimum and maximum value of each named column in
+// a row group, aggregated over the pages listed in the column index.
+func rowGroupStats(chunks []parquet.ColumnChunk, order []string, leafIndex map[string]int) (map[string]any, map[string]any) {
+	mins := make(map[string]any, len(order))
+	maxs := make(map[string]any, len(order))
+	for _, name := range order {
+		chunk := chunks[leafIndex[name]]
+		index, err := chunk.ColumnIndex()
+		if err != nil {
+			continue
+		}
+		typ := chunk.Type()
+		var lo, hi parquet.Value
+		found := false
+		for p := 0; p < index.NumPages(); p++ {
+			if index.NullPage(p) {
+				continue
+			}
+			pmin, pmax := index.MinValue(p), index.MaxValue(p)
+			if !found {
+				lo, hi, found = pmin, pmax, true
+				continue
+			}
+			if typ.Compare(pmin, lo) < 0 {
+				lo = pmin
+			}
+			if typ.Compare(pmax, hi) > 0 {
+				hi = pmax
+			}
+		}
+		if found {
+			mins[name] = parquetValueToGo(lo)
+			maxs[name] = parquetValueToGo(hi)
+		}
+	}
+	return mins, maxs
+}
+
+// readColumnChunk decodes every value of a flat column chunk, reading at most
+// len(buf) values at a time.
+func readColumnChunk(chunk parquet.ColumnChunk, buf []parquet.Value) ([]any, error) {
+	pages := chunk.Pages()
+	defer pages.Close()
+
+	out := make([]any, 0, chunk.NumValues())
+	for {
+		page, err := pages.ReadPage()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		reader := page.Values()
+		for {
+			n, err := reader.ReadValues(buf)
+			for _, v := range buf[:n] {
+				out = append(out, parquetValueToGo(v))
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				parquet.Release(page)
+				return nil, err
+			}
+		}
+		parquet.Release(page)
+	}
+	return out, nil
+}
+
+// parquetValueToGo converts a parquet value to the Go type used by gpandas
+// Series: int64, float64, bool or string. Nulls become nil.
+func parquetValueToGo(v parquet.Value) any {
+	if v.IsNull() {
+		return nil
+	}
+	switch v.Kind() {
+	case parquet.Boolean:
+		return v.Boolean()
+	case parquet.Int32:
+		return int64(v.Int32())
+	case parquet.Int64:
+		return v.Int64()
+	case parquet.Float:
+		return float64(v.Float())
+	case parquet.Double:
+		return v.Double()
+	default:
+		return string(v.ByteArray())
+	}
+}
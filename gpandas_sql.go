@@ -1,10 +1,16 @@
 package gpandas
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/apoplexi24/gpandas/dataframe"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -152,8 +158,28 @@ func (GoPandas) Read_sql(query string, db_config DbConfig) (*dataframe.DataFrame
 		return nil, fmt.Errorf("error iterating over rows: %w", err)
 	}
 
-	// Build typed Series per column based on database column types
-	cols := make(map[string]collection.Series, columnCount)
+	cols, err := buildSQLSeries(columns, columnTypes, colBuffers, colMasks)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create default index
+	rowCount := 0
+	if columnCount > 0 && len(colBuffers[0]) > 0 {
+		rowCount = len(colBuffers[0])
+	}
+	index := make([]string, rowCount)
+	for i := 0; i < rowCount; i++ {
+		index[i] = fmt.Sprintf("%d", i)
+	}
+
+	return &dataframe.DataFrame{Columns: cols, ColumnOrder: append([]string(nil), columns...), Index: index}, nil
+}
+
+// buildSQLSeries builds a typed Series per column from the scanned row
+// buffers, choosing the Series type from each column's database scan type.
+func buildSQLSeries(columns []string, columnTypes []*sql.ColumnType, colBuffers [][]any, colMasks [][]bool) (map[string]collection.Series, error) {
+	cols := make(map[string]collection.Series, len(columns))
 	for i, name := range columns {
 		var s collection.Series
 		var err error
@@ -184,18 +210,172 @@ func (GoPandas) Read_sql(query string, db_config DbConfig) (*dataframe.DataFrame
 		}
 		cols[name] = s
 	}
+	return cols, nil
+}
 
-	// Create default index
-	rowCount := 0
-	if columnCount > 0 && len(colBuffers[0]) > 0 {
-		rowCount = len(colBuffers[0])
+// Read_sql_chunked executes a SQL query and streams the results as a sequence
+// of DataFrames, each holding at most chunksize rows, so that large result
+// sets never have to be materialized in memory at once.
+//
+// It is equivalent to Read_sql_chunked_context with context.Background().
+//
+// Parameters:
+//
+//	query: The SQL query string to execute.
+//	db_config: A DbConfig struct containing database connection parameters (see Read_sql).
+//	chunksize: The maximum number of rows per DataFrame; must be positive.
+//
+// Returns:
+//   - A channel of DataFrames, closed once all rows have been sent or an error occurs.
+//   - A channel receiving at most one error, closed after the DataFrame channel.
+//   - A stop function that abandons the stream. It must be called if the caller
+//     stops reading before the DataFrame channel is closed, otherwise the
+//     feeding goroutine and its database connection are never released; it
+//     is safe to call more than once and after the stream has finished.
+//
+// Examples:
+//
+//	gp := gpandas.GoPandas{}
+//	chunks, errs, stop := gp.Read_sql_chunked("SELECT * FROM events", config, 10000)
+//	defer stop()
+//	for df := range chunks {
+//	    process(df)
+//	}
+//	if err := <-errs; err != nil {
+//	    log.Fatal(err)
+//	}
+func (gp GoPandas) Read_sql_chunked(query string, db_config DbConfig, chunksize int) (<-chan *dataframe.DataFrame, <-chan error, func()) {
+	return gp.Read_sql_chunked_context(context.Background(), query, db_config, chunksize)
+}
+
+// Read_sql_chunked_context is like Read_sql_chunked but also stops when ctx
+// is cancelled. Calling the returned stop function or cancelling ctx makes
+// the feeding goroutine close the rows cursor and the database connection
+// and report the context error (context.Canceled for stop) on the error
+// channel, unless every row had already been sent.
+//
+// Chunks share one column layout and continue the default integer index, so
+// the second chunk of size 100 is labelled "100" through "199". Column types
+// are chosen per chunk from the database scan types, exactly as in Read_sql.
+//
+// Examples:
+//
+//	chunks, errs, stop := gp.Read_sql_chunked_context(ctx, query, config, 500)
+//	first := <-chunks
+//	stop() // stop reading the remaining rows
+func (GoPandas) Read_sql_chunked_context(ctx context.Context, query string, db_config DbConfig, chunksize int) (<-chan *dataframe.DataFrame, <-chan error, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan *dataframe.DataFrame)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer cancel()
+		defer close(errc)
+		defer close(out)
+		if err := streamSQLChunks(ctx, query, &db_config, chunksize, out); err != nil {
+			errc <- err
+		}
+	}()
+	return out, errc, cancel
+}
+
+// streamSQLChunks runs query and sends its rows to out in DataFrames of at
+// most chunksize rows.
+func streamSQLChunks(ctx context.Context, query string, db_config *DbConfig, chunksize int, out chan<- *dataframe.DataFrame) error {
+	if chunksize <= 0 {
+		return fmt.Errorf("chunksize must be positive, got %d", chunksize)
 	}
-	index := make([]string, rowCount)
-	for i := 0; i < rowCount; i++ {
-		index[i] = fmt.Sprintf("%d", i)
+
+	DB, err := connect_to_db(db_config)
+	if err != nil {
+		return fmt.Errorf("database connection error: %w", err)
 	}
+	defer DB.Close()
 
-	return &dataframe.DataFrame{Columns: cols, ColumnOrder: append([]string(nil), columns...), Index: index}, nil
+	results, err := DB.QueryContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("query execution error: %w", err)
+	}
+	defer results.Close()
+
+	return streamSQLRows(ctx, results, chunksize, out)
+}
+
+// streamSQLRows reads rows and sends them to out in DataFrames of at most
+// chunksize rows, stopping early when ctx is done. The caller closes rows.
+func streamSQLRows(ctx context.Context, results *sql.Rows, chunksize int, out chan<- *dataframe.DataFrame) error {
+	columns, err := results.Columns()
+	if err != nil {
+		return fmt.Errorf("error getting columns: %w", err)
+	}
+	columnTypes, err := results.ColumnTypes()
+	if err != nil {
+		return fmt.Errorf("error getting column types: %w", err)
+	}
+
+	columnCount := len(columns)
+	values := make([]any, columnCount)
+	valuePtrs := make([]any, columnCount)
+	for i := range values {
+		valuePtrs[i] = &values[i]
+	}
+
+	var colBuffers [][]any
+	var colMasks [][]bool
+	resetBuffers := func() {
+		colBuffers = make([][]any, columnCount)
+		colMasks = make([][]bool, columnCount)
+		for i := range colBuffers {
+			colBuffers[i] = make([]any, 0, chunksize)
+			colMasks[i] = make([]bool, 0, chunksize)
+		}
+	}
+	resetBuffers()
+
+	rowsSent, buffered := 0, 0
+	flush := func() error {
+		cols, err := buildSQLSeries(columns, columnTypes, colBuffers, colMasks)
+		if err != nil {
+			return err
+		}
+		index := make([]string, buffered)
+		for i := range index {
+			index[i] = fmt.Sprintf("%d", rowsSent+i)
+		}
+		df := &dataframe.DataFrame{Columns: cols, ColumnOrder: append([]string(nil), columns...), Index: index}
+		select {
+		case out <- df:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		rowsSent += buffered
+		buffered = 0
+		resetBuffers()
+		return nil
+	}
+
+	for results.Next() {
+		if err := results.Scan(valuePtrs...); err != nil {
+			return fmt.Errorf("error scanning row: %w", err)
+		}
+		for i := range values {
+			colBuffers[i] = append(colBuffers[i], values[i])
+			colMasks[i] = append(colMasks[i], values[i] == nil)
+		}
+		buffered++
+		if buffered == chunksize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := results.Err(); err != nil {
+		return fmt.Errorf("error iterating over rows: %w", err)
+	}
+	if buffered > 0 {
+		return flush()
+	}
+	return nil
 }
 
 // Helper functions to create typed series from []any with masks
@@ -308,9 +488,13 @@ func createBoolSeriesFromAny(values []any, mask []bool) (*collection.BoolSeries,
 //   - An error if the query execution fails or if there are issues with the BigQuery client.
 //
 // The DataFrame's structure will match the query results:
-//   - Columns will be named according to the SELECT statement
-//   - Data types will be converted from BigQuery types to Go types
+//   - Columns will be named and ordered according to the result schema
+//   - Column types come from the result schema rather than the data:
+//     INTEGER -> Int64Series, FLOAT -> Float64Series, STRING/BYTES ->
+//     StringSeries, BOOLEAN -> BoolSeries, TIMESTAMP -> DateTimeSeries;
+//     other types and repeated fields use AnySeries
 //   - NULL values are properly tracked using the boolean mask approach
+//   - A query that returns no rows yields an empty DataFrame with typed columns
 //
 // Examples:
 //
@@ -326,7 +510,44 @@ func createBoolSeriesFromAny(values []any, mask []bool) (*collection.BoolSeries,
 //	// Charlie | 28  | Boston
 //
 // Note: Requires appropriate Google Cloud credentials to be configured in the environment.
-func (GoPandas) From_gbq(query string, projectID string) (*dataframe.DataFrame, error) {
+func (gp GoPandas) From_gbq(query string, projectID string) (*dataframe.DataFrame, error) {
+	return gp.From_gbq_with_options(query, projectID, GbqOptions{})
+}
+
+// GbqOptions configures From_gbq_with_options.
+type GbqOptions struct {
+	// MaxRows caps the number of rows read. Zero means no limit.
+	MaxRows int64
+	// StartIndex skips this many rows of the result before reading, via the
+	// iterator's StartIndex. It is ignored when the Storage Read API is used.
+	// Index labels start at StartIndex, so they give each row's position in
+	// the full result.
+	StartIndex uint64
+}
+
+// From_gbq_with_options is like From_gbq but can read a window of the result
+// set: StartIndex rows are skipped and at most MaxRows rows are read. The
+// index labels continue from StartIndex, so reading rows 1000-1999 yields the
+// labels "1000" through "1999", matching From_gbq_rows with the same
+// startIndex.
+//
+// As with From_gbq, the first row is fetched before the columns are built so
+// that the iterator reports TotalRows, and every column is pre-allocated to
+// the number of rows that will be read (TotalRows less StartIndex, capped at
+// MaxRows), which avoids repeated slice growth on large result sets.
+//
+// Examples:
+//
+//	gp := gpandas.GoPandas{}
+//	// Rows 1000-1999 of the result
+//	df, err := gp.From_gbq_with_options(query, "my-project-id", gpandas.GbqOptions{
+//	    StartIndex: 1000,
+//	    MaxRows:    1000,
+//	})
+func (GoPandas) From_gbq_with_options(query string, projectID string, opts GbqOptions) (*dataframe.DataFrame, error) {
+	if opts.MaxRows < 0 {
+		return nil, fmt.Errorf("MaxRows must be non-negative, got %d", opts.MaxRows)
+	}
 	ctx := context.Background()
 
 	client, err := bigquery.NewClient(ctx, projectID)
@@ -341,112 +562,388 @@ func (GoPandas) From_gbq(query string, projectID string) (*dataframe.DataFrame,
 	if err != nil {
 		return nil, fmt.Errorf("query.Read: %v", err)
 	}
-
-	// Read the first row to determine column names
-	var firstRow map[string]bigquery.Value
-	err = it.Next(&firstRow)
-	if err == iterator.Done {
-		return nil, fmt.Errorf("no rows returned")
+	it.StartIndex = opts.StartIndex
+	if opts.MaxRows > 0 && opts.MaxRows < math.MaxInt32 {
+		// Don't fetch larger pages than we are going to read.
+		it.PageInfo().MaxSize = int(opts.MaxRows)
 	}
-	if err != nil {
+
+	// The iterator fills in Schema and TotalRows together with the first page,
+	// so read the first row before building the columns.
+	var row []bigquery.Value
+	err = it.Next(&row)
+	if err != nil && err != iterator.Done {
 		return nil, fmt.Errorf("iterator.Next: %v", err)
 	}
-
-	// Extract column names from the first row's keys
-	var columns []string
-	for col := range firstRow {
-		columns = append(columns, col)
+	if len(it.Schema) == 0 {
+		return nil, fmt.Errorf("no rows returned")
 	}
 
-	// Initialize per-column buffers with first row
-	colBuffers := make(map[string][]any, len(columns))
-	colMasks := make(map[string][]bool, len(columns))
-	for _, col := range columns {
-		val := firstRow[col]
-		if val == nil {
-			colBuffers[col] = []any{nil}
-			colMasks[col] = []bool{true}
-		} else {
-			colBuffers[col] = []any{val}
-			colMasks[col] = []bool{false}
-		}
+	// Build one pre-allocated typed Series per schema field, in schema order.
+	capacity := uint64(0)
+	if it.TotalRows > opts.StartIndex {
+		capacity = it.TotalRows - opts.StartIndex
+	}
+	if opts.MaxRows > 0 && capacity > uint64(opts.MaxRows) {
+		capacity = uint64(opts.MaxRows)
 	}
+	columns := newBigQueryColumns(it.Schema, int(capacity))
 
-	// Process actual data here
-	for {
-		var row map[string]bigquery.Value
-		err := it.Next(&row)
-		if err == iterator.Done {
+	var rowsRead int64
+	for err != iterator.Done {
+		if err := columns.appendRow(row); err != nil {
+			return nil, err
+		}
+		rowsRead++
+		if opts.MaxRows > 0 && rowsRead == opts.MaxRows {
 			break
 		}
-		if err != nil {
+
+		row = nil
+		err = it.Next(&row)
+		if err != nil && err != iterator.Done {
 			return nil, fmt.Errorf("iterator.Next: %v", err)
 		}
+	}
 
-		// Append values to column buffers with null tracking
-		for _, col := range columns {
-			val := row[col]
-			if val == nil {
-				colBuffers[col] = append(colBuffers[col], nil)
-				colMasks[col] = append(colMasks[col], true)
-			} else {
-				colBuffers[col] = append(colBuffers[col], val)
-				colMasks[col] = append(colMasks[col], false)
-			}
+	return columns.dataFrame(opts.StartIndex), nil
+}
+
+// From_gbq_rows converts BigQuery result rows, such as those read from a
+// *bigquery.RowIterator into []bigquery.Value, into a DataFrame. It applies
+// the same conversions as From_gbq, so callers that run their own queries or
+// page through results themselves get identically typed columns.
+//
+// Parameters:
+//
+//	schema: The result schema (e.g. RowIterator.Schema); it fixes the column names, order and types.
+//	rows: The rows to convert, each holding at most one value per schema field.
+//	startIndex: The position of rows[0] in the full result, used as the first index label.
+//
+// Returns:
+//   - A pointer to a DataFrame with one typed column per schema field (see From_gbq).
+//   - An error if the schema is empty, a row has more values than the schema has
+//     fields, or a value does not match its column type.
+//
+// Missing trailing values and nil values become nulls.
+//
+// Examples:
+//
+//	var page [][]bigquery.Value
+//	// ... fill page from it.Next(&row)
+//	df, err := gp.From_gbq_rows(it.Schema, page, 0)
+func (GoPandas) From_gbq_rows(schema bigquery.Schema, rows [][]bigquery.Value, startIndex uint64) (*dataframe.DataFrame, error) {
+	if len(schema) == 0 {
+		return nil, fmt.Errorf("schema has no fields")
+	}
+	columns := newBigQueryColumns(schema, len(rows))
+	for i, row := range rows {
+		if err := columns.appendRow(row); err != nil {
+			return nil, fmt.Errorf("row %d: %w", i, err)
 		}
 	}
+	return columns.dataFrame(startIndex), nil
+}
 
-	// Build typed Series per column (infer type from first non-null value)
-	cols := make(map[string]collection.Series, len(columns))
-	for _, name := range columns {
-		s, err := createTypedSeriesFromBigQuery(colBuffers[name], colMasks[name])
-		if err != nil {
-			return nil, fmt.Errorf("failed creating series for column %s: %w", name, err)
+// bigQueryColumns accumulates BigQuery rows into one typed Series per schema
+// field, in schema order.
+type bigQueryColumns struct {
+	schema bigquery.Schema
+	series []collection.Series
+}
+
+// newBigQueryColumns returns an empty bigQueryColumns whose Series are
+// pre-allocated to capacity rows.
+func newBigQueryColumns(schema bigquery.Schema, capacity int) *bigQueryColumns {
+	series := make([]collection.Series, len(schema))
+	for i, field := range schema {
+		series[i] = newSeriesForBigQueryField(field, capacity)
+	}
+	return &bigQueryColumns{schema: schema, series: series}
+}
+
+// appendRow appends one row. Values missing at the end of row are nulls.
+func (c *bigQueryColumns) appendRow(row []bigquery.Value) error {
+	if len(row) > len(c.schema) {
+		return fmt.Errorf("row has %d values but the schema has %d fields", len(row), len(c.schema))
+	}
+	for i, field := range c.schema {
+		var val bigquery.Value
+		if i < len(row) {
+			val = row[i]
+		}
+		if err := appendBigQueryValue(c.series[i], field, val); err != nil {
+			return fmt.Errorf("failed appending to column %s: %w", field.Name, err)
 		}
-		cols[name] = s
+	}
+	return nil
+}
+
+// dataFrame returns the accumulated columns as a DataFrame whose index labels
+// count up from firstLabel.
+func (c *bigQueryColumns) dataFrame(firstLabel uint64) *dataframe.DataFrame {
+	columns := make([]string, len(c.schema))
+	cols := make(map[string]collection.Series, len(c.schema))
+	for i, field := range c.schema {
+		columns[i] = field.Name
+		cols[field.Name] = c.series[i]
 	}
 
-	// Create default index
 	rowCount := 0
-	if len(columns) > 0 {
-		rowCount = len(colBuffers[columns[0]])
+	if len(c.series) > 0 {
+		rowCount = c.series[0].Len()
 	}
 	index := make([]string, rowCount)
-	for i := 0; i < rowCount; i++ {
-		index[i] = fmt.Sprintf("%d", i)
+	for i := range index {
+		index[i] = strconv.FormatUint(firstLabel+uint64(i), 10)
 	}
 
-	return &dataframe.DataFrame{Columns: cols, ColumnOrder: append([]string(nil), columns...), Index: index}, nil
+	return &dataframe.DataFrame{Columns: cols, ColumnOrder: columns, Index: index}
 }
 
-// createTypedSeriesFromBigQuery creates a typed series by inferring type from BigQuery values
-func createTypedSeriesFromBigQuery(values []any, mask []bool) (collection.Series, error) {
-	// Find first non-null value to infer type
-	var inferredType reflect.Type
-	for i, v := range values {
-		if !mask[i] && v != nil {
-			inferredType = reflect.TypeOf(v)
-			break
+// newSeriesForBigQueryField returns an empty Series with the given capacity
+// whose type matches the BigQuery field type. Repeated (array) fields and
+// types without a dedicated Series (e.g. NUMERIC, DATE, RECORD) use AnySeries.
+func newSeriesForBigQueryField(field *bigquery.FieldSchema, capacity int) collection.Series {
+	if field.Repeated {
+		return collection.NewAnySeries(capacity)
+	}
+	switch field.Type {
+	case bigquery.IntegerFieldType:
+		return collection.NewInt64Series(capacity)
+	case bigquery.FloatFieldType:
+		return collection.NewFloat64Series(capacity)
+	case bigquery.StringFieldType, bigquery.BytesFieldType:
+		return collection.NewStringSeries(capacity)
+	case bigquery.BooleanFieldType:
+		return collection.NewBoolSeries(capacity)
+	case bigquery.TimestampFieldType:
+		return collection.NewDateTimeSeries(capacity)
+	default:
+		return collection.NewAnySeries(capacity)
+	}
+}
+
+// appendBigQueryValue appends a BigQuery value to a Series created by
+// newSeriesForBigQueryField. nil values are appended as nulls, and BYTES
+// values are stored as strings.
+func appendBigQueryValue(s collection.Series, field *bigquery.FieldSchema, val bigquery.Value) error {
+	if val == nil {
+		s.AppendNull()
+		return nil
+	}
+	if b, ok := val.([]byte); ok && !field.Repeated && field.Type == bigquery.BytesFieldType {
+		return s.Append(string(b))
+	}
+	return s.Append(val)
+}
+
+// ToGBQOptions configures To_gbq.
+type ToGBQOptions struct {
+	// WriteDisposition is "WRITE_EMPTY" (the default; fail if the table
+	// holds data), "WRITE_TRUNCATE" or "WRITE_APPEND". It applies to the
+	// first chunk; later chunks are always appended.
+	WriteDisposition string
+	// CreateDisposition is "CREATE_IF_NEEDED" (the default) or
+	// "CREATE_NEVER".
+	CreateDisposition string
+	// ChunkSize is the number of rows sent per load job. Zero sends every
+	// row in a single job.
+	ChunkSize int
+}
+
+// To_gbq writes a DataFrame to a BigQuery table, the write counterpart of
+// From_gbq.
+//
+// Like From_gbq it is a GoPandas method that takes the DataFrame, rather than
+// a DataFrame method, so that the dataframe package does not depend on the
+// BigQuery client.
+//
+// Parameters:
+//
+//	df: The DataFrame to write. The index is not written.
+//	tableID: "dataset.table", or "project.dataset.table" naming the project explicitly.
+//	projectID: The Google Cloud Project ID the client and load jobs run in. It may
+//	  be empty when tableID names the project; otherwise the project in tableID
+//	  must equal projectID, since cross-project writes are not supported.
+//	opts: Write and create dispositions and the number of rows per load job.
+//
+// Returns:
+//   - An error if the arguments are invalid, the client cannot be created, or a
+//     load job fails.
+//
+// Rows are sent as newline-delimited JSON load jobs of at most ChunkSize rows
+// each, so no streaming buffer is involved and WriteDisposition is honoured.
+// The table schema is derived from the column types: float columns map to
+// FLOAT, integer columns to INTEGER, bool columns to BOOLEAN, datetime
+// columns to TIMESTAMP, and everything else (string, categorical, any) to
+// STRING, with values of an any column formatted via fmt.Sprintf("%v").
+// Every field is NULLABLE and nulls are written as NULL.
+//
+// Examples:
+//
+//	gp := gpandas.GoPandas{}
+//	err := gp.To_gbq(df, "analytics.daily_sales", "my-project-id", gpandas.ToGBQOptions{
+//	    WriteDisposition: "WRITE_TRUNCATE",
+//	    ChunkSize:        10000,
+//	})
+//
+// Note: Requires appropriate Google Cloud credentials to be configured in the environment.
+func (GoPandas) To_gbq(df *dataframe.DataFrame, tableID string, projectID string, opts ToGBQOptions) error {
+	if df == nil {
+		return fmt.Errorf("DataFrame is nil")
+	}
+	parts := strings.Split(tableID, ".")
+	if len(parts) == 3 {
+		if projectID != "" && parts[0] != projectID {
+			return fmt.Errorf("table ID project '%s' does not match project ID '%s'", parts[0], projectID)
 		}
+		projectID, parts = parts[0], parts[1:]
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("table ID must be 'dataset.table' or 'project.dataset.table', got '%s'", tableID)
+	}
+	if projectID == "" {
+		return fmt.Errorf("project ID is required")
+	}
+
+	writeDisposition := bigquery.WriteEmpty
+	switch opts.WriteDisposition {
+	case "", string(bigquery.WriteEmpty):
+	case string(bigquery.WriteTruncate), string(bigquery.WriteAppend):
+		writeDisposition = bigquery.TableWriteDisposition(opts.WriteDisposition)
+	default:
+		return fmt.Errorf("write disposition must be 'WRITE_EMPTY', 'WRITE_TRUNCATE' or 'WRITE_APPEND', got '%s'", opts.WriteDisposition)
+	}
+	createDisposition := bigquery.CreateIfNeeded
+	switch opts.CreateDisposition {
+	case "", string(bigquery.CreateIfNeeded):
+	case string(bigquery.CreateNever):
+		createDisposition = bigquery.CreateNever
+	default:
+		return fmt.Errorf("create disposition must be 'CREATE_IF_NEEDED' or 'CREATE_NEVER', got '%s'", opts.CreateDisposition)
+	}
+	if opts.ChunkSize < 0 {
+		return fmt.Errorf("chunk size must be non-negative, got %d", opts.ChunkSize)
+	}
+
+	df.RLock()
+	defer df.RUnlock()
+
+	if len(df.ColumnOrder) == 0 {
+		return fmt.Errorf("DataFrame has no columns")
+	}
+	schema := make(bigquery.Schema, len(df.ColumnOrder))
+	for i, name := range df.ColumnOrder {
+		schema[i] = &bigquery.FieldSchema{Name: name, Type: bigQueryFieldType(df.Columns[name])}
+	}
+
+	ctx := context.Background()
+	client, err := bigquery.NewClient(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("bigquery.NewClient: %v", err)
 	}
+	defer client.Close()
+	table := client.Dataset(parts[0]).Table(parts[1])
 
-	if inferredType == nil {
-		// All values are null
-		return collection.NewAnySeriesFromData(values, mask)
+	rowCount := df.Columns[df.ColumnOrder[0]].Len()
+	chunkSize := opts.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = max(rowCount, 1)
 	}
+	// An empty DataFrame still runs one job so that the table is created
+	// (or truncated) as requested.
+	for start := 0; start == 0 || start < rowCount; start += chunkSize {
+		end := min(start+chunkSize, rowCount)
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		for r := start; r < end; r++ {
+			row := make(map[string]any, len(schema))
+			for i, name := range df.ColumnOrder {
+				row[name] = bigQueryJSONValue(df.Columns[name], schema[i].Type, r)
+			}
+			if err := enc.Encode(row); err != nil {
+				return fmt.Errorf("encoding row %d: %w", r, err)
+			}
+		}
+
+		src := bigquery.NewReaderSource(&buf)
+		src.SourceFormat = bigquery.JSON
+		src.Schema = schema
+		loader := table.LoaderFrom(src)
+		loader.CreateDisposition = createDisposition
+		loader.WriteDisposition = writeDisposition
+		if start > 0 {
+			loader.WriteDisposition = bigquery.WriteAppend
+		}
 
-	// Create appropriate typed series based on inferred type
-	switch inferredType.Kind() {
+		job, err := loader.Run(ctx)
+		if err != nil {
+			return fmt.Errorf("loading rows %d-%d: %v", start, end, err)
+		}
+		status, err := job.Wait(ctx)
+		if err != nil {
+			return fmt.Errorf("loading rows %d-%d: %v", start, end, err)
+		}
+		if err := status.Err(); err != nil {
+			return fmt.Errorf("loading rows %d-%d: %v", start, end, err)
+		}
+	}
+	return nil
+}
+
+// bigQueryFieldType maps a Series to the BigQuery type its values are
+// written as.
+func bigQueryFieldType(s collection.Series) bigquery.FieldType {
+	if _, ok := s.(*collection.DateTimeSeries); ok {
+		return bigquery.TimestampFieldType
+	}
+	switch s.DType().Kind() {
 	case reflect.Float64, reflect.Float32:
-		return createFloat64SeriesFromAny(values, mask)
-	case reflect.Int64, reflect.Int32, reflect.Int16, reflect.Int8, reflect.Int:
-		return createInt64SeriesFromAny(values, mask)
-	case reflect.String:
-		return createStringSeriesFromAny(values, mask)
+		return bigquery.FloatFieldType
+	case reflect.Int64, reflect.Int32:
+		return bigquery.IntegerFieldType
 	case reflect.Bool:
-		return createBoolSeriesFromAny(values, mask)
+		return bigquery.BooleanFieldType
+	default:
+		return bigquery.StringFieldType
+	}
+}
+
+// bigQueryJSONValue returns row r of s in the JSON form BigQuery loads for a
+// field of type ft. Non-finite floats are written as the strings BigQuery
+// accepts for them, since JSON has no literal for them.
+func bigQueryJSONValue(s collection.Series, ft bigquery.FieldType, r int) any {
+	if s.IsNull(r) {
+		return nil
+	}
+	v, _ := s.At(r)
+	switch ft {
+	case bigquery.FloatFieldType:
+		var f float64
+		switch x := v.(type) {
+		case float64:
+			f = x
+		case float32:
+			f = float64(x)
+		}
+		switch {
+		case math.IsNaN(f):
+			return "NaN"
+		case math.IsInf(f, 1):
+			return "Infinity"
+		case math.IsInf(f, -1):
+			return "-Infinity"
+		}
+		return f
+	case bigquery.TimestampFieldType:
+		return v.(time.Time).UTC().Format(time.RFC3339Nano)
+	case bigquery.StringFieldType:
+		if str, ok := v.(string); ok {
+			return str
+		}
+		return fmt.Sprintf("%v", v)
 	default:
-		// Fallback to AnySeries for other types
-		return collection.NewAnySeriesFromData(values, mask)
+		return v
 	}
 }
@@ -559,3 +559,147 @@ func TestDefaultConcatOptions(t *testing.T) {
 		t.Error("expected default Sort false")
 	}
 }
+
+// TestConcatKeys verifies that Keys prefixes index labels or column names
+func TestConcatKeys(t *testing.T) {
+	df1 := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": mustSeries(1, 2)},
+		ColumnOrder: []string{"A"},
+		Index:       []string{"0", "1"},
+	}
+	df2 := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": mustSeries(3)},
+		ColumnOrder: []string{"A"},
+		Index:       []string{"0"},
+	}
+
+	result, err := gpandas.Concat([]*dataframe.DataFrame{df1, nil, df2}, gpandas.ConcatOptions{
+		Keys:            []string{"jan", "unused", "feb"},
+		VerifyIntegrity: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedIndex := []string{"jan/0", "jan/1", "feb/0"}
+	if !strSliceEqual(result.Index, expectedIndex) {
+		t.Errorf("expected index %v, got %v", expectedIndex, result.Index)
+	}
+	if !strSliceEqual(df1.Index, []string{"0", "1"}) {
+		t.Errorf("input index was modified: %v", df1.Index)
+	}
+
+	result, err = gpandas.Concat([]*dataframe.DataFrame{df1, df2}, gpandas.ConcatOptions{
+		Axis:   gpandas.AxisColumns,
+		Keys:   []string{"left", "right"},
+		KeySep: ".",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedCols := []string{"left.A", "right.A"}
+	if !strSliceEqual(result.ColumnOrder, expectedCols) {
+		t.Errorf("expected columns %v, got %v", expectedCols, result.ColumnOrder)
+	}
+	if df1.ColumnOrder[0] != "A" {
+		t.Errorf("input columns were modified: %v", df1.ColumnOrder)
+	}
+
+	_, err = gpandas.Concat([]*dataframe.DataFrame{df1, df2}, gpandas.ConcatOptions{Keys: []string{"only"}})
+	if err == nil {
+		t.Error("expected error for mismatched keys length, got nil")
+	}
+}
+
+// TestConcatAxis1IndexOrder tests that column-wise concatenation keeps index
+// labels in encounter order, or sorts them when SortKeys is set
+func TestConcatAxis1IndexOrder(t *testing.T) {
+	df1 := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(1, 2, 3),
+		},
+		ColumnOrder: []string{"A"},
+		Index:       []string{"c", "a", "b"},
+	}
+
+	df2 := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"B": mustSeries(4, 5, 6),
+		},
+		ColumnOrder: []string{"B"},
+		Index:       []string{"e", "a", "d"},
+	}
+
+	for i := 0; i < 5; i++ {
+		result, err := gpandas.Concat([]*dataframe.DataFrame{df1, df2}, gpandas.ConcatOptions{
+			Axis: gpandas.AxisColumns,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"c", "a", "b", "e", "d"}) {
+			t.Fatalf("expected index [c a b e d], got %v", result.Index)
+		}
+	}
+
+	result, err := gpandas.Concat([]*dataframe.DataFrame{df1, df2}, gpandas.ConcatOptions{
+		Axis:     gpandas.AxisColumns,
+		SortKeys: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strSliceEqual(result.Index, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("expected index [a b c d e], got %v", result.Index)
+	}
+	if null := result.Columns["A"].IsNull(3); !null {
+		t.Error("expected A to be null at label 'd'")
+	}
+	if null := result.Columns["B"].IsNull(0); null {
+		t.Error("expected B to have a value at label 'a'")
+	}
+
+	inner, err := gpandas.Concat([]*dataframe.DataFrame{df1, df2}, gpandas.ConcatOptions{
+		Axis: gpandas.AxisColumns,
+		Join: gpandas.JoinInner,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strSliceEqual(inner.Index, []string{"a"}) {
+		t.Errorf("expected index [a], got %v", inner.Index)
+	}
+}
+
+func TestConcatKeepsTypedColumns(t *testing.T) {
+	a1, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, []bool{false, true})
+	a2, _ := collection.NewInt64SeriesFromData([]int64{3}, nil)
+	f2, _ := collection.NewFloat64SeriesFromData([]float64{0.5}, nil)
+	df1 := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": a1, "B": mustSeries("x", "y")},
+		ColumnOrder: []string{"A", "B"},
+		Index:       []string{"0", "1"},
+	}
+	df2 := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": a2, "B": f2},
+		ColumnOrder: []string{"A", "B"},
+		Index:       []string{"2"},
+	}
+
+	result, err := gpandas.Concat([]*dataframe.DataFrame{df1, df2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	colA, ok := result.Columns["A"].(*collection.Int64Series)
+	if !ok {
+		t.Fatalf("expected A to stay an Int64Series, got %T", result.Columns["A"])
+	}
+	if !colA.IsNull(1) {
+		t.Error("expected null to be preserved")
+	}
+	if v, _ := colA.At(2); v != int64(3) {
+		t.Errorf("expected 3 at position 2, got %v", v)
+	}
+	if _, ok := result.Columns["B"].(*collection.AnySeries); !ok {
+		t.Errorf("expected mixed column B to be an AnySeries, got %T", result.Columns["B"])
+	}
+}
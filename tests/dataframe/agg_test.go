@@ -1,6 +1,7 @@
 package dataframe_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -120,6 +121,40 @@ func TestStackUnstackRoundTrip(t *testing.T) {
 	}
 }
 
+func TestUnstackDuplicates(t *testing.T) {
+	long := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"index": mustSeries("Alice", "Alice", "Bob"),
+			"month": mustSeries("Jan", "Jan", "Feb"),
+			"value": mustSeries(10.0, 5.0, 7.0),
+		},
+		ColumnOrder: []string{"index", "month", "value"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	_, err := long.UnstackColumn("month", "")
+	if err == nil {
+		t.Fatal("expected error for duplicate combinations")
+	}
+	if !strings.Contains(err.Error(), "(Alice, Jan)") {
+		t.Errorf("expected error to name the ambiguous combination, got %v", err)
+	}
+
+	wide, err := long.UnstackColumn("month", dataframe.AggSum)
+	if err != nil {
+		t.Fatalf("UnstackColumn failed: %v", err)
+	}
+	if !strSliceEqual(wide.ColumnOrder, []string{"Feb", "Jan"}) {
+		t.Fatalf("unexpected columns: %v", wide.ColumnOrder)
+	}
+	if v, _ := wide.Columns["Jan"].At(0); !valuesEqual(v, 15.0) {
+		t.Errorf("expected Alice Jan 15, got %v", v)
+	}
+	if !wide.Columns["Jan"].IsNull(1) {
+		t.Error("expected Bob Jan to be null")
+	}
+}
+
 func TestStackDropsNulls(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -136,6 +171,50 @@ func TestStackDropsNulls(t *testing.T) {
 	}
 }
 
+func TestStackWithOptions(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(1.0, nil),
+			"B": mustSeries(2.0, 3.0),
+		},
+		ColumnOrder: []string{"A", "B"},
+		Index:       []string{"x", "y"},
+	}
+
+	long, err := df.StackWithOptions(dataframe.StackOptions{VarName: "col", ValueName: "val"})
+	if err != nil {
+		t.Fatalf("StackWithOptions failed: %v", err)
+	}
+	if !strSliceEqual(long.ColumnOrder, []string{"index", "col", "val"}) {
+		t.Fatalf("unexpected stacked columns: %v", long.ColumnOrder)
+	}
+	// Nulls are kept, so every cell becomes a row
+	if long.Len() != 4 {
+		t.Fatalf("expected 4 rows, got %d", long.Len())
+	}
+	if label, _ := long.Columns["index"].At(2); label != "y" {
+		t.Errorf("expected row 2 to come from 'y', got %v", label)
+	}
+	if col, _ := long.Columns["col"].At(2); col != "A" {
+		t.Errorf("expected row 2 variable 'A', got %v", col)
+	}
+	if !long.Columns["val"].IsNull(2) {
+		t.Error("expected null value for y/A")
+	}
+
+	dropped, err := df.StackWithOptions(dataframe.StackOptions{DropNa: true})
+	if err != nil {
+		t.Fatalf("StackWithOptions failed: %v", err)
+	}
+	if dropped.Len() != 3 || !strSliceEqual(dropped.ColumnOrder, []string{"index", "variable", "value"}) {
+		t.Errorf("expected 3 rows with default names, got %d rows %v", dropped.Len(), dropped.ColumnOrder)
+	}
+
+	if _, err := df.StackWithOptions(dataframe.StackOptions{VarName: "index"}); err == nil {
+		t.Error("expected error for clashing column names")
+	}
+}
+
 func TestSetMultiIndex(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -161,3 +240,194 @@ func TestSetMultiIndex(t *testing.T) {
 		}
 	})
 }
+
+func TestMultiIndexSurvivesRowOperations(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Country": mustSeries("USA", "USA", "UK"),
+			"City":    mustSeries("NYC", "LA", "London"),
+			"Pop":     mustSeries(3.0, nil, 1.0),
+		},
+		ColumnOrder: []string{"Country", "City", "Pop"},
+		Index:       []string{"0", "1", "2"},
+	}
+	indexed, err := df.SetMultiIndex([]string{"Country", "City"})
+	if err != nil {
+		t.Fatalf("SetMultiIndex failed: %v", err)
+	}
+
+	ops := map[string]func() (*dataframe.DataFrame, error){
+		"SortValues": func() (*dataframe.DataFrame, error) {
+			return indexed.SortValues(dataframe.SortOptions{By: []string{"Pop"}})
+		},
+		"Head":  func() (*dataframe.DataFrame, error) { return indexed.Head(2), nil },
+		"Tail":  func() (*dataframe.DataFrame, error) { return indexed.Tail(2), nil },
+		"Slice": func() (*dataframe.DataFrame, error) { return indexed.Slice([]int{2, 0}) },
+		"Filter": func() (*dataframe.DataFrame, error) {
+			return indexed.Filter("Pop", dataframe.GreaterThan, 0.0).Result()
+		},
+		"FillNA": func() (*dataframe.DataFrame, error) { return indexed.FillNAValues(0.0, nil) },
+		"ILoc":   func() (*dataframe.DataFrame, error) { return indexed.ILoc().Rows([]int{1}) },
+	}
+	for name, op := range ops {
+		t.Run(name, func(t *testing.T) {
+			result, err := op()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			cities, err := result.IndexLevel(1)
+			if err != nil {
+				t.Fatalf("IndexLevel(1): %v", err)
+			}
+			// Every label's second level must still match its row.
+			for i, label := range result.Index {
+				if !strings.HasSuffix(label, "_"+cities[i]) {
+					t.Errorf("row %d: level %q does not match label %q", i, cities[i], label)
+				}
+			}
+		})
+	}
+
+	t.Run("IgnoreIndex drops levels", func(t *testing.T) {
+		result, err := indexed.SortValues(dataframe.SortOptions{By: []string{"Pop"}, IgnoreIndex: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.MultiIndex != nil {
+			t.Errorf("expected no MultiIndex after IgnoreIndex, got %v", result.MultiIndex)
+		}
+	})
+}
+
+func TestDataFrameGroupByAgg(t *testing.T) {
+	spec := map[string][]dataframe.AggFunc{"Salary": {dataframe.AggSum}}
+
+	result, err := aggTestDF().GroupByAgg([]string{"Dept"}, spec)
+	if err != nil {
+		t.Fatalf("GroupByAgg failed: %v", err)
+	}
+	if !strSliceEqual(result.ColumnOrder, []string{"Dept", "Salary_sum"}) {
+		t.Fatalf("unexpected columns %v", result.ColumnOrder)
+	}
+	sum, _ := result.Columns["Salary_sum"].At(0) // Eng
+	if sum != 450.0 {
+		t.Errorf("expected Eng sum 450, got %v", sum)
+	}
+
+	if _, err := aggTestDF().GroupByAgg([]string{"Missing"}, spec); err == nil {
+		t.Error("expected error for missing group column")
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Dept":   mustSeries("eng", "ops", "eng"),
+			"Salary": mustSeries(100.0, 50.0, 200.0),
+			"Age":    mustSeries(30.0, nil, 40.0),
+		},
+		ColumnOrder: []string{"Dept", "Salary", "Age"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	t.Run("whole frame", func(t *testing.T) {
+		result, err := df.Aggregate(map[string]dataframe.AggFunc{
+			"Age":    dataframe.AggCount,
+			"Salary": dataframe.AggSum,
+		}, nil)
+		if err != nil {
+			t.Fatalf("Aggregate failed: %v", err)
+		}
+		if result.Len() != 1 {
+			t.Fatalf("expected 1 row, got %d", result.Len())
+		}
+		if !strSliceEqual(result.ColumnOrder, []string{"Salary", "Age"}) {
+			t.Fatalf("unexpected columns: %v", result.ColumnOrder)
+		}
+		if v, _ := result.Columns["Salary"].At(0); !valuesEqual(v, 350.0) {
+			t.Errorf("expected Salary sum 350, got %v", v)
+		}
+		if v, _ := result.Columns["Age"].At(0); !valuesEqual(v, int64(2)) {
+			t.Errorf("expected Age count 2, got %v", v)
+		}
+	})
+
+	t.Run("grouped", func(t *testing.T) {
+		result, err := df.Aggregate(map[string]dataframe.AggFunc{
+			"Salary": dataframe.AggMean,
+		}, []string{"Dept"})
+		if err != nil {
+			t.Fatalf("Aggregate failed: %v", err)
+		}
+		if !strSliceEqual(result.ColumnOrder, []string{"Dept", "Salary"}) {
+			t.Fatalf("unexpected columns: %v", result.ColumnOrder)
+		}
+		if result.Len() != 2 {
+			t.Fatalf("expected 2 groups, got %d", result.Len())
+		}
+		if dept, _ := result.Columns["Dept"].At(0); dept != "eng" {
+			t.Errorf("expected first group 'eng', got %v", dept)
+		}
+		if v, _ := result.Columns["Salary"].At(0); !valuesEqual(v, 150.0) {
+			t.Errorf("expected eng mean 150, got %v", v)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := df.Aggregate(nil, nil); err == nil {
+			t.Error("expected error for empty aggs")
+		}
+		if _, err := df.Aggregate(map[string]dataframe.AggFunc{"Dept": dataframe.AggCount}, []string{"Dept"}); err == nil {
+			t.Error("expected error for aggregating a grouping column")
+		}
+		if _, err := df.Aggregate(map[string]dataframe.AggFunc{"Missing": dataframe.AggSum}, nil); err == nil {
+			t.Error("expected error for missing column")
+		}
+	})
+}
+
+func TestBroadcast(t *testing.T) {
+	score, _ := collection.NewInt64SeriesFromData([]int64{0}, nil)
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Status": mustSeries("new"),
+			"Score":  score,
+		},
+		ColumnOrder: []string{"Status", "Score"},
+		Index:       []string{"template"},
+	}
+
+	out, err := df.Broadcast(3)
+	if err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+	if !strSliceEqual(out.Index, []string{"template", "template", "template"}) {
+		t.Errorf("expected repeated index, got %v", out.Index)
+	}
+	if _, ok := out.Columns["Score"].(*collection.Int64Series); !ok {
+		t.Errorf("expected Score to stay Int64, got %T", out.Columns["Score"])
+	}
+	if v, _ := out.Columns["Status"].At(2); v != "new" {
+		t.Errorf("expected 'new' in last row, got %v", v)
+	}
+
+	// Changing the broadcast frame must not touch the template.
+	if err := out.Columns["Score"].Set(0, int64(7)); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if v, _ := score.At(0); v != int64(0) {
+		t.Errorf("expected template to be unchanged, got %v", v)
+	}
+
+	reset, err := df.Broadcast(2, true)
+	if err != nil {
+		t.Fatalf("Broadcast failed: %v", err)
+	}
+	if !strSliceEqual(reset.Index, []string{"0", "1"}) {
+		t.Errorf("expected reset index, got %v", reset.Index)
+	}
+
+	if _, err := out.Broadcast(2); err == nil {
+		t.Error("expected error for a multi-row DataFrame")
+	}
+}
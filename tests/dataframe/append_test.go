@@ -0,0 +1,243 @@
+package dataframe_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestAppendDataFrame(t *testing.T) {
+	t.Run("same types", func(t *testing.T) {
+		a, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+		b, _ := collection.NewInt64SeriesFromData([]int64{3}, []bool{true})
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": a},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"x", "y"},
+		}
+		other := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": b},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"z"},
+		}
+		if err := df.AppendDataFrame(other); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.Len() != 3 {
+			t.Fatalf("expected 3 rows, got %d", df.Len())
+		}
+		if !df.Columns["A"].IsNull(2) {
+			t.Error("expected null to be preserved")
+		}
+		if !strSliceEqual(df.Index, []string{"x", "y", "z"}) {
+			t.Errorf("unexpected index %v", df.Index)
+		}
+	})
+
+	t.Run("int64 upcast to float64", func(t *testing.T) {
+		a, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+		b, _ := collection.NewFloat64SeriesFromData([]float64{2.5}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": a},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"0", "1"},
+		}
+		other := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": b},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"2"},
+		}
+		if err := df.AppendDataFrame(other); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		fs, ok := df.Columns["A"].(*collection.Float64Series)
+		if !ok {
+			t.Fatalf("expected Float64Series, got %T", df.Columns["A"])
+		}
+		if !reflect.DeepEqual(fs.Float64Values(), []float64{1, 2, 2.5}) {
+			t.Errorf("unexpected values %v", fs.Float64Values())
+		}
+	})
+
+	t.Run("any column upcast to specific type", func(t *testing.T) {
+		b, _ := collection.NewStringSeriesFromData([]string{"c"}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"S": mustSeries("a", "b")},
+			ColumnOrder: []string{"S"},
+			Index:       []string{"0", "1"},
+		}
+		other := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"S": b},
+			ColumnOrder: []string{"S"},
+			Index:       []string{"2"},
+		}
+		if err := df.AppendDataFrame(other); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := df.Columns["S"].(*collection.StringSeries); !ok {
+			t.Errorf("expected StringSeries, got %T", df.Columns["S"])
+		}
+	})
+
+	t.Run("empty dataframe adopts columns", func(t *testing.T) {
+		df := &dataframe.DataFrame{Columns: map[string]collection.Series{}}
+		other := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": mustSeries(1, 2)},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"0", "1"},
+		}
+		if err := df.AppendDataFrame(other); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := df.AppendDataFrame(other); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.Len() != 4 || other.Len() != 2 {
+			t.Errorf("expected 4 and 2 rows, got %d and %d", df.Len(), other.Len())
+		}
+	})
+
+	t.Run("column mismatch", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": mustSeries(1)},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"0"},
+		}
+		other := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"B": mustSeries(1)},
+			ColumnOrder: []string{"B"},
+			Index:       []string{"0"},
+		}
+		if err := df.AppendDataFrame(other); err == nil {
+			t.Error("expected error for mismatched columns")
+		}
+	})
+
+	t.Run("incompatible types leave dataframe unchanged", func(t *testing.T) {
+		i1, _ := collection.NewInt64SeriesFromData([]int64{1}, nil)
+		s1, _ := collection.NewStringSeriesFromData([]string{"a"}, nil)
+		i2, _ := collection.NewInt64SeriesFromData([]int64{2}, nil)
+		b2, _ := collection.NewBoolSeriesFromData([]bool{true}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": i1, "B": s1},
+			ColumnOrder: []string{"A", "B"},
+			Index:       []string{"0"},
+		}
+		other := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": i2, "B": b2},
+			ColumnOrder: []string{"A", "B"},
+			Index:       []string{"1"},
+		}
+		if err := df.AppendDataFrame(other); err == nil {
+			t.Fatal("expected error for incompatible types")
+		}
+		if df.Columns["A"].Len() != 1 || len(df.Index) != 1 {
+			t.Errorf("expected DataFrame unchanged after error")
+		}
+	})
+}
+
+func TestAppendAll(t *testing.T) {
+	a1, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+	a2, _ := collection.NewFloat64SeriesFromData([]float64{3.5}, nil)
+	b2, _ := collection.NewStringSeriesFromData([]string{"z"}, nil)
+	first := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": a1},
+		ColumnOrder: []string{"A"},
+		Index:       []string{"x", "y"},
+	}
+	second := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"B": b2, "A": a2},
+		ColumnOrder: []string{"B", "A"},
+		Index:       []string{"z"},
+	}
+
+	result, err := first.AppendAll(nil, second)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strSliceEqual(result.ColumnOrder, []string{"A", "B"}) {
+		t.Errorf("unexpected column order %v", result.ColumnOrder)
+	}
+	if !strSliceEqual(result.Index, []string{"x", "y", "z"}) {
+		t.Errorf("unexpected index %v", result.Index)
+	}
+	if got := result.Columns["A"].ValuesCopy(); !reflect.DeepEqual(got, []any{1.0, 2.0, 3.5}) {
+		t.Errorf("expected A combined as float64, got %v", got)
+	}
+	if _, ok := result.Columns["B"].(*collection.StringSeries); !ok {
+		t.Errorf("expected B to stay a StringSeries, got %T", result.Columns["B"])
+	}
+	if !result.Columns["B"].IsNull(0) || result.Columns["B"].IsNull(2) {
+		t.Error("expected nulls only for rows from the frame without B")
+	}
+	if first.Len() != 2 {
+		t.Errorf("expected df to be unchanged, got %d rows", first.Len())
+	}
+
+	clash := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": b2},
+		ColumnOrder: []string{"A"},
+		Index:       []string{"w"},
+	}
+	if _, err := first.AppendAll(clash); err == nil {
+		t.Error("expected error for incompatible column types")
+	}
+}
+
+func TestAppendRow(t *testing.T) {
+	newDF := func() *dataframe.DataFrame {
+		name, _ := collection.NewStringSeriesFromData([]string{"Alice"}, nil)
+		age, _ := collection.NewInt64SeriesFromData([]int64{30}, nil)
+		return &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"Name": name, "Age": age},
+			ColumnOrder: []string{"Name", "Age"},
+			Index:       []string{"0"},
+		}
+	}
+
+	t.Run("append full row", func(t *testing.T) {
+		df := newDF()
+		if err := df.AppendRow(map[string]any{"Name": "Bob", "Age": 41}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.Len() != 2 {
+			t.Fatalf("expected 2 rows, got %d", df.Len())
+		}
+		if v, _ := df.Columns["Age"].At(1); v != int64(41) {
+			t.Errorf("expected 41, got %v", v)
+		}
+		if !strSliceEqual(df.Index, []string{"0", "1"}) {
+			t.Errorf("unexpected index %v", df.Index)
+		}
+	})
+
+	t.Run("missing columns become null", func(t *testing.T) {
+		df := newDF()
+		if err := df.AppendRow(map[string]any{"Name": "Cara"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !df.Columns["Age"].IsNull(1) {
+			t.Error("expected null Age for missing key")
+		}
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		df := newDF()
+		if err := df.AppendRow(map[string]any{"Salary": 1.0}); err == nil {
+			t.Error("expected error for unknown column")
+		}
+	})
+
+	t.Run("incompatible value leaves dataframe unchanged", func(t *testing.T) {
+		df := newDF()
+		if err := df.AppendRow(map[string]any{"Name": "Dan", "Age": "old"}); err == nil {
+			t.Fatal("expected error for incompatible value")
+		}
+		if df.Columns["Name"].Len() != 1 || len(df.Index) != 1 {
+			t.Error("expected DataFrame unchanged after error")
+		}
+	})
+}
@@ -238,3 +238,147 @@ func TestApplyRow(t *testing.T) {
 		}
 	})
 }
+
+func TestApplyParallel(t *testing.T) {
+	n := 1000
+	a := make([]any, n)
+	b := make([]any, n)
+	for i := 0; i < n; i++ {
+		a[i] = int64(i)
+		b[i] = float64(i) / 2
+	}
+	b[7] = nil
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(a...),
+			"B": mustSeries(b...),
+		},
+		ColumnOrder: []string{"A", "B"},
+	}
+	sum := func(row []any) any {
+		if row[1] == nil {
+			return nil
+		}
+		return float64(row[0].(int64)) + row[1].(float64)
+	}
+
+	for _, workers := range []int{0, 1, 3, 2 * n} {
+		result, err := df.ApplyParallel(sum, reflect.TypeOf(float64(0)), workers)
+		if err != nil {
+			t.Fatalf("workers=%d: unexpected error: %v", workers, err)
+		}
+		if result.Len() != n || result.DType() != reflect.TypeOf(float64(0)) {
+			t.Fatalf("workers=%d: got len %d dtype %v", workers, result.Len(), result.DType())
+		}
+		if !result.IsNull(7) {
+			t.Errorf("workers=%d: expected null at row 7", workers)
+		}
+		for _, i := range []int{0, 1, 500, n - 1} {
+			v, _ := result.At(i)
+			if want := float64(i) * 1.5; v != want {
+				t.Errorf("workers=%d: row %d: expected %v, got %v", workers, i, want, v)
+			}
+		}
+	}
+
+	t.Run("incompatible result type", func(t *testing.T) {
+		_, err := df.ApplyParallel(func(row []any) any { return "x" }, reflect.TypeOf(int64(0)), 2)
+		if err == nil {
+			t.Error("expected error for string result in int64 series")
+		}
+	})
+
+	t.Run("empty DataFrame", func(t *testing.T) {
+		empty := &dataframe.DataFrame{Columns: map[string]collection.Series{}}
+		result, err := empty.ApplyParallel(sum, nil, 0)
+		if err != nil || result.Len() != 0 {
+			t.Errorf("expected empty result, got %v, %v", result, err)
+		}
+	})
+}
+
+func TestApplyInPlace(t *testing.T) {
+	t.Run("numeric column with nulls", func(t *testing.T) {
+		salary, _ := collection.NewFloat64SeriesFromData([]float64{100, 0, 300}, []bool{false, true, false})
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"Salary": salary},
+			ColumnOrder: []string{"Salary"},
+			Index:       []string{"0", "1", "2"},
+		}
+		calls := 0
+		err := df.ApplyInPlace("Salary", func(v any) any {
+			calls++
+			return v.(float64) * 2
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("expected fn called for 2 non-null values, got %d", calls)
+		}
+		if df.Columns["Salary"] != salary {
+			t.Error("expected the existing series to be modified in place")
+		}
+		got := salary.ValuesCopy()
+		if !valuesEqual(got[0], 200.0) || got[1] != nil || !valuesEqual(got[2], 600.0) {
+			t.Errorf("expected [200 <nil> 600], got %v", got)
+		}
+	})
+
+	t.Run("string column and nil result", func(t *testing.T) {
+		names, _ := collection.NewStringSeriesFromData([]string{"alice", "bob", ""}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"Name": names},
+			ColumnOrder: []string{"Name"},
+			Index:       []string{"0", "1", "2"},
+		}
+		err := df.ApplyInPlace("Name", func(v any) any {
+			if v.(string) == "" {
+				return nil
+			}
+			return v.(string) + "!"
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := df.Columns["Name"].ValuesCopy()
+		if got[0] != "alice!" || got[1] != "bob!" || got[2] != nil {
+			t.Errorf("expected [alice! bob! <nil>], got %v", got)
+		}
+	})
+
+	t.Run("type mismatch leaves column unchanged", func(t *testing.T) {
+		salary, _ := collection.NewFloat64SeriesFromData([]float64{1, 2}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"Salary": salary},
+			ColumnOrder: []string{"Salary"},
+			Index:       []string{"0", "1"},
+		}
+		err := df.ApplyInPlace("Salary", func(v any) any {
+			if v.(float64) > 1 {
+				return "big"
+			}
+			return v.(float64) * 10
+		})
+		if err == nil {
+			t.Fatal("expected error for mismatched result type")
+		}
+		if v, _ := df.Columns["Salary"].At(0); !valuesEqual(v, 1.0) {
+			t.Errorf("expected column unchanged, got %v", v)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": mustSeries(1.0)},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"0"},
+		}
+		if err := df.ApplyInPlace("missing", func(v any) any { return v }); err == nil {
+			t.Error("expected error for missing column")
+		}
+		if err := df.ApplyInPlace("A", nil); err == nil {
+			t.Error("expected error for nil fn")
+		}
+	})
+}
@@ -152,6 +152,62 @@ func TestAsType(t *testing.T) {
 	})
 }
 
+func TestSetColumnType(t *testing.T) {
+	newDF := func() *dataframe.DataFrame {
+		return &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"Price": mustSeries("1.5", "n/a", nil),
+			},
+			ColumnOrder: []string{"Price"},
+			Index:       []string{"0", "1", "2"},
+		}
+	}
+	floatType := reflect.TypeOf(float64(0))
+
+	t.Run("strict by default", func(t *testing.T) {
+		df := newDF()
+		if err := df.SetColumnType("Price", floatType); err == nil {
+			t.Fatal("expected error for unconvertible value")
+		}
+		if _, ok := df.Columns["Price"].(*collection.AnySeries); !ok {
+			t.Errorf("expected column unchanged on error, got %T", df.Columns["Price"])
+		}
+	})
+
+	t.Run("non-strict coerces to null", func(t *testing.T) {
+		df := newDF()
+		if err := df.SetColumnType("Price", floatType, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := df.Columns["Price"].(*collection.Float64Series); !ok {
+			t.Fatalf("expected Float64Series, got %T", df.Columns["Price"])
+		}
+		if !reflect.DeepEqual(df.Columns["Price"].ValuesCopy(), []any{1.5, nil, nil}) {
+			t.Errorf("expected [1.5 nil nil], got %v", df.Columns["Price"].ValuesCopy())
+		}
+	})
+
+	t.Run("int32 target", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"N": mustSeries("7", "8")},
+			ColumnOrder: []string{"N"},
+			Index:       []string{"0", "1"},
+		}
+		if err := df.SetColumnType("N", reflect.TypeOf(int32(0))); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v, _ := df.Columns["N"].At(1); v != int32(8) {
+			t.Errorf("expected int32 8, got %v (%T)", v, v)
+		}
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		if err := newDF().SetColumnType("Missing", floatType); err == nil {
+			t.Error("expected error for missing column")
+		}
+	})
+}
+
 func TestDTypes(t *testing.T) {
 	fs, _ := collection.NewFloat64SeriesFromData([]float64{1}, nil)
 	is, _ := collection.NewInt64SeriesFromData([]int64{1}, nil)
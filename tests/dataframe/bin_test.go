@@ -0,0 +1,52 @@
+package dataframe_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestBin(t *testing.T) {
+	age, _ := collection.NewInt64SeriesFromData([]int64{1, 4, 7, 10, 0}, []bool{false, false, false, false, true})
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"Age": age},
+		ColumnOrder: []string{"Age"},
+		Index:       []string{"0", "1", "2", "3", "4"},
+	}
+
+	binned, err := df.Bin("Age", 3, nil, "")
+	if err != nil {
+		t.Fatalf("Bin failed: %v", err)
+	}
+	if !strSliceEqual(binned.ColumnOrder, []string{"Age", "Age_bin"}) {
+		t.Errorf("unexpected columns %v", binned.ColumnOrder)
+	}
+	// Edges 0.991, 4, 7, 10: boundary values fall into the lower interval.
+	want := []any{"(0.991, 4]", "(0.991, 4]", "(4, 7]", "(7, 10]", nil}
+	if got := binned.Columns["Age_bin"].ValuesCopy(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if _, ok := df.Columns["Age_bin"]; ok {
+		t.Error("expected the original DataFrame to be unchanged")
+	}
+
+	named, err := df.Bin("Age", 2, []string{"low", "high"}, "Group")
+	if err != nil {
+		t.Fatalf("Bin failed: %v", err)
+	}
+	if got := named.Columns["Group"].ValuesCopy(); !reflect.DeepEqual(got, []any{"low", "low", "high", "high", nil}) {
+		t.Errorf("unexpected labels %v", got)
+	}
+
+	if _, err := df.Bin("Age", 2, []string{"only one"}, ""); err == nil {
+		t.Error("expected error for wrong number of labels")
+	}
+	if _, err := df.Bin("Age", 0, nil, ""); err == nil {
+		t.Error("expected error for zero bins")
+	}
+	if _, err := df.Bin("Missing", 2, nil, ""); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
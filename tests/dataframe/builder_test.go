@@ -0,0 +1,70 @@
+package dataframe_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Run("build with index", func(t *testing.T) {
+		names, _ := collection.NewStringSeriesFromData([]string{"a", "b"}, nil)
+		ages, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+		df, err := dataframe.NewBuilder().
+			AddColumn("Name", names).
+			AddColumn("Age", ages).
+			SetIndex([]string{"x", "y"}).
+			Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"Name", "Age"}) {
+			t.Errorf("unexpected column order %v", df.ColumnOrder)
+		}
+		if !strSliceEqual(df.Index, []string{"x", "y"}) {
+			t.Errorf("unexpected index %v", df.Index)
+		}
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		one, _ := collection.NewInt64SeriesFromData([]int64{1}, nil)
+		two, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+
+		if _, err := dataframe.NewBuilder().Build(); err == nil {
+			t.Error("expected error for no columns")
+		}
+		if _, err := dataframe.NewBuilder().AddColumn("A", one).AddColumn("A", one).Build(); err == nil {
+			t.Error("expected error for duplicate names")
+		}
+		if _, err := dataframe.NewBuilder().AddColumn("A", one).AddColumn("B", two).Build(); err == nil {
+			t.Error("expected error for inconsistent lengths")
+		}
+		if _, err := dataframe.NewBuilder().AddColumn("A", one).SetIndex([]string{"a", "b"}).Build(); err == nil {
+			t.Error("expected error for index length mismatch")
+		}
+	})
+
+	t.Run("concurrent AddColumn", func(t *testing.T) {
+		var b dataframe.Builder
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				s, _ := collection.NewInt64SeriesFromData([]int64{int64(i)}, nil)
+				b.AddColumn(fmt.Sprintf("c%d", i), s)
+			}(i)
+		}
+		wg.Wait()
+		df, err := b.Build()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(df.ColumnOrder) != 20 {
+			t.Errorf("expected 20 columns, got %d", len(df.ColumnOrder))
+		}
+	})
+}
@@ -145,3 +145,198 @@ func TestInsert(t *testing.T) {
 		}
 	})
 }
+
+func TestInsertColumn(t *testing.T) {
+	df := columnsTestDF()
+	col, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3}, nil)
+	if err := df.InsertColumn(1, "ID", col); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strSliceEqual(df.ColumnOrder, []string{"Name", "ID", "Salary"}) {
+		t.Errorf("expected ID in the middle, got %v", df.ColumnOrder)
+	}
+	if err := df.InsertColumn(0, "ID", col); err == nil {
+		t.Error("expected error for duplicate column name")
+	}
+}
+
+func TestAddColumn(t *testing.T) {
+	t.Run("appends column", func(t *testing.T) {
+		df := columnsTestDF()
+		col, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3}, nil)
+		if err := df.AddColumn("ID", col); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"Name", "Salary", "ID"}) {
+			t.Errorf("expected ID last, got %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("existing name errors", func(t *testing.T) {
+		df := columnsTestDF()
+		col, _ := collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)
+		if err := df.AddColumn("Salary", col); err == nil {
+			t.Error("expected error for existing column name")
+		}
+	})
+
+	t.Run("zero-value DataFrame", func(t *testing.T) {
+		df := &dataframe.DataFrame{}
+		col, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+		if err := df.AddColumn("ID", col); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.Columns["ID"] != col || !strSliceEqual(df.Index, []string{"0", "1"}) {
+			t.Errorf("unexpected frame %v / %v", df.Columns, df.Index)
+		}
+	})
+
+	t.Run("length mismatch errors", func(t *testing.T) {
+		df := columnsTestDF()
+		col, _ := collection.NewFloat64SeriesFromData([]float64{1, 2}, nil)
+		if err := df.AddColumn("X", col); err == nil {
+			t.Error("expected error for length mismatch")
+		}
+	})
+}
+
+func TestSetColumnValues(t *testing.T) {
+	t.Run("existing column keeps its type", func(t *testing.T) {
+		df := columnsTestDF()
+		scores, _ := collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)
+		if err := df.Assign("Score", scores); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := df.SetColumnValues("Score", []any{10, nil, 2.5}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, ok := df.Columns["Score"].(*collection.Float64Series)
+		if !ok {
+			t.Fatalf("expected *Float64Series, got %T", df.Columns["Score"])
+		}
+		v0, _ := got.At(0)
+		if v0 != 10.0 || !got.IsNull(1) {
+			t.Errorf("unexpected values: %v", got.ValuesCopy())
+		}
+		if v, _ := scores.At(0); v != 1.0 {
+			t.Error("the previous Series should not be modified")
+		}
+	})
+
+	t.Run("new column infers type", func(t *testing.T) {
+		df := columnsTestDF()
+		if err := df.SetColumnValues("Flag", []any{true, false, nil}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := df.Columns["Flag"].(*collection.BoolSeries); !ok {
+			t.Errorf("expected *BoolSeries, got %T", df.Columns["Flag"])
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"Name", "Salary", "Flag"}) {
+			t.Errorf("expected Flag last, got %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("incompatible value leaves column unchanged", func(t *testing.T) {
+		df := columnsTestDF()
+		names, _ := collection.NewStringSeriesFromData([]string{"a", "b", "c"}, nil)
+		df.Columns["Name"] = names
+		if err := df.SetColumnValues("Name", []any{"x", 5, "z"}); err == nil {
+			t.Fatal("expected error for non-string value")
+		}
+		if df.Columns["Name"] != names {
+			t.Error("column should be unchanged after a failed call")
+		}
+	})
+
+	t.Run("lossy float for an int column errors", func(t *testing.T) {
+		df := columnsTestDF()
+		ids, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3}, nil)
+		df.Columns["ID"] = ids
+		df.ColumnOrder = append(df.ColumnOrder, "ID")
+		if err := df.SetColumnValues("ID", []any{1, 2.7, 3}); err == nil {
+			t.Fatal("expected error for a fractional value")
+		}
+		if err := df.SetColumnValues("ID", []any{1, 2.0, 3}); err != nil {
+			t.Errorf("unexpected error for a whole float: %v", err)
+		}
+	})
+
+	t.Run("zero-value DataFrame", func(t *testing.T) {
+		df := &dataframe.DataFrame{}
+		if err := df.SetColumnValues("A", []any{1, 2}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"A"}) || len(df.Index) != 2 {
+			t.Errorf("unexpected frame %v / %v", df.ColumnOrder, df.Index)
+		}
+	})
+
+	t.Run("length mismatch errors", func(t *testing.T) {
+		if err := columnsTestDF().SetColumnValues("Salary", []any{1.0}); err == nil {
+			t.Error("expected error for length mismatch")
+		}
+	})
+}
+
+func TestWithIndexColumn(t *testing.T) {
+	t.Run("WithIndex returns new frame", func(t *testing.T) {
+		df := columnsTestDF()
+		out, err := df.WithIndex([]string{"a", "b", "c"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(out.Index, []string{"a", "b", "c"}) {
+			t.Errorf("expected new index, got %v", out.Index)
+		}
+		if !strSliceEqual(df.Index, []string{"0", "1", "2"}) {
+			t.Errorf("original index modified: %v", df.Index)
+		}
+		if _, err := df.WithIndex([]string{"a"}); err == nil {
+			t.Error("expected error for index length mismatch")
+		}
+	})
+
+	t.Run("WithColumn chains without modifying original", func(t *testing.T) {
+		df := columnsTestDF()
+		age, _ := collection.NewInt64SeriesFromData([]int64{10, 20, 30}, nil)
+		out, err := df.WithColumn("Age", age)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		out, err = out.WithoutColumn("Salary")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(out.ColumnOrder, []string{"Name", "Age"}) {
+			t.Errorf("expected [Name Age], got %v", out.ColumnOrder)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"Name", "Salary"}) {
+			t.Errorf("original columns modified: %v", df.ColumnOrder)
+		}
+		if out.Columns["Name"] != df.Columns["Name"] {
+			t.Error("expected unchanged columns to share the same Series")
+		}
+	})
+
+	t.Run("WithColumn replaces in position", func(t *testing.T) {
+		df := columnsTestDF()
+		col, _ := collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)
+		out, err := df.WithColumn("Name", col)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(out.ColumnOrder, []string{"Name", "Salary"}) {
+			t.Errorf("expected order kept, got %v", out.ColumnOrder)
+		}
+		short, _ := collection.NewFloat64SeriesFromData([]float64{1}, nil)
+		if _, err := df.WithColumn("Name", short); err == nil {
+			t.Error("expected error for length mismatch")
+		}
+	})
+
+	t.Run("WithoutColumn missing column", func(t *testing.T) {
+		if _, err := columnsTestDF().WithoutColumn("Missing"); err == nil {
+			t.Error("expected error for missing column")
+		}
+	})
+}
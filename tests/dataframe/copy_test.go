@@ -0,0 +1,105 @@
+package dataframe_test
+
+import (
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+)
+
+func TestViewAndCopy(t *testing.T) {
+	t.Run("view shares series", func(t *testing.T) {
+		df := columnsTestDF()
+		v := df.View()
+		if v.Columns["Salary"] != df.Columns["Salary"] {
+			t.Error("expected view to share series")
+		}
+		v.ColumnOrder = v.ColumnOrder[:1]
+		if len(df.ColumnOrder) != 2 {
+			t.Errorf("expected original column order untouched, got %v", df.ColumnOrder)
+		}
+		if err := v.Columns["Salary"].Set(0, 1.0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if val, _ := df.Columns["Salary"].At(0); val != 1.0 {
+			t.Errorf("expected mutation visible through view, got %v", val)
+		}
+	})
+
+	t.Run("copy is independent", func(t *testing.T) {
+		df := columnsTestDF()
+		df.IndexName = "id"
+		c := df.Copy()
+		if c.IndexName != "id" || !strSliceEqual(c.ColumnOrder, df.ColumnOrder) {
+			t.Errorf("expected metadata copied, got %q %v", c.IndexName, c.ColumnOrder)
+		}
+		if err := c.Columns["Salary"].Set(0, 1.0); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		c.Columns["Name"].SetNull(1)
+		if val, _ := df.Columns["Salary"].At(0); val != 100.0 {
+			t.Errorf("expected original unchanged, got %v", val)
+		}
+		if df.Columns["Name"].IsNull(1) {
+			t.Error("expected original mask unchanged")
+		}
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var df *dataframe.DataFrame
+		if df.View() != nil || df.Copy() != nil {
+			t.Error("expected nil for nil DataFrame")
+		}
+	})
+}
+
+func TestDeepOptions(t *testing.T) {
+	df := columnsTestDF()
+
+	sel, err := df.Select("Salary")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sel.Columns["Salary"] != df.Columns["Salary"] {
+		t.Error("expected Select to return a view")
+	}
+	sel, err = df.SelectWithOptions([]string{"Salary"}, dataframe.SelectOptions{Deep: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sel.Columns["Salary"] == df.Columns["Salary"] {
+		t.Error("expected deep Select to copy the series")
+	}
+
+	dropped, err := df.Drop(dataframe.DropOptions{Columns: []string{"Name"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dropped.Columns["Salary"] != df.Columns["Salary"] {
+		t.Error("expected Drop to return a view")
+	}
+	dropped, err = df.Drop(dataframe.DropOptions{Columns: []string{"Name"}, Deep: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dropped.Columns["Salary"] == df.Columns["Salary"] {
+		t.Error("expected deep Drop to copy the series")
+	}
+
+	col, err := df.ILoc().Col(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if col != df.Columns["Salary"] {
+		t.Error("expected ILoc().Col to return the shared series")
+	}
+	cols, err := df.ILoc().Deep().Cols([]int{1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cols.Columns["Salary"] == df.Columns["Salary"] {
+		t.Error("expected ILoc().Deep().Cols to copy the series")
+	}
+	if val, _ := cols.Columns["Salary"].At(2); val != 300.0 {
+		t.Errorf("expected copied value 300, got %v", val)
+	}
+}
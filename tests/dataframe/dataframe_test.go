@@ -2,6 +2,7 @@ package dataframe_test
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -809,6 +810,39 @@ func TestDataFrameDrop(t *testing.T) {
 		}
 	})
 
+	t.Run("drop rows keeps index metadata", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"A": mustSeries(1, 2, 3),
+			},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"x_1", "x_2", "y_1"},
+			IndexName:   "key",
+			MultiIndex:  [][]string{{"x", "1"}, {"x", "2"}, {"y", "1"}},
+		}
+
+		result, err := df.Drop(dataframe.DropOptions{Index: []string{"x_2"}, Axis: 0})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"x_1", "y_1"}) {
+			t.Errorf("expected index [x_1, y_1], got %v", result.Index)
+		}
+		if result.IndexName != "key" {
+			t.Errorf("expected IndexName 'key', got %q", result.IndexName)
+		}
+		if len(result.MultiIndex) != 2 || !strSliceEqual(result.MultiIndex[1], []string{"y", "1"}) {
+			t.Errorf("expected MultiIndex rows filtered, got %v", result.MultiIndex)
+		}
+
+		if _, err := df.Drop(dataframe.DropOptions{Index: []string{"y_1"}, Inplace: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(df.MultiIndex) != 2 || len(df.Index) != 2 {
+			t.Errorf("expected in-place drop to filter MultiIndex, got %v", df.MultiIndex)
+		}
+	})
+
 	t.Run("drop rows using labels and axis 0", func(t *testing.T) {
 		df := &dataframe.DataFrame{
 			Columns: map[string]collection.Series{
@@ -1044,3 +1078,280 @@ func TestDataFrameDrop(t *testing.T) {
 		}
 	})
 }
+
+func TestDataFrameLen(t *testing.T) {
+	t.Run("nil dataframe", func(t *testing.T) {
+		var df *dataframe.DataFrame
+		if df.Len() != 0 {
+			t.Errorf("expected 0 rows, got %d", df.Len())
+		}
+	})
+
+	t.Run("no columns", func(t *testing.T) {
+		df := &dataframe.DataFrame{Columns: map[string]collection.Series{}}
+		if df.Len() != 0 {
+			t.Errorf("expected 0 rows, got %d", df.Len())
+		}
+	})
+
+	t.Run("missing first column", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{},
+			ColumnOrder: []string{"A"},
+		}
+		if df.Len() != 0 {
+			t.Errorf("expected 0 rows, got %d", df.Len())
+		}
+	})
+
+	t.Run("populated dataframe", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"A": mustSeries(1, 2, 3),
+			},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"0", "1", "2"},
+		}
+		if df.Len() != 3 {
+			t.Errorf("expected 3 rows, got %d", df.Len())
+		}
+	})
+}
+
+func TestDataFrameShape(t *testing.T) {
+	t.Run("nil dataframe", func(t *testing.T) {
+		var df *dataframe.DataFrame
+		rows, cols := df.Shape()
+		if rows != 0 || cols != 0 {
+			t.Errorf("expected (0, 0), got (%d, %d)", rows, cols)
+		}
+		if !df.IsEmpty() {
+			t.Error("expected nil DataFrame to be empty")
+		}
+	})
+
+	t.Run("columns without rows", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"A": collection.NewInt64Series(0),
+			},
+			ColumnOrder: []string{"A"},
+		}
+		if df.NumCols() != 1 || df.NumRows() != 0 {
+			t.Errorf("expected 0 rows and 1 column, got %d and %d", df.NumRows(), df.NumCols())
+		}
+		if !df.IsEmpty() {
+			t.Error("expected DataFrame without rows to be empty")
+		}
+	})
+
+	t.Run("populated dataframe", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"A": mustSeries(1, 2, 3),
+				"B": mustSeries("x", "y", "z"),
+			},
+			ColumnOrder: []string{"A", "B"},
+			Index:       []string{"0", "1", "2"},
+		}
+		rows, cols := df.Shape()
+		if rows != 3 || cols != 2 {
+			t.Errorf("expected (3, 2), got (%d, %d)", rows, cols)
+		}
+		if df.NumRows() != df.Len() {
+			t.Errorf("NumRows %d should equal Len %d", df.NumRows(), df.Len())
+		}
+		if df.IsEmpty() {
+			t.Error("expected populated DataFrame not to be empty")
+		}
+	})
+}
+
+func TestDataFrameRenameAxis(t *testing.T) {
+	newDF := func() *dataframe.DataFrame {
+		return &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"score": mustSeries(1, 2),
+			},
+			ColumnOrder: []string{"score"},
+			Index:       []string{"u1", "u2"},
+		}
+	}
+
+	t.Run("sets axis names", func(t *testing.T) {
+		df := newDF()
+		if err := df.RenameAxis("user_id", "metric"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.IndexName != "user_id" || df.ColumnsName != "metric" {
+			t.Errorf("expected names (user_id, metric), got (%s, %s)", df.IndexName, df.ColumnsName)
+		}
+	})
+
+	t.Run("ToCSV includes named index", func(t *testing.T) {
+		df := newDF()
+		_ = df.RenameAxis("user_id", "")
+		csv, err := df.ToCSV("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := "user_id,score\nu1,1\nu2,2\n"
+		if csv != expected {
+			t.Errorf("expected %q, got %q", expected, csv)
+		}
+	})
+
+	t.Run("String includes named index", func(t *testing.T) {
+		df := newDF()
+		_ = df.RenameAxis("user_id", "")
+		out := df.String()
+		if !strings.Contains(out, "user_id") || !strings.Contains(out, "u2") {
+			t.Errorf("expected index header and labels in output, got:\n%s", out)
+		}
+	})
+
+	t.Run("unnamed index is not printed", func(t *testing.T) {
+		df := newDF()
+		csv, _ := df.ToCSV("")
+		if csv != "score\n1\n2\n" {
+			t.Errorf("expected index omitted, got %q", csv)
+		}
+	})
+
+	t.Run("row operations keep axis names", func(t *testing.T) {
+		df := newDF()
+		_ = df.RenameAxis("user_id", "metric")
+		ops := map[string]func() (*dataframe.DataFrame, error){
+			"SortValues": func() (*dataframe.DataFrame, error) {
+				return df.SortValues(dataframe.SortOptions{By: []string{"score"}, Ascending: []bool{false}})
+			},
+			"Head":         func() (*dataframe.DataFrame, error) { return df.Head(1), nil },
+			"FillNAValues": func() (*dataframe.DataFrame, error) { return df.FillNAValues(0, nil) },
+			"DropNAAxis":   func() (*dataframe.DataFrame, error) { return df.DropNAAxis(1, "any", 0, nil) },
+			"FilterParallel": func() (*dataframe.DataFrame, error) {
+				return df.FilterParallel(func(row map[string]any) bool { return true }, 2)
+			},
+		}
+		for name, op := range ops {
+			result, err := op()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			if result.IndexName != "user_id" || result.ColumnsName != "metric" {
+				t.Errorf("%s: expected names (user_id, metric), got (%s, %s)", name, result.IndexName, result.ColumnsName)
+			}
+		}
+
+		sorted, _ := df.SortValues(dataframe.SortOptions{By: []string{"score"}, Ascending: []bool{false}})
+		csv, _ := sorted.ToCSV("")
+		if csv != "user_id,score\nu2,2\nu1,1\n" {
+			t.Errorf("expected named index in CSV after sort, got %q", csv)
+		}
+	})
+}
+
+func TestDataFrameRenameRegex(t *testing.T) {
+	newDF := func() *dataframe.DataFrame {
+		return &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"feature_A": mustSeries(1, 2),
+				"feature_B": mustSeries(3, 4),
+				"label":     mustSeries("x", "y"),
+			},
+			ColumnOrder: []string{"feature_A", "feature_B", "label"},
+			Index:       []string{"0", "1"},
+		}
+	}
+
+	t.Run("strips prefix", func(t *testing.T) {
+		df := newDF()
+		if err := df.RenameRegex("^feature_", ""); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"A", "B", "label"}) {
+			t.Errorf("unexpected columns %v", df.ColumnOrder)
+		}
+		if v, _ := df.Columns["B"].At(1); !valuesEqual(v, 4) {
+			t.Errorf("expected 4 in column B, got %v", v)
+		}
+	})
+
+	t.Run("capture groups", func(t *testing.T) {
+		df := newDF()
+		if err := df.RenameRegex(`^feature_(\w)$`, "${1}_feat"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"A_feat", "B_feat", "label"}) {
+			t.Errorf("unexpected columns %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("collision leaves DataFrame unchanged", func(t *testing.T) {
+		df := newDF()
+		if err := df.RenameRegex("_[AB]$", ""); err == nil {
+			t.Fatal("expected collision error")
+		}
+		if df.ColumnOrder[0] != "feature_A" {
+			t.Errorf("DataFrame was modified: %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		if err := newDF().RenameRegex("(", ""); err == nil {
+			t.Error("expected error for invalid pattern")
+		}
+	})
+}
+
+func TestDataFramePrefixSuffixColumns(t *testing.T) {
+	newDF := func() *dataframe.DataFrame {
+		return &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"id":     mustSeries(1, 2),
+				"amount": mustSeries(3, 4),
+				"x_id":   mustSeries(5, 6),
+			},
+			ColumnOrder: []string{"id", "amount", "x_id"},
+			Index:       []string{"0", "1"},
+		}
+	}
+
+	t.Run("prefix all columns", func(t *testing.T) {
+		df := newDF()
+		if err := df.PrefixColumns("l_", nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"l_id", "l_amount", "l_x_id"}) {
+			t.Errorf("unexpected columns %v", df.ColumnOrder)
+		}
+		if _, ok := df.Columns["l_amount"]; !ok || len(df.Columns) != 3 {
+			t.Errorf("Columns map not updated: %v", df.Columns)
+		}
+	})
+
+	t.Run("suffix subset", func(t *testing.T) {
+		df := newDF()
+		if err := df.SuffixColumns("_usd", []string{"amount"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"id", "amount_usd", "x_id"}) {
+			t.Errorf("unexpected columns %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("collision with column outside subset", func(t *testing.T) {
+		df := newDF()
+		if err := df.PrefixColumns("x_", []string{"id"}); err == nil {
+			t.Fatal("expected collision error")
+		}
+		if !strSliceEqual(df.ColumnOrder, []string{"id", "amount", "x_id"}) {
+			t.Errorf("DataFrame was modified: %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("missing subset column", func(t *testing.T) {
+		if err := newDF().SuffixColumns("_y", []string{"nope"}); err == nil {
+			t.Error("expected error for missing column")
+		}
+	})
+}
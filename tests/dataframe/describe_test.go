@@ -187,3 +187,45 @@ func TestValueCountsMissingColumn(t *testing.T) {
 		t.Error("expected error for missing column")
 	}
 }
+
+func TestColumnStats(t *testing.T) {
+	df := describeTestDF()
+	df.Columns["Score"] = mustSeries(10.0, nil, 30.0, 40.0)
+
+	stats, err := df.ColumnStats(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("expected 3 columns, got %d", len(stats))
+	}
+
+	score := stats["Score"]
+	if score.Count != 3 || score.NullCount != 1 {
+		t.Errorf("Score count/nulls = %d/%d, want 3/1", score.Count, score.NullCount)
+	}
+	if score.Min != 10 || score.Max != 40 {
+		t.Errorf("Score min/max = %v/%v, want 10/40", score.Min, score.Max)
+	}
+	if math.Abs(score.Mean-80.0/3) > 1e-9 {
+		t.Errorf("Score mean = %v", score.Mean)
+	}
+	// sample std of 10, 30, 40
+	if want := math.Sqrt(700.0 / 3); math.Abs(score.Std-want) > 1e-9 {
+		t.Errorf("Score std = %v, want %v", score.Std, want)
+	}
+
+	name := stats["Name"]
+	if name.Count != 4 || !math.IsNaN(name.Mean) || !math.IsNaN(name.Min) {
+		t.Errorf("Name stats = %+v", name)
+	}
+
+	selected, err := df.ColumnStats([]string{"Age"})
+	if err != nil || len(selected) != 1 || selected["Age"].Mean != 2.5 {
+		t.Errorf("ColumnStats(Age) = %+v, %v", selected, err)
+	}
+
+	if _, err := df.ColumnStats([]string{"Missing"}); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
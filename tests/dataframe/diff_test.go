@@ -0,0 +1,90 @@
+package dataframe_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestDiff(t *testing.T) {
+	before := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"name":  mustSeries("a", "b", "c"),
+			"price": mustSeries(1.0, 2.0, 3.0),
+		},
+		ColumnOrder: []string{"name", "price"},
+		Index:       []string{"k1", "k2", "k3"},
+	}
+	after := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"name":  mustSeries("a", "b", "d"),
+			"price": mustSeries(1.0, 2.5, 4.0),
+		},
+		ColumnOrder: []string{"name", "price"},
+		Index:       []string{"k1", "k2", "k4"},
+	}
+
+	t.Run("added removed modified", func(t *testing.T) {
+		res, err := before.Diff(after)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(res.Added.Index, []string{"k4"}) {
+			t.Errorf("expected added [k4], got %v", res.Added.Index)
+		}
+		if !strSliceEqual(res.Removed.Index, []string{"k3"}) {
+			t.Errorf("expected removed [k3], got %v", res.Removed.Index)
+		}
+		if !strSliceEqual(res.Modified.Index, []string{"k2"}) {
+			t.Errorf("expected modified [k2], got %v", res.Modified.Index)
+		}
+		if len(res.ModifiedDetails) != 1 {
+			t.Fatalf("expected 1 cell change, got %v", res.ModifiedDetails)
+		}
+		c := res.ModifiedDetails[0]
+		if c.Index != "k2" || c.Column != "price" || c.Old != 2.0 || c.New != 2.5 {
+			t.Errorf("unexpected cell change %+v", c)
+		}
+	})
+
+	t.Run("identical frames", func(t *testing.T) {
+		res, err := before.Diff(before)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if res.Added.Len() != 0 || res.Removed.Len() != 0 || res.Modified.Len() != 0 || len(res.ModifiedDetails) != 0 {
+			t.Errorf("expected no differences, got %+v", res.ModifiedDetails)
+		}
+	})
+
+	t.Run("unchanged NaN", func(t *testing.T) {
+		prices := func(vals ...float64) *dataframe.DataFrame {
+			s, _ := collection.NewFloat64SeriesFromData(vals, nil)
+			return &dataframe.DataFrame{
+				Columns:     map[string]collection.Series{"price": s},
+				ColumnOrder: []string{"price"},
+				Index:       []string{"k1", "k2"},
+			}
+		}
+		res, err := prices(math.NaN(), 1).Diff(prices(math.NaN(), 2))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(res.Modified.Index, []string{"k2"}) || len(res.ModifiedDetails) != 1 {
+			t.Errorf("expected only k2 modified, got %+v", res.ModifiedDetails)
+		}
+	})
+
+	t.Run("duplicate index", func(t *testing.T) {
+		dup := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"name": mustSeries("a", "b")},
+			ColumnOrder: []string{"name"},
+			Index:       []string{"k1", "k1"},
+		}
+		if _, err := before.Diff(dup); err == nil {
+			t.Error("expected error for duplicate index")
+		}
+	})
+}
@@ -1,7 +1,9 @@
 package dataframe_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas/dataframe"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -188,6 +190,78 @@ func TestWhere(t *testing.T) {
 	})
 }
 
+func TestFilterParallel(t *testing.T) {
+	t.Run("matches Where and keeps types and index", func(t *testing.T) {
+		ages := make([]int64, 1000)
+		index := make([]string, len(ages))
+		for i := range ages {
+			ages[i] = int64(i % 50)
+			index[i] = fmt.Sprintf("r%d", i)
+		}
+		age, _ := collection.NewInt64SeriesFromData(ages, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"Age": age},
+			ColumnOrder: []string{"Age"},
+			Index:       index,
+		}
+		adult := func(row map[string]any) bool { return row["Age"].(int64) >= 18 }
+
+		result, err := df.FilterParallel(adult, 4)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want, _ := df.Where(adult).Result()
+		if !strSliceEqual(result.Index, want.Index) {
+			t.Errorf("expected the same rows in order as Where")
+		}
+		if _, ok := result.Columns["Age"].(*collection.Int64Series); !ok {
+			t.Errorf("expected Age to stay an Int64Series, got %T", result.Columns["Age"])
+		}
+	})
+
+	t.Run("keeps DateTime columns typed", func(t *testing.T) {
+		start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		when, _ := collection.NewDateTimeSeriesFromData([]time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"When": when},
+			ColumnOrder: []string{"When"},
+			Index:       []string{"0", "1", "2"},
+		}
+		result, err := df.FilterParallel(func(row map[string]any) bool {
+			return row["When"].(time.Time).After(start)
+		}, 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := result.Columns["When"].(*collection.DateTimeSeries); !ok {
+			t.Errorf("expected When to stay a DateTimeSeries, got %T", result.Columns["When"])
+		}
+		if result.Columns["When"].Len() != 2 {
+			t.Errorf("expected 2 rows, got %d", result.Columns["When"].Len())
+		}
+	})
+
+	t.Run("no matches yields empty DataFrame", func(t *testing.T) {
+		result, err := filterTestDF().FilterParallel(func(map[string]any) bool { return false }, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result == nil || result.Len() != 0 || len(result.ColumnOrder) != 3 {
+			t.Errorf("expected an empty DataFrame with 3 columns, got %v", result)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, err := filterTestDF().FilterParallel(nil, 0); err == nil {
+			t.Error("expected error for nil predicate")
+		}
+		var df *dataframe.DataFrame
+		if _, err := df.FilterParallel(func(map[string]any) bool { return true }, 0); err == nil {
+			t.Error("expected error for nil DataFrame")
+		}
+	})
+}
+
 func TestFilterChaining(t *testing.T) {
 	t.Run("chained Filter then Filter", func(t *testing.T) {
 		df := filterTestDF()
@@ -255,3 +329,39 @@ func TestFilterChaining(t *testing.T) {
 		_ = df.Filter("Missing", dataframe.Equals, 1).MustResult()
 	})
 }
+
+func TestSelectWhere(t *testing.T) {
+	df := filterTestDF()
+	mask, _ := collection.NewBoolSeriesFromData([]bool{true, true, false, true}, []bool{false, true, false, false})
+
+	result, err := df.SelectWhere([]string{"City", "Name"}, mask)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strSliceEqual(result.ColumnOrder, []string{"City", "Name"}) || len(result.Columns) != 2 {
+		t.Fatalf("unexpected columns %v", result.ColumnOrder)
+	}
+	if !strSliceEqual(result.Index, []string{"0", "3"}) {
+		t.Errorf("expected index [0 3], got %v", result.Index)
+	}
+	name, _ := result.Columns["Name"].At(1)
+	if name != "Diana" {
+		t.Errorf("expected Diana, got %v", name)
+	}
+
+	all, err := df.SelectWhere(nil, mask)
+	if err != nil || len(all.ColumnOrder) != 3 || all.Len() != 2 {
+		t.Errorf("expected all columns and 2 rows, got %v, %v", all, err)
+	}
+
+	short, _ := collection.NewBoolSeriesFromData([]bool{true}, nil)
+	if _, err := df.SelectWhere(nil, short); err == nil {
+		t.Error("expected error for mask length mismatch")
+	}
+	if _, err := df.SelectWhere([]string{"Missing"}, mask); err == nil {
+		t.Error("expected error for missing column")
+	}
+	if _, err := df.SelectWhere(nil, mustSeries(1, 2, 3, 4)); err == nil {
+		t.Error("expected error for non-bool mask")
+	}
+}
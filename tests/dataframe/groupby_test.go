@@ -1,7 +1,11 @@
 package dataframe
 
 import (
+	"errors"
+	"math"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas/dataframe"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -105,6 +109,46 @@ func TestGroupBy_Sum(t *testing.T) {
 	}
 }
 
+func TestGroupBy_Std(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"K": must(collection.NewStringSeriesFromData([]string{"a", "a", "a", "a", "a", "a", "a", "a", "b", "b"}, nil)),
+			"V": must(collection.NewFloat64SeriesFromData([]float64{2, 4, 4, 4, 5, 5, 7, 9, 1, 0}, []bool{false, false, false, false, false, false, false, false, false, true})),
+		},
+		ColumnOrder: []string{"K", "V"},
+		Index:       []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"},
+	}
+	gb, err := df.GroupBy([]string{"K"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	// Population variance of [2 4 4 4 5 5 7 9] is exactly 4, so std is 2.
+	pop, err := gb.Std(0)
+	if err != nil {
+		t.Fatalf("Std failed: %v", err)
+	}
+	if v, _ := pop.Columns["V"].At(0); v != 2.0 {
+		t.Errorf("Expected population std 2, got %v", v)
+	}
+	if v, _ := pop.Columns["V"].At(1); v != 0.0 {
+		t.Errorf("Expected population std 0 for a single value, got %v", v)
+	}
+
+	// Sample variance is 32/7.
+	sample, _ := gb.Std(1)
+	if v, _ := sample.Columns["V"].At(0); math.Abs(v.(float64)-math.Sqrt(32.0/7.0)) > 1e-12 {
+		t.Errorf("Expected sample std sqrt(32/7), got %v", v)
+	}
+	if !sample.Columns["V"].IsNull(1) {
+		t.Error("Expected null sample std for a group with one non-null value")
+	}
+
+	if _, err := gb.Std(-1); err == nil {
+		t.Error("Expected error for negative ddof")
+	}
+}
+
 func TestGroupBy_Apply(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -145,3 +189,441 @@ func TestGroupBy_Apply(t *testing.T) {
 		t.Errorf("Expected 1.0, got %v", val1)
 	}
 }
+
+func TestGroupBy_Pipe(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": must(collection.NewStringSeriesFromData([]string{"foo", "bar", "foo"}, nil)),
+			"C": must(collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)),
+		},
+		ColumnOrder: []string{"A", "C"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	gb, err := df.GroupBy([]string{"A"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	sum, err := gb.Pipe(func(g *dataframe.GroupBy) (*dataframe.DataFrame, error) {
+		return g.Sum()
+	})
+	if err != nil {
+		t.Fatalf("Pipe failed: %v", err)
+	}
+	if sum.Len() != 2 {
+		t.Errorf("Expected 2 rows, got %d", sum.Len())
+	}
+
+	var keys []string
+	res, err := gb.PipeWithKey(func(key string, sub *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+		keys = append(keys, key)
+		if key == "bar" {
+			return nil, nil
+		}
+		return sub, nil
+	})
+	if err != nil {
+		t.Fatalf("PipeWithKey failed: %v", err)
+	}
+	if len(keys) != 2 || keys[0] != "bar" || keys[1] != "foo" {
+		t.Errorf("Expected keys [bar foo], got %v", keys)
+	}
+	if res.Len() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", res.Len())
+	}
+	cCol, _ := res.SelectCol("C")
+	if v, _ := cCol.At(1); v != 3.0 {
+		t.Errorf("Expected 3.0, got %v", v)
+	}
+
+	if _, err := gb.PipeWithKey(nil); err == nil {
+		t.Error("Expected error for nil fn")
+	}
+}
+
+func TestGroupBy_HeadTail(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Team":  must(collection.NewStringSeriesFromData([]string{"a", "b", "a", "a", "b", "c"}, nil)),
+			"Score": must(collection.NewInt64SeriesFromData([]int64{1, 2, 3, 4, 5, 6}, nil)),
+		},
+		ColumnOrder: []string{"Team", "Score"},
+		Index:       []string{"r0", "r1", "r2", "r3", "r4", "r5"},
+	}
+	gb, err := df.GroupBy([]string{"Team"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	t.Run("head", func(t *testing.T) {
+		head, err := gb.Head(2)
+		if err != nil {
+			t.Fatalf("Head failed: %v", err)
+		}
+		expected := []string{"r0", "r1", "r2", "r4", "r5"}
+		if len(head.Index) != len(expected) {
+			t.Fatalf("expected index %v, got %v", expected, head.Index)
+		}
+		for i := range expected {
+			if head.Index[i] != expected[i] {
+				t.Fatalf("expected index %v, got %v", expected, head.Index)
+			}
+		}
+		if v, _ := head.Columns["Score"].At(3); v != int64(5) {
+			t.Errorf("expected Score 5 at row 3, got %v", v)
+		}
+	})
+
+	t.Run("tail", func(t *testing.T) {
+		tail, err := gb.Tail(1)
+		if err != nil {
+			t.Fatalf("Tail failed: %v", err)
+		}
+		expected := []string{"r3", "r4", "r5"}
+		if len(tail.Index) != len(expected) {
+			t.Fatalf("expected index %v, got %v", expected, tail.Index)
+		}
+		for i := range expected {
+			if tail.Index[i] != expected[i] {
+				t.Fatalf("expected index %v, got %v", expected, tail.Index)
+			}
+		}
+	})
+
+	t.Run("negative n", func(t *testing.T) {
+		if _, err := gb.Head(-1); err == nil {
+			t.Error("expected error for negative n")
+		}
+	})
+}
+
+func TestGroupBy_Rank(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Team":  must(collection.NewStringSeriesFromData([]string{"a", "b", "a", "a", "b"}, nil)),
+			"Name":  must(collection.NewStringSeriesFromData([]string{"p", "q", "r", "s", "t"}, nil)),
+			"Score": must(collection.NewInt64SeriesFromData([]int64{10, 5, 30, 20, 7}, nil)),
+		},
+		ColumnOrder: []string{"Team", "Name", "Score"},
+		Index:       []string{"0", "1", "2", "3", "4"},
+	}
+	gb, err := df.GroupBy([]string{"Team"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	ranks, err := gb.Rank("average", true)
+	if err != nil {
+		t.Fatalf("Rank failed: %v", err)
+	}
+	if len(ranks.ColumnOrder) != 1 || ranks.ColumnOrder[0] != "Score" {
+		t.Fatalf("expected only Score column, got %v", ranks.ColumnOrder)
+	}
+	expected := []float64{1, 1, 3, 2, 2}
+	for i, want := range expected {
+		got, _ := ranks.Columns["Score"].At(i)
+		if got != want {
+			t.Errorf("row %d: expected rank %v, got %v", i, want, got)
+		}
+	}
+
+	if _, err := gb.Rank("bogus", true); err == nil {
+		t.Error("expected error for invalid method")
+	}
+}
+
+func TestResample(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Sales": must(collection.NewFloat64SeriesFromData([]float64{1, 2, 3, 4, 5}, nil)),
+		},
+		ColumnOrder: []string{"Sales"},
+		Index:       []string{"2024-01-05", "2024-01-20", "2024-02-01", "2024-04-30", "2024-04-01"},
+		IndexName:   "date",
+	}
+
+	gb, err := df.Resample("M")
+	if err != nil {
+		t.Fatalf("Resample: %v", err)
+	}
+	monthly, err := gb.Sum()
+	if err != nil {
+		t.Fatalf("Sum: %v", err)
+	}
+	wantKeys := []any{"2024-01-31", "2024-02-29", "2024-04-30"}
+	wantSums := []any{3.0, 3.0, 9.0}
+	for i := range wantKeys {
+		key, _ := monthly.Columns["date"].At(i)
+		sum, _ := monthly.Columns["Sales"].At(i)
+		if key != wantKeys[i] || sum != wantSums[i] {
+			t.Errorf("row %d: expected %v=%v, got %v=%v", i, wantKeys[i], wantSums[i], key, sum)
+		}
+	}
+
+	gb, err = df.Resample("Q")
+	if err != nil {
+		t.Fatalf("Resample Q: %v", err)
+	}
+	quarterly, _ := gb.Sum()
+	if quarterly.Len() != 2 {
+		t.Errorf("expected 2 quarters, got %d", quarterly.Len())
+	}
+	if key, _ := quarterly.Columns["date"].At(1); key != "2024-06-30" {
+		t.Errorf("expected second quarter label 2024-06-30, got %v", key)
+	}
+
+	// 2024-01-05 is a Friday; its week ends on Sunday 2024-01-07.
+	gb, _ = df.Resample("W")
+	weekly, _ := gb.Sum()
+	if key, _ := weekly.Columns["date"].At(0); key != "2024-01-07" {
+		t.Errorf("expected first week label 2024-01-07, got %v", key)
+	}
+
+	if _, err := df.Resample("fortnight"); err == nil {
+		t.Error("expected error for unsupported rule")
+	}
+	bad := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"x": must(collection.NewInt64SeriesFromData([]int64{1}, nil))},
+		ColumnOrder: []string{"x"},
+		Index:       []string{"not a date"},
+	}
+	if _, err := bad.Resample("D"); err == nil {
+		t.Error("expected error for non-datetime index")
+	}
+}
+
+func TestGroupByFunc(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Amount": must(collection.NewFloat64SeriesFromData([]float64{50, 150, 20, 300}, nil)),
+		},
+		ColumnOrder: []string{"Amount"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+
+	bucket := func(i int, df *dataframe.DataFrame) (string, error) {
+		v, err := df.ILoc().At(i, 0)
+		if err != nil {
+			return "", err
+		}
+		if v.(float64) >= 100 {
+			return "large", nil
+		}
+		return "small", nil
+	}
+	gb, err := df.GroupByFunc(bucket, 0)
+	if err != nil {
+		t.Fatalf("GroupByFunc: %v", err)
+	}
+	sums, err := gb.Sum()
+	if err != nil {
+		t.Fatalf("Sum: %v", err)
+	}
+	wantKeys := []any{"large", "small"}
+	wantSums := []any{450.0, 70.0}
+	for i := range wantKeys {
+		key, _ := sums.Columns["group"].At(i)
+		sum, _ := sums.Columns["Amount"].At(i)
+		if key != wantKeys[i] || sum != wantSums[i] {
+			t.Errorf("row %d: expected %v=%v, got %v=%v", i, wantKeys[i], wantSums[i], key, sum)
+		}
+	}
+	if len(df.ColumnOrder) != 1 {
+		t.Errorf("expected original DataFrame unchanged, got columns %v", df.ColumnOrder)
+	}
+
+	failing := func(i int, df *dataframe.DataFrame) (string, error) {
+		return "", errors.New("boom")
+	}
+	if _, err := df.GroupByFunc(failing, 0); err == nil {
+		t.Error("expected key function error to be returned")
+	}
+	if _, err := df.GroupByFunc(bucket, 1); err == nil {
+		t.Error("expected error for axis 1")
+	}
+}
+
+func TestGroupBy_MultiKeyTypes(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Region": must(collection.NewStringSeriesFromData([]string{"n", "s", "n", "n"}, nil)),
+			"Year":   must(collection.NewInt64SeriesFromData([]int64{2020, 2020, 2020, 2021}, nil)),
+			"Sales":  must(collection.NewFloat64SeriesFromData([]float64{1, 2, 3, 4}, nil)),
+		},
+		ColumnOrder: []string{"Region", "Year", "Sales"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+
+	gb, err := df.GroupBy([]string{"Region", "Year"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+	sum, err := gb.Sum()
+	if err != nil {
+		t.Fatalf("Sum failed: %v", err)
+	}
+
+	if _, ok := sum.Columns["Region"].(*collection.StringSeries); !ok {
+		t.Errorf("expected Region to stay a StringSeries, got %T", sum.Columns["Region"])
+	}
+	if _, ok := sum.Columns["Year"].(*collection.Int64Series); !ok {
+		t.Fatalf("expected Year to stay an Int64Series, got %T", sum.Columns["Year"])
+	}
+
+	want := []struct {
+		region string
+		year   int64
+		sales  float64
+	}{
+		{"n", 2020, 4},
+		{"n", 2021, 4},
+		{"s", 2020, 2},
+	}
+	if sum.Len() != len(want) {
+		t.Fatalf("expected %d groups, got %d", len(want), sum.Len())
+	}
+	for i, w := range want {
+		region, _ := sum.Columns["Region"].At(i)
+		year, _ := sum.Columns["Year"].At(i)
+		sales, _ := sum.Columns["Sales"].At(i)
+		if region != w.region || year != w.year || sales != w.sales {
+			t.Errorf("row %d: expected (%s, %d, %v), got (%v, %v, %v)", i, w.region, w.year, w.sales, region, year, sales)
+		}
+	}
+}
+
+func TestGroupBy_Mode(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Store":   must(collection.NewStringSeriesFromData([]string{"a", "a", "a", "b", "b"}, nil)),
+			"Product": must(collection.NewStringSeriesFromData([]string{"x", "y", "x", "y", "z"}, nil)),
+			"Qty":     must(collection.NewInt64SeriesFromData([]int64{1, 1, 2, 5, 0}, []bool{false, false, false, false, true})),
+		},
+		ColumnOrder: []string{"Store", "Product", "Qty"},
+		Index:       []string{"0", "1", "2", "3", "4"},
+	}
+
+	gb, err := df.GroupBy([]string{"Store"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+	modes, err := gb.Mode()
+	if err != nil {
+		t.Fatalf("Mode failed: %v", err)
+	}
+
+	// Store a: Product x, Qty 1. Store b: Product y/z tie, Qty 5 then null padding.
+	wantStore := []any{"a", "b", "b"}
+	wantProduct := []any{"x", "y", "z"}
+	wantQty := []any{int64(1), int64(5), nil}
+	if got := modes.Columns["Store"].ValuesCopy(); !reflect.DeepEqual(got, wantStore) {
+		t.Errorf("Store: expected %v, got %v", wantStore, got)
+	}
+	if got := modes.Columns["Product"].ValuesCopy(); !reflect.DeepEqual(got, wantProduct) {
+		t.Errorf("Product: expected %v, got %v", wantProduct, got)
+	}
+	if _, ok := modes.Columns["Qty"].(*collection.Int64Series); !ok {
+		t.Errorf("expected Qty to stay an Int64Series, got %T", modes.Columns["Qty"])
+	}
+	if got := modes.Columns["Qty"].ValuesCopy(); !reflect.DeepEqual(got, wantQty) {
+		t.Errorf("Qty: expected %v, got %v", wantQty, got)
+	}
+	if len(modes.Index) != 3 {
+		t.Errorf("expected 3 index labels, got %v", modes.Index)
+	}
+}
+
+func TestGroupBy_NUnique(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Store":   must(collection.NewStringSeriesFromData([]string{"a", "a", "a", "b", "b"}, nil)),
+			"Product": must(collection.NewStringSeriesFromData([]string{"x", "y", "x", "y", ""}, []bool{false, false, false, false, true})),
+		},
+		ColumnOrder: []string{"Store", "Product"},
+		Index:       []string{"0", "1", "2", "3", "4"},
+	}
+
+	gb, err := df.GroupBy([]string{"Store"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+	counts, err := gb.NUnique(true)
+	if err != nil {
+		t.Fatalf("NUnique failed: %v", err)
+	}
+	if got := counts.Columns["Product"].ValuesCopy(); !reflect.DeepEqual(got, []any{int64(2), int64(1)}) {
+		t.Errorf("expected [2 1], got %v", got)
+	}
+	if got := counts.Columns["Store"].ValuesCopy(); !reflect.DeepEqual(got, []any{"a", "b"}) {
+		t.Errorf("expected group keys [a b], got %v", got)
+	}
+
+	withNull, _ := gb.NUnique(false)
+	if got := withNull.Columns["Product"].ValuesCopy(); !reflect.DeepEqual(got, []any{int64(2), int64(2)}) {
+		t.Errorf("expected [2 2] counting null, got %v", got)
+	}
+
+	agg, err := gb.Agg(map[string][]dataframe.AggFunc{"Product": {dataframe.AggNUnique}})
+	if err != nil {
+		t.Fatalf("Agg failed: %v", err)
+	}
+	if got := agg.Columns["Product_nunique"].ValuesCopy(); !reflect.DeepEqual(got, []any{int64(2), int64(1)}) {
+		t.Errorf("expected Agg nunique [2 1], got %v", got)
+	}
+}
+
+func TestGroupBy_TypedKeyColumns(t *testing.T) {
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Day":   must(collection.NewDateTimeSeriesFromData([]time.Time{day1, day2, day1}, nil)),
+			"Store": must(collection.NewCategoricalSeriesFromStrings([]string{"a", "b", "a"}, nil)),
+			"Sales": must(collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)),
+		},
+		ColumnOrder: []string{"Day", "Store", "Sales"},
+		Index:       []string{"0", "1", "2"},
+	}
+	gb, err := df.GroupBy([]string{"Day", "Store"}, 0)
+	if err != nil {
+		t.Fatalf("GroupBy failed: %v", err)
+	}
+
+	sum, err := gb.Sum()
+	if err != nil {
+		t.Fatalf("Sum failed: %v", err)
+	}
+	agg, err := gb.Agg(map[string][]dataframe.AggFunc{"Sales": {dataframe.AggSum}})
+	if err != nil {
+		t.Fatalf("Agg failed: %v", err)
+	}
+	nunique, err := gb.NUnique(true)
+	if err != nil {
+		t.Fatalf("NUnique failed: %v", err)
+	}
+	mode, err := gb.Mode()
+	if err != nil {
+		t.Fatalf("Mode failed: %v", err)
+	}
+
+	for name, result := range map[string]*dataframe.DataFrame{"Sum": sum, "Agg": agg, "NUnique": nunique, "Mode": mode} {
+		if _, ok := result.Columns["Day"].(*collection.DateTimeSeries); !ok {
+			t.Errorf("%s: expected Day to stay a DateTimeSeries, got %T", name, result.Columns["Day"])
+		}
+		if _, ok := result.Columns["Store"].(*collection.CategoricalSeries); !ok {
+			t.Errorf("%s: expected Store to stay a CategoricalSeries, got %T", name, result.Columns["Store"])
+		}
+		// Mode gives the first group one row per tied mode, so check the
+		// last row, which belongs to the second group in every result.
+		last := result.Len() - 1
+		day, _ := result.Columns["Day"].At(last)
+		store, _ := result.Columns["Store"].At(last)
+		if day != day2 || store != "b" {
+			t.Errorf("%s: expected second group (%v, b), got (%v, %v)", name, day2, day, store)
+		}
+	}
+	if v, _ := sum.Columns["Sales"].At(0); v != 4.0 {
+		t.Errorf("expected Sales sum 4 for the first group, got %v", v)
+	}
+}
@@ -0,0 +1,80 @@
+package dataframe_test
+
+import (
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func hashTestDF() *dataframe.DataFrame {
+	return &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Name":  mustSeries("Alice", "Bob"),
+			"Score": mustSeries(1.5, 2.5),
+		},
+		ColumnOrder: []string{"Name", "Score"},
+		Index:       []string{"0", "1"},
+	}
+}
+
+func TestColumnHash(t *testing.T) {
+	a, err := hashTestDF().ColumnHash("Score")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, _ := hashTestDF().ColumnHash("Score")
+	if a != b {
+		t.Errorf("expected equal columns to hash equally, got %x and %x", a, b)
+	}
+
+	zero, _ := collection.NewFloat64SeriesFromData([]float64{0}, nil)
+	null, _ := collection.NewFloat64SeriesFromData([]float64{0}, []bool{true})
+	empty, _ := collection.NewStringSeriesFromData([]string{""}, nil)
+	nullStr, _ := collection.NewStringSeriesFromData([]string{""}, []bool{true})
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"zero": zero, "null": null, "empty": empty, "nullStr": nullStr},
+		ColumnOrder: []string{"zero", "null", "empty", "nullStr"},
+		Index:       []string{"0"},
+	}
+	hz, _ := df.ColumnHash("zero")
+	hn, _ := df.ColumnHash("null")
+	he, _ := df.ColumnHash("empty")
+	hns, _ := df.ColumnHash("nullStr")
+	if hz == hn || he == hns {
+		t.Error("expected nulls to hash differently from zero values")
+	}
+
+	if _, err := df.ColumnHash("Missing"); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
+
+func TestHash(t *testing.T) {
+	a, err := hashTestDF().Hash()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, _ := hashTestDF().Hash()
+	if a != b {
+		t.Errorf("expected equal DataFrames to hash equally")
+	}
+
+	reindexed := hashTestDF()
+	reindexed.Index = []string{"x", "y"}
+	if h, _ := reindexed.Hash(); h == a {
+		t.Error("expected index change to change the hash")
+	}
+
+	reordered := hashTestDF()
+	reordered.ColumnOrder = []string{"Score", "Name"}
+	if h, _ := reordered.Hash(); h == a {
+		t.Error("expected column order change to change the hash")
+	}
+
+	changed := hashTestDF()
+	changed.Columns["Score"].Set(1, 3.5)
+	if h, _ := changed.Hash(); h == a {
+		t.Error("expected value change to change the hash")
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/apoplexi24/gpandas"
 	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
 )
 
 // Helper function to create a test DataFrame
@@ -159,6 +160,127 @@ func TestSetIndexAndResetIndex(t *testing.T) {
 	})
 }
 
+func TestSetIndexFromColumn(t *testing.T) {
+	t.Run("promote column and drop it", func(t *testing.T) {
+		df := createTestDataFrame(t)
+		if err := df.SetIndexFromColumn("name", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expectedIndex := []string{"Alice", "Bob", "Charlie", "David"}
+		if !reflect.DeepEqual(df.Index, expectedIndex) {
+			t.Errorf("expected index %v, got %v", expectedIndex, df.Index)
+		}
+		if !reflect.DeepEqual(df.ColumnOrder, []string{"age", "city"}) {
+			t.Errorf("expected columns [age city], got %v", df.ColumnOrder)
+		}
+		if _, ok := df.Columns["name"]; ok {
+			t.Error("expected 'name' column to be dropped")
+		}
+		val, err := df.Loc().At("Bob", "city")
+		if err != nil || val != "LA" {
+			t.Errorf("expected LA for Bob, got %v (err %v)", val, err)
+		}
+	})
+
+	t.Run("promote column and keep it", func(t *testing.T) {
+		df := createTestDataFrame(t)
+		if err := df.SetIndexFromColumn("age", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(df.Index, []string{"25", "30", "35", "28"}) {
+			t.Errorf("unexpected index %v", df.Index)
+		}
+		if len(df.ColumnOrder) != 3 {
+			t.Errorf("expected 3 columns, got %v", df.ColumnOrder)
+		}
+	})
+
+	t.Run("duplicate values", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"ID": mustSeries(1, 2, 1),
+			},
+			ColumnOrder: []string{"ID"},
+			Index:       []string{"0", "1", "2"},
+		}
+		if err := df.SetIndexFromColumn("ID", true); err == nil {
+			t.Error("expected error for duplicate index values")
+		}
+		if !reflect.DeepEqual(df.Index, []string{"0", "1", "2"}) {
+			t.Errorf("expected index unchanged on error, got %v", df.Index)
+		}
+	})
+
+	t.Run("null values", func(t *testing.T) {
+		ids, _ := collection.NewInt64SeriesFromData([]int64{7, 0, 9}, []bool{false, true, false})
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"ID": ids},
+			ColumnOrder: []string{"ID"},
+			Index:       []string{"0", "1", "2"},
+		}
+		if err := df.SetIndexFromColumn("ID", false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(df.Index, []string{"7", "null", "9"}) {
+			t.Errorf("expected a null label, got %v", df.Index)
+		}
+
+		twoNulls, _ := collection.NewInt64SeriesFromData([]int64{0, 0}, []bool{true, true})
+		df = &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"ID": twoNulls},
+			ColumnOrder: []string{"ID"},
+			Index:       []string{"0", "1"},
+		}
+		if err := df.SetIndexFromColumn("ID", false); err == nil {
+			t.Error("expected error for more than one null")
+		}
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		df := createTestDataFrame(t)
+		if err := df.SetIndexFromColumn("missing", false); err == nil {
+			t.Error("expected error for missing column")
+		}
+	})
+}
+
+func TestResetIndexToColumn(t *testing.T) {
+	t.Run("round trip with SetIndexFromColumn", func(t *testing.T) {
+		df := createTestDataFrame(t)
+		if err := df.SetIndexFromColumn("name", true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := df.ResetIndexToColumn("name"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(df.ColumnOrder, []string{"name", "age", "city"}) {
+			t.Errorf("expected name restored as first column, got %v", df.ColumnOrder)
+		}
+		if !reflect.DeepEqual(df.Index, []string{"0", "1", "2", "3"}) {
+			t.Errorf("expected default index, got %v", df.Index)
+		}
+		val, _ := df.Columns["name"].At(2)
+		if val != "Charlie" {
+			t.Errorf("expected Charlie at row 2, got %v", val)
+		}
+	})
+
+	t.Run("existing column name", func(t *testing.T) {
+		df := createTestDataFrame(t)
+		if err := df.ResetIndexToColumn("age"); err == nil {
+			t.Error("expected error for existing column name")
+		}
+	})
+
+	t.Run("index length mismatch", func(t *testing.T) {
+		df := createTestDataFrame(t)
+		df.Index = []string{"a"}
+		if err := df.ResetIndexToColumn("label"); err == nil {
+			t.Error("expected error for index length mismatch")
+		}
+	})
+}
+
 // TestLocAt tests Loc.At() for single value access
 func TestLocAt(t *testing.T) {
 	df := createTestDataFrame(t)
@@ -300,6 +422,114 @@ func TestLocCols(t *testing.T) {
 	})
 }
 
+// TestLocSubFrame tests Loc.AtColumns() and Loc.SubFrame()
+func TestLocSubFrame(t *testing.T) {
+	df := createTestDataFrame(t)
+	if err := df.SetIndex([]string{"A", "B", "C", "D"}); err != nil {
+		t.Fatalf("Failed to set index: %v", err)
+	}
+	df.Columns["city"].SetNull(1)
+
+	t.Run("at columns", func(t *testing.T) {
+		vals, err := df.Loc().AtColumns("B", []string{"city", "name"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(vals, []any{nil, "Bob"}) {
+			t.Errorf("expected [<nil> Bob], got %v", vals)
+		}
+		if _, err := df.Loc().AtColumns("Z", []string{"name"}); err == nil {
+			t.Error("expected error for invalid row label")
+		}
+		if _, err := df.Loc().AtColumns("A", []string{"name", "missing"}); err == nil {
+			t.Error("expected error for invalid column")
+		}
+	})
+
+	t.Run("sub frame", func(t *testing.T) {
+		sub, err := df.Loc().SubFrame([]string{"D", "B"}, []string{"city", "age"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(sub.Index, []string{"D", "B"}) {
+			t.Errorf("expected index [D B], got %v", sub.Index)
+		}
+		if !reflect.DeepEqual(sub.ColumnOrder, []string{"city", "age"}) {
+			t.Errorf("expected columns [city age], got %v", sub.ColumnOrder)
+		}
+		if !reflect.DeepEqual(sub.Columns["city"].ValuesCopy(), []any{"Seattle", nil}) {
+			t.Errorf("expected city [Seattle <nil>], got %v", sub.Columns["city"].ValuesCopy())
+		}
+		if !reflect.DeepEqual(sub.Columns["age"].ValuesCopy(), []any{"28", "30"}) {
+			t.Errorf("expected age [28 30], got %v", sub.Columns["age"].ValuesCopy())
+		}
+		if _, err := df.Loc().SubFrame([]string{"A", "Z"}, []string{"name"}); err == nil {
+			t.Error("expected error for invalid row label")
+		}
+		if _, err := df.Loc().SubFrame([]string{"A"}, []string{"missing"}); err == nil {
+			t.Error("expected error for invalid column")
+		}
+	})
+}
+
+// TestILocSubFrame tests iLoc.SubFrame() and iLoc.SliceFrame()
+func TestILocSubFrame(t *testing.T) {
+	df := createTestDataFrame(t)
+
+	t.Run("sub frame", func(t *testing.T) {
+		sub, err := df.ILoc().SubFrame([]int{3, 0}, []int{2, 0})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(sub.Index, []string{"3", "0"}) {
+			t.Errorf("expected index [3 0], got %v", sub.Index)
+		}
+		if !reflect.DeepEqual(sub.ColumnOrder, []string{"city", "name"}) {
+			t.Errorf("expected columns [city name], got %v", sub.ColumnOrder)
+		}
+		if !reflect.DeepEqual(sub.Columns["name"].ValuesCopy(), []any{"David", "Alice"}) {
+			t.Errorf("expected names [David Alice], got %v", sub.Columns["name"].ValuesCopy())
+		}
+		if _, err := df.ILoc().SubFrame([]int{4}, []int{0}); err == nil {
+			t.Error("expected error for out of range row")
+		}
+		if _, err := df.ILoc().SubFrame([]int{0}, []int{3}); err == nil {
+			t.Error("expected error for out of range column")
+		}
+	})
+
+	t.Run("slice frame", func(t *testing.T) {
+		sub, err := df.ILoc().SliceFrame(1, 3, 1, 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(sub.Index, []string{"1", "2"}) {
+			t.Errorf("expected index [1 2], got %v", sub.Index)
+		}
+		if !reflect.DeepEqual(sub.ColumnOrder, []string{"age", "city"}) {
+			t.Errorf("expected columns [age city], got %v", sub.ColumnOrder)
+		}
+		if !reflect.DeepEqual(sub.Columns["city"].ValuesCopy(), []any{"LA", "SF"}) {
+			t.Errorf("expected cities [LA SF], got %v", sub.Columns["city"].ValuesCopy())
+		}
+
+		empty, err := df.ILoc().SliceFrame(2, 2, 0, 3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if empty.Len() != 0 || len(empty.ColumnOrder) != 3 {
+			t.Errorf("expected 0x3 frame, got %dx%d", empty.Len(), len(empty.ColumnOrder))
+		}
+
+		if _, err := df.ILoc().SliceFrame(0, 5, 0, 1); err == nil {
+			t.Error("expected error for row end past the last row")
+		}
+		if _, err := df.ILoc().SliceFrame(0, 1, 2, 1); err == nil {
+			t.Error("expected error for column end before start")
+		}
+	})
+}
+
 // TestILocAt tests iLoc.At() for single value access
 func TestILocAt(t *testing.T) {
 	df := createTestDataFrame(t)
@@ -519,3 +749,110 @@ func TestIndexPreservation(t *testing.T) {
 		}
 	})
 }
+
+func TestLookup(t *testing.T) {
+	df := createTestDataFrame(t)
+	_ = df.SetIndex([]string{"a", "b", "c", "d"})
+
+	t.Run("paired lookups", func(t *testing.T) {
+		got, err := df.Lookup([]string{"a", "c", "d"}, []string{"name", "city", "age"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		expected := []any{"Alice", "SF", "28"}
+		if !reflect.DeepEqual(got.ValuesCopy(), expected) {
+			t.Errorf("expected %v, got %v", expected, got.ValuesCopy())
+		}
+		if _, ok := got.(*collection.StringSeries); !ok {
+			t.Errorf("expected StringSeries, got %T", got)
+		}
+	})
+
+	t.Run("length mismatch", func(t *testing.T) {
+		if _, err := df.Lookup([]string{"a"}, []string{"name", "age"}); err == nil {
+			t.Error("expected error for length mismatch")
+		}
+	})
+
+	t.Run("unknown label or column", func(t *testing.T) {
+		if _, err := df.Lookup([]string{"z"}, []string{"name"}); err == nil {
+			t.Error("expected error for unknown row label")
+		}
+		if _, err := df.Lookup([]string{"a"}, []string{"missing"}); err == nil {
+			t.Error("expected error for unknown column")
+		}
+	})
+}
+
+func TestILocRangeStep(t *testing.T) {
+	df := createTestDataFrame(t)
+
+	t.Run("every other row", func(t *testing.T) {
+		result, err := df.ILoc().RangeStep(0, 4, 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(result.Index, []string{"0", "2"}) {
+			t.Errorf("expected index [0 2], got %v", result.Index)
+		}
+		if v, _ := result.Columns["name"].At(1); v != "Charlie" {
+			t.Errorf("expected Charlie, got %v", v)
+		}
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		result, err := df.ILoc().RangeStep(3, -1, -1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(result.Index, []string{"3", "2", "1", "0"}) {
+			t.Errorf("expected reversed index, got %v", result.Index)
+		}
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		if _, err := df.ILoc().RangeStep(0, 4, 0); err == nil {
+			t.Error("expected error for zero step")
+		}
+		if _, err := df.ILoc().RangeStep(0, 5, 1); err == nil {
+			t.Error("expected error for end out of range")
+		}
+		if _, err := df.ILoc().RangeStep(0, 2, -1); err == nil {
+			t.Error("expected error for negative step with start < end")
+		}
+	})
+}
+
+func TestLocRowRange(t *testing.T) {
+	df := createTestDataFrame(t)
+	_ = df.SetIndex([]string{"2023-01-01", "2023-01-02", "2023-01-03", "2023-01-04"})
+
+	t.Run("inclusive range", func(t *testing.T) {
+		result, err := df.Loc().RowRange("2023-01-02", "2023-01-03")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(result.Index, []string{"2023-01-02", "2023-01-03"}) {
+			t.Errorf("unexpected index %v", result.Index)
+		}
+		if v, _ := result.Columns["name"].At(0); v != "Bob" {
+			t.Errorf("expected Bob, got %v", v)
+		}
+	})
+
+	t.Run("start after end is empty", func(t *testing.T) {
+		result, err := df.Loc().RowRange("2023-01-03", "2023-01-01")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Len() != 0 {
+			t.Errorf("expected empty result, got %d rows", result.Len())
+		}
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		if _, err := df.Loc().RowRange("2023-01-01", "2024-01-01"); err == nil {
+			t.Error("expected error for unknown label")
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package dataframe_test
 
 import (
+	"math"
 	"strings"
 	"testing"
 
@@ -56,3 +57,219 @@ func TestToJSONNulls(t *testing.T) {
 		t.Errorf("expected null in output, got %s", s)
 	}
 }
+
+func TestWriteCSV(t *testing.T) {
+	t.Run("streams rows with custom separator", func(t *testing.T) {
+		var sb strings.Builder
+		if err := ioDF().WriteCSV(&sb, dataframe.CSVWriteOptions{Separator: ';'}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "Name;Age;Active\nAlice;30;true\nBob;25;false\n"
+		if sb.String() != want {
+			t.Errorf("expected %q, got %q", want, sb.String())
+		}
+	})
+
+	t.Run("quotes special values and writes nulls as empty", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"Name": mustSeries("Smith, J", nil),
+				"Note": mustSeries(`say "hi"`, "ok"),
+			},
+			ColumnOrder: []string{"Name", "Note"},
+			Index:       []string{"a", "b"},
+			IndexName:   "id",
+		}
+		var sb strings.Builder
+		if err := df.WriteCSV(&sb, dataframe.CSVWriteOptions{UseCRLF: true}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "id,Name,Note\r\na,\"Smith, J\",\"say \"\"hi\"\"\"\r\nb,,ok\r\n"
+		if sb.String() != want {
+			t.Errorf("expected %q, got %q", want, sb.String())
+		}
+	})
+
+	t.Run("quote all with custom quote char", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"Name": mustSeries("it's", "Bob"),
+				"Age":  mustSeries(30, nil),
+			},
+			ColumnOrder: []string{"Name", "Age"},
+			Index:       []string{"0", "1"},
+		}
+		out, err := df.ToCSVWithOptions("", dataframe.CSVWriteOptions{QuoteChar: '\'', QuoteAll: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "'Name','Age'\n'it''s','30'\n'Bob',''\n"
+		if out != want {
+			t.Errorf("expected %q, got %q", want, out)
+		}
+
+		out, _ = df.ToCSVWithOptions("", dataframe.CSVWriteOptions{QuoteChar: '\''})
+		want = "Name,Age\n'it''s',30\nBob,\n"
+		if out != want {
+			t.Errorf("expected %q, got %q", want, out)
+		}
+	})
+
+	t.Run("ToCSV quotes values containing the separator", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"City": mustSeries("Paris, FR", "line\nbreak")},
+			ColumnOrder: []string{"City"},
+			Index:       []string{"0", "1"},
+		}
+		out, err := df.ToCSV("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "City\n\"Paris, FR\"\n\"line\nbreak\"\n"
+		if out != want {
+			t.Errorf("expected %q, got %q", want, out)
+		}
+	})
+
+	t.Run("ToCSV keeps multi-character separators", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"Name": mustSeries("Alice", "Bob"),
+				"Age":  mustSeries(30, nil),
+			},
+			ColumnOrder: []string{"Name", "Age"},
+			Index:       []string{"0", "1"},
+		}
+		out, err := df.ToCSV("", "::")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := "Name::Age\nAlice::30\nBob::\n"
+		if out != want {
+			t.Errorf("expected %q, got %q", want, out)
+		}
+		out, err = df.ToCSV("", "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := "NameAge\nAlice30\nBob\n"; out != want {
+			t.Errorf("expected %q, got %q", want, out)
+		}
+	})
+
+	t.Run("nil writer", func(t *testing.T) {
+		if err := ioDF().WriteCSV(nil, dataframe.CSVWriteOptions{}); err == nil {
+			t.Error("expected error for nil writer")
+		}
+	})
+}
+
+func TestWriteJSON(t *testing.T) {
+	t.Run("records matches ToJSON", func(t *testing.T) {
+		df := ioDF()
+		want, _ := df.ToJSON("")
+		var sb strings.Builder
+		if err := df.WriteJSON(&sb, "records"); err != nil {
+			t.Fatalf("WriteJSON failed: %v", err)
+		}
+		if sb.String() != want {
+			t.Errorf("expected %s, got %s", want, sb.String())
+		}
+	})
+
+	t.Run("columns orientation with nulls", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"B": mustSeries("x", nil),
+				"A": mustSeries(1.5, 2.0),
+			},
+			ColumnOrder: []string{"B", "A"},
+			Index:       []string{"0", "1"},
+		}
+		var sb strings.Builder
+		if err := df.WriteJSON(&sb, "columns"); err != nil {
+			t.Fatalf("WriteJSON failed: %v", err)
+		}
+		want := `{"B":["x",null],"A":[1.5,2]}`
+		if sb.String() != want {
+			t.Errorf("expected %s, got %s", want, sb.String())
+		}
+	})
+
+	t.Run("unsupported orient", func(t *testing.T) {
+		var sb strings.Builder
+		if err := ioDF().WriteJSON(&sb, "split"); err == nil {
+			t.Error("expected error for unsupported orient")
+		}
+	})
+}
+
+func TestToDict(t *testing.T) {
+	data := ioDF().ToDict()
+	if len(data) != 3 || data["Age"][0] != 30 || data["Name"][1] != "Bob" {
+		t.Errorf("unexpected ToDict result %v", data)
+	}
+
+	score, _ := collection.NewFloat64SeriesFromData([]float64{1.5, 0}, []bool{false, true})
+	id, _ := collection.NewInt64SeriesFromData([]int64{7, 8}, nil)
+	name, _ := collection.NewStringSeriesFromData([]string{"a", "b"}, nil)
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"score": score, "id": id, "name": name},
+		ColumnOrder: []string{"score", "id", "name"},
+		Index:       []string{"0", "1"},
+	}
+	floats, ints, strs, err := df.ToDictTyped()
+	if err != nil {
+		t.Fatalf("ToDictTyped: %v", err)
+	}
+	if floats["score"][0] != 1.5 || !math.IsNaN(floats["score"][1]) {
+		t.Errorf("unexpected floats %v", floats)
+	}
+	if ints["id"][1] != 8 || strs["name"][0] != "a" {
+		t.Errorf("unexpected ints %v or strings %v", ints, strs)
+	}
+
+	if _, _, _, err := ioDF().ToDictTyped(); err == nil {
+		t.Error("expected error for untyped columns")
+	}
+}
+
+func TestPipeTo(t *testing.T) {
+	df := ioDF()
+	for _, format := range []string{"csv", "json", "table"} {
+		var b strings.Builder
+		if err := df.PipeTo(&b, format); err != nil {
+			t.Fatalf("PipeTo(%s) failed: %v", format, err)
+		}
+		if !strings.Contains(b.String(), "Alice") {
+			t.Errorf("PipeTo(%s): expected output to contain Alice, got %q", format, b.String())
+		}
+	}
+	if err := df.PipeTo(&strings.Builder{}, "xml"); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	age, _ := collection.NewInt64SeriesFromData([]int64{30, 0}, []bool{false, true})
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Name": mustSeries("Alice", "a|b"),
+			"Age":  age,
+		},
+		ColumnOrder: []string{"Name", "Age"},
+		Index:       []string{"0", "1"},
+	}
+
+	var b strings.Builder
+	if err := df.PipeTo(&b, "markdown"); err != nil {
+		t.Fatalf("WriteMarkdown failed: %v", err)
+	}
+	want := "| Name  |  Age |\n" +
+		"|:------|-----:|\n" +
+		"| Alice |   30 |\n" +
+		"| a\\|b  | null |\n"
+	if b.String() != want {
+		t.Errorf("unexpected markdown:\n%s\nwant:\n%s", b.String(), want)
+	}
+}
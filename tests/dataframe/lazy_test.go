@@ -0,0 +1,93 @@
+package dataframe_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func lazyTestDF() *dataframe.DataFrame {
+	return &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Name":   mustSeries("Alice", "Bob", "Charlie", "Diana", "Eve"),
+			"Age":    mustSeries(30, 25, 35, 28, 41),
+			"City":   mustSeries("NYC", "LA", "NYC", "SF", "LA"),
+			"Active": mustSeries(true, true, false, true, true),
+		},
+		ColumnOrder: []string{"Name", "Age", "City", "Active"},
+		Index:       []string{"a", "b", "c", "d", "e"},
+	}
+}
+
+func TestLazyFrameCollect(t *testing.T) {
+	df := lazyTestDF()
+	active := df.Columns["Active"]
+	notLA, _ := collection.NewBoolSeriesFromData([]bool{true, false, true, true, false}, nil)
+
+	result, err := df.Lazy().
+		Filter(active).
+		SortValues(dataframe.SortOptions{By: []string{"Age"}, Ascending: []bool{false}}).
+		Filter(notLA).
+		Select("Name").
+		Collect()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strSliceEqual(result.ColumnOrder, []string{"Name"}) || len(result.Columns) != 1 {
+		t.Fatalf("unexpected columns %v", result.ColumnOrder)
+	}
+	// Active and not LA: Alice(30), Diana(28) -> sorted by Age desc
+	if !strSliceEqual(result.Index, []string{"a", "d"}) {
+		t.Errorf("expected index [a d], got %v", result.Index)
+	}
+	if v, _ := result.Columns["Name"].At(1); v != "Diana" {
+		t.Errorf("expected Diana, got %v", v)
+	}
+
+	// The source DataFrame is untouched.
+	if len(df.ColumnOrder) != 4 || df.Len() != 5 {
+		t.Errorf("source DataFrame was modified")
+	}
+}
+
+func TestLazyFrameExplain(t *testing.T) {
+	df := lazyTestDF()
+	lf := df.Lazy().
+		Filter(df.Columns["Active"]).
+		SortValues(dataframe.SortOptions{By: []string{"Age"}}).
+		Select("Name")
+
+	plan := lf.Explain()
+	for _, want := range []string{"Filter(mask)", "Scan(columns=[Name Age], filters=1)", "Select([Name])"} {
+		if !strings.Contains(plan, want) {
+			t.Errorf("expected %q in plan:\n%s", want, plan)
+		}
+	}
+}
+
+func TestLazyFrameErrors(t *testing.T) {
+	df := lazyTestDF()
+
+	t.Run("sort on deselected column", func(t *testing.T) {
+		_, err := df.Lazy().Select("Name").SortValues(dataframe.SortOptions{By: []string{"Age"}}).Collect()
+		if err == nil {
+			t.Error("expected error for sort key removed by Select")
+		}
+	})
+
+	t.Run("mask length mismatch", func(t *testing.T) {
+		short, _ := collection.NewBoolSeriesFromData([]bool{true}, nil)
+		if _, err := df.Lazy().Filter(short).Collect(); err == nil {
+			t.Error("expected error for short mask")
+		}
+	})
+
+	t.Run("empty plan returns all rows", func(t *testing.T) {
+		result, err := df.Lazy().Collect()
+		if err != nil || result.Len() != 5 || len(result.ColumnOrder) != 4 {
+			t.Errorf("unexpected result %v, %v", result, err)
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package dataframe_test
 
 import (
+	"math"
+	"reflect"
 	"testing"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -104,6 +106,129 @@ func TestFillNAColumn(t *testing.T) {
 	})
 }
 
+func fillTestDF() *dataframe.DataFrame {
+	names, _ := collection.NewStringSeriesFromData([]string{"a", "", "c"}, []bool{false, true, false})
+	scores, _ := collection.NewFloat64SeriesFromData([]float64{1.5, 0, 0}, []bool{false, true, true})
+	counts, _ := collection.NewInt32SeriesFromData([]int32{0, 2, 3}, []bool{true, false, false})
+	return &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Name":  names,
+			"Score": scores,
+			"Count": counts,
+		},
+		ColumnOrder: []string{"Name", "Score", "Count"},
+		Index:       []string{"0", "1", "2"},
+	}
+}
+
+func TestFillNAValues(t *testing.T) {
+	t.Run("column values override the default and types are kept", func(t *testing.T) {
+		df := fillTestDF()
+		result, err := df.FillNAValues(int64(0), map[string]any{"Name": "unknown", "Score": int64(-1)})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := result.Columns["Score"].(*collection.Float64Series); !ok {
+			t.Errorf("expected Score to stay *Float64Series, got %T", result.Columns["Score"])
+		}
+		if _, ok := result.Columns["Count"].(*collection.Int32Series); !ok {
+			t.Errorf("expected Count to stay *Int32Series, got %T", result.Columns["Count"])
+		}
+		name, _ := result.Columns["Name"].At(1)
+		score, _ := result.Columns["Score"].At(2)
+		count, _ := result.Columns["Count"].At(0)
+		if name != "unknown" || score != -1.0 || count != int32(0) {
+			t.Errorf("got Name=%v Score=%v Count=%v", name, score, count)
+		}
+		if !df.Columns["Score"].IsNull(2) {
+			t.Error("original DataFrame was mutated")
+		}
+	})
+
+	t.Run("nil default fills only named columns", func(t *testing.T) {
+		result, err := fillTestDF().FillNAValues(nil, map[string]any{"Score": 0.0})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Columns["Score"].IsNull(1) {
+			t.Error("Score null should be filled")
+		}
+		if !result.Columns["Name"].IsNull(1) || !result.Columns["Count"].IsNull(0) {
+			t.Error("unnamed columns should keep their nulls")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		df := fillTestDF()
+		if _, err := df.FillNAValues(0.0, nil); err == nil {
+			t.Error("expected error filling a string column with a float")
+		}
+		if _, err := df.FillNAValues(nil, map[string]any{"Missing": 1}); err == nil {
+			t.Error("expected error for missing column")
+		}
+		if _, err := df.FillNAValues(nil, map[string]any{"Count": int64(1 << 40)}); err == nil {
+			t.Error("expected error filling an int32 column with an out-of-range int")
+		}
+		ids, _ := collection.NewInt64SeriesFromData([]int64{1, 0}, []bool{false, true})
+		intDF := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"ID": ids},
+			ColumnOrder: []string{"ID"},
+			Index:       []string{"0", "1"},
+		}
+		for _, fill := range []any{2.9, math.NaN(), math.Inf(1), math.Inf(-1), 1e19} {
+			if _, err := intDF.FillNAValues(fill, nil); err == nil {
+				t.Errorf("expected error filling an int64 column with %v", fill)
+			}
+		}
+		if result, err := intDF.FillNAValues(3.0, nil); err != nil {
+			t.Errorf("unexpected error filling an int64 column with a whole float: %v", err)
+		} else if v, _ := result.Columns["ID"].At(1); v != int64(3) {
+			t.Errorf("expected 3, got %v", v)
+		}
+		var nilDF *dataframe.DataFrame
+		if _, err := nilDF.FillNAValues(0, nil); err == nil {
+			t.Error("expected error for nil DataFrame")
+		}
+	})
+}
+
+func TestFillNAInPlace(t *testing.T) {
+	t.Run("fills existing series", func(t *testing.T) {
+		df := fillTestDF()
+		scores := df.Columns["Score"]
+		if err := df.FillNAInPlace(nil, map[string]any{"Score": 2, "Name": "x"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.Columns["Score"] != scores {
+			t.Error("expected the Score series to be modified in place")
+		}
+		v, _ := scores.At(1)
+		name, _ := df.Columns["Name"].At(1)
+		if v != 2.0 || name != "x" {
+			t.Errorf("got Score=%v Name=%v", v, name)
+		}
+		if !df.Columns["Count"].IsNull(0) {
+			t.Error("Count should keep its null")
+		}
+	})
+
+	t.Run("incompatible value leaves frame unchanged", func(t *testing.T) {
+		df := fillTestDF()
+		if err := df.FillNAInPlace(nil, map[string]any{"Score": 1.0, "Name": 5}); err == nil {
+			t.Fatal("expected error filling a string column with an int")
+		}
+		if err := df.FillNAInPlace(nil, map[string]any{"Count": 2.5}); err == nil {
+			t.Error("expected error filling an int32 column with a fractional float")
+		}
+		if !df.Columns["Count"].IsNull(0) {
+			t.Error("Count should not be filled after a failed call")
+		}
+		if !df.Columns["Score"].IsNull(1) {
+			t.Error("Score should not be filled after a failed call")
+		}
+	})
+}
+
 func TestFillNAMethod(t *testing.T) {
 	t.Run("ffill", func(t *testing.T) {
 		df := &dataframe.DataFrame{
@@ -236,6 +361,95 @@ func TestDropNA(t *testing.T) {
 	})
 }
 
+func TestDropNAAxis(t *testing.T) {
+	makeDF := func() *dataframe.DataFrame {
+		return &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"A": mustSeries(1.0, nil, 3.0, nil),
+				"B": mustSeries(nil, nil, 30.0, 40.0),
+				"C": mustSeries(nil, nil, nil, nil),
+			},
+			ColumnOrder: []string{"A", "B", "C"},
+			Index:       []string{"w", "x", "y", "z"},
+		}
+	}
+
+	t.Run("rows with thresh", func(t *testing.T) {
+		result, err := makeDF().DropNAAxis(0, "", 1, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"w", "y", "z"}) {
+			t.Errorf("expected index [w y z], got %v", result.Index)
+		}
+	})
+
+	t.Run("rows with subset", func(t *testing.T) {
+		result, err := makeDF().DropNAAxis(0, "any", 0, []string{"B"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"y", "z"}) {
+			t.Errorf("expected index [y z], got %v", result.Index)
+		}
+	})
+
+	t.Run("columns how all", func(t *testing.T) {
+		result, err := makeDF().DropNAAxis(1, "all", 0, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.ColumnOrder, []string{"A", "B"}) {
+			t.Errorf("expected columns [A B], got %v", result.ColumnOrder)
+		}
+		if _, ok := result.Columns["C"]; ok {
+			t.Error("column C should be dropped")
+		}
+		if !strSliceEqual(result.Index, []string{"w", "x", "y", "z"}) {
+			t.Errorf("index should be unchanged, got %v", result.Index)
+		}
+	})
+
+	t.Run("columns how any over subset of rows", func(t *testing.T) {
+		result, err := makeDF().DropNAAxis(1, "any", 0, []string{"y", "z"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.ColumnOrder, []string{"B"}) {
+			t.Errorf("expected columns [B], got %v", result.ColumnOrder)
+		}
+	})
+
+	t.Run("columns with thresh", func(t *testing.T) {
+		result, err := makeDF().DropNAAxis(1, "", 2, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.ColumnOrder, []string{"A", "B"}) {
+			t.Errorf("expected columns [A B], got %v", result.ColumnOrder)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		df := makeDF()
+		if _, err := df.DropNAAxis(2, "any", 0, nil); err == nil {
+			t.Error("expected error for invalid axis")
+		}
+		if _, err := df.DropNAAxis(0, "some", 0, nil); err == nil {
+			t.Error("expected error for invalid how")
+		}
+		if _, err := df.DropNAAxis(0, "any", -1, nil); err == nil {
+			t.Error("expected error for negative thresh")
+		}
+		if _, err := df.DropNAAxis(0, "any", 0, []string{"Missing"}); err == nil {
+			t.Error("expected error for missing column")
+		}
+		if _, err := df.DropNAAxis(1, "any", 0, []string{"missing"}); err == nil {
+			t.Error("expected error for missing index label")
+		}
+	})
+}
+
 func TestIsNANotNA(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -259,3 +473,135 @@ func TestIsNANotNA(t *testing.T) {
 		t.Errorf("NotNA expected [true, false, ...], got [%v, %v, ...]", n0, n1)
 	}
 }
+
+func TestNullMaskAndCounts(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(1.0, nil, 3.0),
+			"B": mustSeries(nil, nil, "x"),
+		},
+		ColumnOrder: []string{"A", "B"},
+		Index:       []string{"r0", "r1", "r2"},
+	}
+
+	mask := df.NullMask()
+	if _, ok := mask.Columns["B"].(*collection.BoolSeries); !ok {
+		t.Fatalf("expected BoolSeries, got %T", mask.Columns["B"])
+	}
+	if v, _ := mask.Columns["B"].At(1); v != true {
+		t.Errorf("expected B[1] null, got %v", v)
+	}
+	if mask.Index[2] != "r2" {
+		t.Errorf("expected index preserved, got %v", mask.Index)
+	}
+
+	cols, err := df.NullCounts(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cols["A"] != 1 || cols["B"] != 2 {
+		t.Errorf("expected A=1 B=2, got %v", cols)
+	}
+	rows, err := df.NullCounts(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rows["r0"] != 1 || rows["r1"] != 2 || rows["r2"] != 0 {
+		t.Errorf("expected r0=1 r1=2 r2=0, got %v", rows)
+	}
+	if _, err := df.NullCounts(2); err == nil {
+		t.Error("expected error for invalid axis")
+	}
+}
+
+func TestInterpolateColumn(t *testing.T) {
+	temps, _ := collection.NewFloat64SeriesFromData([]float64{1, 0, 0, 4, 0}, []bool{false, true, true, false, true})
+	counts, _ := collection.NewInt64SeriesFromData([]int64{10, 0, 20}, []bool{false, true, false})
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"Temp": temps},
+		ColumnOrder: []string{"Temp"},
+		Index:       []string{"0", "1", "2", "3", "4"},
+	}
+
+	cases := []struct {
+		method string
+		limit  int
+		want   []any
+	}{
+		{"linear", 0, []any{1.0, 2.0, 3.0, 4.0, nil}},
+		{"linear", 1, []any{1.0, 2.0, nil, 4.0, nil}},
+		{"nearest", 0, []any{1.0, 1.0, 4.0, 4.0, nil}},
+		{"pad", 0, []any{1.0, 1.0, 1.0, 4.0, 4.0}},
+		{"backfill", 1, []any{1.0, nil, 4.0, 4.0, nil}},
+	}
+	for _, c := range cases {
+		result, err := df.InterpolateColumn("Temp", c.method, c.limit)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.method, err)
+		}
+		got := result.Columns["Temp"].ValuesCopy()
+		for i := range c.want {
+			if got[i] != c.want[i] {
+				t.Errorf("%s limit %d: expected %v, got %v", c.method, c.limit, c.want, got)
+				break
+			}
+		}
+	}
+	if !df.Columns["Temp"].IsNull(1) {
+		t.Error("original DataFrame was mutated")
+	}
+
+	intDF := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"N": counts},
+		ColumnOrder: []string{"N"},
+		Index:       []string{"0", "1", "2"},
+	}
+	result, err := intDF.InterpolateColumn("N", "linear", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, _ := result.Columns["N"].At(1); v != 15.0 {
+		t.Errorf("expected linear int interpolation 15.0, got %v", v)
+	}
+	result, _ = intDF.InterpolateColumn("N", "pad", 0)
+	if v, _ := result.Columns["N"].At(1); v != int64(10) {
+		t.Errorf("expected pad to keep int64, got %v (%T)", v, v)
+	}
+
+	// Values beyond 2^53 must not be rounded through float64.
+	big := int64(1<<53 + 1)
+	bigInts, _ := collection.NewInt64SeriesFromData([]int64{big, 0, 0, big + 2}, []bool{false, true, true, false})
+	bigDF := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"N": bigInts},
+		ColumnOrder: []string{"N"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+	for _, c := range []struct {
+		method string
+		want   []any
+	}{
+		{"pad", []any{big, big, big, big + 2}},
+		{"backfill", []any{big, big + 2, big + 2, big + 2}},
+		{"nearest", []any{big, big, big + 2, big + 2}},
+	} {
+		result, err := bigDF.InterpolateColumn("N", c.method, 0)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.method, err)
+		}
+		if got := result.Columns["N"].ValuesCopy(); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: expected %v, got %v", c.method, c.want, got)
+		}
+	}
+
+	strDF := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"S": mustSeries("a", nil)},
+		ColumnOrder: []string{"S"},
+		Index:       []string{"0", "1"},
+	}
+	if _, err := strDF.InterpolateColumn("S", "pad", 0); err == nil {
+		t.Error("expected error for non-numeric column")
+	}
+	if _, err := df.InterpolateColumn("Temp", "cubic", 0); err == nil {
+		t.Error("expected error for unsupported method")
+	}
+}
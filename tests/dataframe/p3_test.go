@@ -1,7 +1,9 @@
 package dataframe_test
 
 import (
+	"errors"
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -33,6 +35,79 @@ func TestPipe(t *testing.T) {
 	})
 }
 
+func pipelineDouble(d *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+	return d.Apply("V", func(v any) any { return v.(float64) * 2 })
+}
+
+func TestPipeline(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"V": mustSeries(1.0, 2.0, 3.0)},
+		ColumnOrder: []string{"V"},
+		Index:       []string{"0", "1", "2"},
+	}
+	addOne := func(d *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+		return d.Apply("V", func(v any) any { return v.(float64) + 1 })
+	}
+	errBoom := errors.New("boom")
+	fail := func(d *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+		return nil, errBoom
+	}
+
+	p := dataframe.NewPipeline().Step(pipelineDouble).StepNamed("addOne", addOne)
+	if got := p.String(); got != "Pipeline[dataframe_test.pipelineDouble -> addOne]" {
+		t.Errorf("unexpected description %q", got)
+	}
+
+	result, err := p.Run(df)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if v, _ := result.Columns["V"].At(2); !valuesEqual(v, 7.0) {
+		t.Errorf("expected 7.0, got %v", v)
+	}
+
+	t.Run("Then composes pipelines", func(t *testing.T) {
+		combined := p.Then(dataframe.NewPipeline().StepNamed("addOne", addOne))
+		if combined.Len() != 3 || p.Len() != 2 {
+			t.Fatalf("expected 3 and 2 steps, got %d and %d", combined.Len(), p.Len())
+		}
+		result, err := combined.Run(df)
+		if err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if v, _ := result.Columns["V"].At(0); !valuesEqual(v, 4.0) {
+			t.Errorf("expected 4.0, got %v", v)
+		}
+	})
+
+	t.Run("Run stops at first error", func(t *testing.T) {
+		calls := 0
+		count := func(d *dataframe.DataFrame) (*dataframe.DataFrame, error) {
+			calls++
+			return d, nil
+		}
+		_, err := dataframe.NewPipeline().StepNamed("fail", fail).Step(count).Run(df)
+		if !errors.Is(err, errBoom) || !strings.Contains(err.Error(), "fail") {
+			t.Errorf("expected wrapped boom error naming the step, got %v", err)
+		}
+		if calls != 0 {
+			t.Errorf("expected later steps to be skipped, got %d calls", calls)
+		}
+	})
+
+	t.Run("RunAll collects errors", func(t *testing.T) {
+		var p dataframe.Pipeline
+		p.StepNamed("fail1", fail).StepNamed("addOne", addOne).StepNamed("fail2", fail)
+		result, err := p.RunAll(df)
+		if err == nil || !strings.Contains(err.Error(), "fail1") || !strings.Contains(err.Error(), "fail2") {
+			t.Fatalf("expected both errors, got %v", err)
+		}
+		if v, _ := result.Columns["V"].At(0); !valuesEqual(v, 2.0) {
+			t.Errorf("expected successful step to apply, got %v", v)
+		}
+	})
+}
+
 func TestSample(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns:     map[string]collection.Series{"V": mustSeries(1, 2, 3, 4, 5)},
@@ -93,6 +168,45 @@ func TestCorr(t *testing.T) {
 	}
 }
 
+func TestCorrMethodSpearman(t *testing.T) {
+	// Ranks of y are [1 2 3.5 5 3.5]; against x's ranks [1 2 3 4 5] the
+	// Pearson correlation is 8 / sqrt(10 * 9.5).
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"x":     mustSeries(1.0, 2.0, 3.0, 4.0, 5.0),
+			"y":     mustSeries(5.0, 6.0, 7.0, 8.0, 7.0),
+			"cubed": mustSeries(1.0, 8.0, 27.0, 64.0, 125.0),
+		},
+		ColumnOrder: []string{"x", "y", "cubed"},
+		Index:       []string{"0", "1", "2", "3", "4"},
+	}
+	corr, err := df.CorrMethod("spearman", 1)
+	if err != nil {
+		t.Fatalf("CorrMethod failed: %v", err)
+	}
+	xy, _ := corr.Columns["y"].At(0)
+	if math.Abs(xy.(float64)-8/math.Sqrt(95)) > 1e-12 {
+		t.Errorf("spearman(x,y) expected %v, got %v", 8/math.Sqrt(95), xy)
+	}
+	// A monotonic but non-linear relationship has rank correlation 1.
+	xc, _ := corr.Columns["cubed"].At(0)
+	if math.Abs(xc.(float64)-1.0) > 1e-12 {
+		t.Errorf("spearman(x,cubed) expected 1, got %v", xc)
+	}
+	pearson, _ := df.CorrMethod("pearson", 1)
+	if pc, _ := pearson.Columns["cubed"].At(0); pc.(float64) >= 1.0-1e-6 {
+		t.Errorf("pearson(x,cubed) expected < 1, got %v", pc)
+	}
+
+	sparse, _ := df.CorrMethod("spearman", 6)
+	if !sparse.Columns["y"].IsNull(0) {
+		t.Error("expected null when fewer than minPeriods observations")
+	}
+	if _, err := df.CorrMethod("kendall", 1); err == nil {
+		t.Error("expected error for unsupported method")
+	}
+}
+
 func TestCov(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -1,6 +1,7 @@
 package dataframe
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -221,6 +222,88 @@ func TestPivotTable_MultipleValues(t *testing.T) {
 	}
 }
 
+func TestPivotTable_ColumnHierarchy(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(collection.NewStringSeriesFromData([]string{"foo", "foo", "bar"}, nil)),
+			"B": mustSeries(collection.NewStringSeriesFromData([]string{"one", "two", "one"}, nil)),
+			"C": mustSeries(collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)),
+			"D": mustSeries(collection.NewFloat64SeriesFromData([]float64{10, 20, 30}, nil)),
+		},
+		ColumnOrder: []string{"A", "B", "C", "D"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	pivot, err := df.PivotTable(dataframe.PivotTableOptions{
+		Index:   []string{"A"},
+		Columns: "B",
+		Values:  []string{"C", "D"},
+		AggFunc: dataframe.AggSum,
+	})
+	if err != nil {
+		t.Fatalf("PivotTable failed: %v", err)
+	}
+
+	value, colVal, ok := pivot.LevelsOf("D_two")
+	if !ok || value != "D" || colVal != "two" {
+		t.Errorf("LevelsOf(D_two) = %q, %q, %v", value, colVal, ok)
+	}
+	if _, _, ok := pivot.LevelsOf("A"); ok {
+		t.Error("expected index column A to have no levels")
+	}
+
+	flat := pivot.FlattenColumns(".")
+	expected := []string{"A", "C.one", "C.two", "D.one", "D.two"}
+	if len(flat.ColumnOrder) != len(expected) {
+		t.Fatalf("expected columns %v, got %v", expected, flat.ColumnOrder)
+	}
+	for i, col := range expected {
+		if flat.ColumnOrder[i] != col {
+			t.Errorf("column %d: expected %s, got %s", i, col, flat.ColumnOrder[i])
+		}
+		if _, ok := flat.Columns[col]; !ok {
+			t.Errorf("column %s missing from Columns", col)
+		}
+	}
+	if flat.ColumnHierarchy != nil {
+		t.Error("expected flattened DataFrame to have no hierarchy")
+	}
+	if _, ok := pivot.Columns["C_one"]; !ok {
+		t.Error("original pivot was modified")
+	}
+
+	back := flat.UnflattenColumns(".", []string{"C", "D"})
+	if !reflect.DeepEqual(back.ColumnOrder, pivot.ColumnOrder) {
+		t.Errorf("expected unflattened columns %v, got %v", pivot.ColumnOrder, back.ColumnOrder)
+	}
+	if !reflect.DeepEqual(back.ColumnHierarchy, pivot.ColumnHierarchy) {
+		t.Errorf("expected hierarchy %v, got %v", pivot.ColumnHierarchy, back.ColumnHierarchy)
+	}
+	if value, colVal, ok := back.LevelsOf("D_one"); !ok || value != "D" || colVal != "one" {
+		t.Errorf("LevelsOf(D_one) after unflatten = %q, %q, %v", value, colVal, ok)
+	}
+	if flat.ColumnHierarchy != nil {
+		t.Error("UnflattenColumns modified its receiver")
+	}
+	if aliased := pivot.FlattenIndex("."); !reflect.DeepEqual(aliased.ColumnOrder, flat.ColumnOrder) {
+		t.Errorf("expected FlattenIndex to flatten columns to %v, got %v", flat.ColumnOrder, aliased.ColumnOrder)
+	}
+	if aliased := flat.UnflattenIndex(".", []string{"C", "D"}); !reflect.DeepEqual(aliased.ColumnHierarchy, pivot.ColumnHierarchy) {
+		t.Errorf("expected UnflattenIndex to restore hierarchy %v, got %v", pivot.ColumnHierarchy, aliased.ColumnHierarchy)
+	}
+	split := flat.UnflattenColumns(".", nil)
+	if !reflect.DeepEqual(split.ColumnOrder, pivot.ColumnOrder) {
+		t.Errorf("expected columns %v when splitting at sep, got %v", pivot.ColumnOrder, split.ColumnOrder)
+	}
+
+	single, _ := df.PivotTable(dataframe.PivotTableOptions{
+		Index: []string{"A"}, Columns: "B", Values: []string{"C"}, AggFunc: dataframe.AggSum,
+	})
+	if single.ColumnHierarchy != nil {
+		t.Error("expected no hierarchy for a single Values column")
+	}
+}
+
 func TestPivotTable_WithFillValue(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -305,6 +388,183 @@ func TestPivotTable_MinMax(t *testing.T) {
 	}
 }
 
+func TestPivotTable_CustomAggFunc(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(collection.NewStringSeriesFromData([]string{"foo", "foo", "bar"}, nil)),
+			"B": mustSeries(collection.NewStringSeriesFromData([]string{"one", "one", "one"}, nil)),
+			"C": mustSeries(collection.NewFloat64SeriesFromData([]float64{2, 8, 5}, nil)),
+		},
+		ColumnOrder: []string{"A", "B", "C"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	product := func(values []float64) float64 {
+		p := 1.0
+		for _, v := range values {
+			p *= v
+		}
+		return p
+	}
+	pivot, err := df.PivotTable(dataframe.PivotTableOptions{
+		Index:         []string{"A"},
+		Columns:       "B",
+		Values:        []string{"C"},
+		CustomAggFunc: product,
+	})
+	if err != nil {
+		t.Fatalf("PivotTable failed: %v", err)
+	}
+	oneCol, _ := pivot.SelectCol("one")
+	if v, _ := oneCol.At(1); v != 16.0 {
+		t.Errorf("Expected foo/one = 16 (2*8), got %v", v)
+	}
+
+	_, err = df.PivotTable(dataframe.PivotTableOptions{
+		Index:         []string{"A"},
+		Columns:       "B",
+		Values:        []string{"C"},
+		AggFunc:       dataframe.AggSum,
+		CustomAggFunc: product,
+	})
+	if err == nil {
+		t.Error("Expected error when both AggFunc and CustomAggFunc are set")
+	}
+}
+
+func TestPivotTable_MultiIndex(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Region":  mustSeries(collection.NewStringSeriesFromData([]string{"east", "east", "west", "east"}, nil)),
+			"Year":    mustSeries(collection.NewStringSeriesFromData([]string{"2023", "2024", "2023", "2023"}, nil)),
+			"Product": mustSeries(collection.NewStringSeriesFromData([]string{"a", "a", "b", "b"}, nil)),
+			"Sales":   mustSeries(collection.NewFloat64SeriesFromData([]float64{1, 2, 3, 4}, nil)),
+		},
+		ColumnOrder: []string{"Region", "Year", "Product", "Sales"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+
+	pivot, err := df.PivotTable(dataframe.PivotTableOptions{
+		Index:   []string{"Region", "Year"},
+		Columns: "Product",
+		Values:  []string{"Sales"},
+		AggFunc: dataframe.AggSum,
+	})
+	if err != nil {
+		t.Fatalf("PivotTable failed: %v", err)
+	}
+	expectedIndex := []string{"east\x002023", "east\x002024", "west\x002023"}
+	if !reflect.DeepEqual(pivot.Index, expectedIndex) {
+		t.Errorf("Expected index %q, got %q", expectedIndex, pivot.Index)
+	}
+	regions, err := pivot.IndexLevel(0)
+	if err != nil || !reflect.DeepEqual(regions, []string{"east", "east", "west"}) {
+		t.Errorf("Unexpected level 0: %v (err %v)", regions, err)
+	}
+	years, _ := pivot.IndexLevel(1)
+	if !reflect.DeepEqual(years, []string{"2023", "2024", "2023"}) {
+		t.Errorf("Unexpected level 1: %v", years)
+	}
+	if _, err := pivot.IndexLevel(2); err == nil {
+		t.Error("Expected error for out-of-range level")
+	}
+
+	flat := pivot.FlattenRowIndex("/")
+	if !reflect.DeepEqual(flat.Index, []string{"east/2023", "east/2024", "west/2023"}) {
+		t.Errorf("Unexpected flattened index %q", flat.Index)
+	}
+	if flat.MultiIndex != nil {
+		t.Error("Expected no MultiIndex after FlattenRowIndex")
+	}
+	if pivot.MultiIndex == nil {
+		t.Error("FlattenRowIndex modified its receiver")
+	}
+	back := flat.UnflattenRowIndex("/", []string{"Region", "Year"})
+	if !reflect.DeepEqual(back.MultiIndex, pivot.MultiIndex) {
+		t.Errorf("Expected levels %v after UnflattenRowIndex, got %v", pivot.MultiIndex, back.MultiIndex)
+	}
+	if back.IndexName != "Region/Year" {
+		t.Errorf("Expected index name Region/Year, got %q", back.IndexName)
+	}
+	ragged := (&dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"V": mustSeries(collection.NewInt64SeriesFromData([]int64{1, 2}, nil))},
+		ColumnOrder: []string{"V"},
+		Index:       []string{"a/b/c", "d"},
+	}).UnflattenRowIndex("/", nil)
+	if !reflect.DeepEqual(ragged.MultiIndex, [][]string{{"a", "b", "c"}, {"d", "", ""}}) {
+		t.Errorf("Unexpected ragged levels %v", ragged.MultiIndex)
+	}
+
+	single, _ := df.PivotTable(dataframe.PivotTableOptions{
+		Index: []string{"Region"}, Columns: "Product", Values: []string{"Sales"},
+	})
+	if single.MultiIndex != nil {
+		t.Error("Expected no MultiIndex for a single Index column")
+	}
+	if level, _ := single.IndexLevel(0); !reflect.DeepEqual(level, single.Index) {
+		t.Errorf("Expected level 0 of a flat index to equal Index, got %v", level)
+	}
+}
+
+func TestPivotTable_Margins(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(collection.NewStringSeriesFromData([]string{"foo", "foo", "bar", "bar"}, nil)),
+			"B": mustSeries(collection.NewStringSeriesFromData([]string{"one", "two", "one", "one"}, nil)),
+			"C": mustSeries(collection.NewFloat64SeriesFromData([]float64{1, 2, 3, 5}, nil)),
+		},
+		ColumnOrder: []string{"A", "B", "C"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+
+	pivot, err := df.PivotTable(dataframe.PivotTableOptions{
+		Index:   []string{"A"},
+		Columns: "B",
+		Values:  []string{"C"},
+		AggFunc: dataframe.AggSum,
+		Margins: true,
+	})
+	if err != nil {
+		t.Fatalf("PivotTable failed: %v", err)
+	}
+	if !reflect.DeepEqual(pivot.ColumnOrder, []string{"A", "one", "two", "All"}) {
+		t.Fatalf("Expected columns [A one two All], got %v", pivot.ColumnOrder)
+	}
+	if !reflect.DeepEqual(pivot.Index, []string{"0", "1", "All"}) {
+		t.Errorf("Expected index [0 1 All], got %v", pivot.Index)
+	}
+	// Rows are bar, foo, All.
+	wantAll := []any{8.0, 3.0, 11.0}
+	if got := pivot.Columns["All"].ValuesCopy(); !reflect.DeepEqual(got, wantAll) {
+		t.Errorf("Expected row totals %v, got %v", wantAll, got)
+	}
+	wantOne := []any{8.0, 1.0, 9.0}
+	if got := pivot.Columns["one"].ValuesCopy(); !reflect.DeepEqual(got, wantOne) {
+		t.Errorf("Expected one column %v, got %v", wantOne, got)
+	}
+	if v, _ := pivot.Columns["A"].At(2); v != "All" {
+		t.Errorf("Expected margins row label All, got %v", v)
+	}
+
+	// Mean margins aggregate the raw values, not the cell means.
+	mean, err := df.PivotTable(dataframe.PivotTableOptions{
+		Index:       []string{"A"},
+		Columns:     "B",
+		Values:      []string{"C"},
+		Margins:     true,
+		MarginsName: "Total",
+	})
+	if err != nil {
+		t.Fatalf("PivotTable failed: %v", err)
+	}
+	if v, _ := mean.Columns["Total"].At(2); v != 2.75 {
+		t.Errorf("Expected grand mean 2.75, got %v", v)
+	}
+	if v, _ := mean.Columns["one"].At(2); v != 3.0 {
+		t.Errorf("Expected one column mean 3, got %v", v)
+	}
+}
+
 func TestPivotTable_ErrorCases(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -416,6 +676,36 @@ func TestMelt_Basic(t *testing.T) {
 	}
 }
 
+func TestMelt_IdVarsKeepColumnOrder(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Name":  mustSeries(collection.NewStringSeriesFromData([]string{"Alice", "Bob"}, nil)),
+			"Class": mustSeries(collection.NewStringSeriesFromData([]string{"A", "B"}, nil)),
+			"Math":  mustSeries(collection.NewFloat64SeriesFromData([]float64{90, 80}, nil)),
+		},
+		ColumnOrder: []string{"Name", "Class", "Math"},
+		Index:       []string{"0", "1"},
+	}
+
+	melted, err := df.Melt(dataframe.MeltOptions{
+		IdVars: []string{"Class", "Name"},
+	})
+	if err != nil {
+		t.Fatalf("Melt failed: %v", err)
+	}
+
+	expectedCols := []string{"Name", "Class", "variable", "value"}
+	if !reflect.DeepEqual(melted.ColumnOrder, expectedCols) {
+		t.Errorf("Expected columns %v, got %v", expectedCols, melted.ColumnOrder)
+	}
+	if name, _ := melted.Columns["Name"].At(1); name != "Bob" {
+		t.Errorf("Expected Name 'Bob' in row 1, got %v", name)
+	}
+	if class, _ := melted.Columns["Class"].At(1); class != "B" {
+		t.Errorf("Expected Class 'B' in row 1, got %v", class)
+	}
+}
+
 func TestMelt_WithValueVars(t *testing.T) {
 	df := &dataframe.DataFrame{
 		Columns: map[string]collection.Series{
@@ -566,3 +856,130 @@ func TestMelt_WithNulls(t *testing.T) {
 		t.Error("Expected null value to be preserved for Bob's Math score")
 	}
 }
+
+func TestMelt_DropNaAndIgnoreIndex(t *testing.T) {
+	mathSeries, _ := collection.NewFloat64SeriesFromData([]float64{90, 0}, []bool{false, true})
+	sciSeries, _ := collection.NewFloat64SeriesFromData([]float64{85, 75}, nil)
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Name":    mustSeries(collection.NewStringSeriesFromData([]string{"Alice", "Bob"}, nil)),
+			"Math":    mathSeries,
+			"Science": sciSeries,
+		},
+		ColumnOrder: []string{"Name", "Math", "Science"},
+		Index:       []string{"a", "b"},
+	}
+
+	melted, err := df.Melt(dataframe.MeltOptions{IdVars: []string{"Name"}, DropNa: true})
+	if err != nil {
+		t.Fatalf("Melt failed: %v", err)
+	}
+	if melted.Len() != 3 {
+		t.Fatalf("expected 3 rows after dropping nulls, got %d", melted.Len())
+	}
+	if melted.Columns["value"].NullCount() != 0 {
+		t.Error("expected no null values")
+	}
+	if !reflect.DeepEqual(melted.Index, []string{"a", "a", "b"}) {
+		t.Errorf("expected source labels to repeat, got %v", melted.Index)
+	}
+	if name, _ := melted.Columns["Name"].At(2); name != "Bob" {
+		t.Errorf("expected last row to be Bob, got %v", name)
+	}
+
+	melted, err = df.Melt(dataframe.MeltOptions{IdVars: []string{"Name"}, IgnoreIndex: true})
+	if err != nil {
+		t.Fatalf("Melt failed: %v", err)
+	}
+	if !reflect.DeepEqual(melted.Index, []string{"0", "1", "2", "3"}) {
+		t.Errorf("expected reset index, got %v", melted.Index)
+	}
+}
+
+func TestCrossTabulate(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(collection.NewStringSeriesFromData([]string{"foo", "foo", "foo", "bar", "bar", "bar"}, []bool{false, false, false, false, false, true})),
+			"B": mustSeries(collection.NewStringSeriesFromData([]string{"one", "one", "two", "one", "two", "two"}, nil)),
+		},
+		ColumnOrder: []string{"A", "B"},
+		Index:       []string{"0", "1", "2", "3", "4", "5"},
+	}
+
+	table, err := df.CrossTabulate("A", "B", "", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(table.Index, []string{"bar", "foo", "All"}) || !reflect.DeepEqual(table.ColumnOrder, []string{"one", "two", "All"}) {
+		t.Fatalf("unexpected labels: index %v, columns %v", table.Index, table.ColumnOrder)
+	}
+	if table.IndexName != "A" || table.ColumnsName != "B" {
+		t.Errorf("unexpected axis names %q, %q", table.IndexName, table.ColumnsName)
+	}
+	expected := map[string][]any{
+		"one": {int64(1), int64(2), int64(3)},
+		"two": {int64(1), int64(1), int64(2)},
+		"All": {int64(2), int64(3), int64(5)},
+	}
+	for col, want := range expected {
+		got := table.Columns[col].ValuesCopy()
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("column %s: expected %v, got %v", col, want, got)
+				break
+			}
+		}
+	}
+
+	norm, err := df.CrossTabulate("A", "B", "index", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v, _ := norm.Columns["one"].At(1); v != 2.0/3.0 {
+		t.Errorf("expected foo/one = 2/3, got %v", v)
+	}
+	if v, _ := norm.Columns["All"].At(1); v != 1.0 {
+		t.Errorf("expected foo/All = 1, got %v", v)
+	}
+	if v, _ := norm.Columns["one"].At(2); v != 0.6 {
+		t.Errorf("expected All/one = 0.6, got %v", v)
+	}
+
+	if _, err := df.CrossTabulate("A", "B", "rows", false); err == nil {
+		t.Error("expected error for invalid normalize")
+	}
+	if _, err := df.CrossTabulate("A", "Z", "", false); err == nil {
+		t.Error("expected error for missing column")
+	}
+
+	numeric := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"N": mustSeries(collection.NewInt64SeriesFromData([]int64{10, 2, 1, 10}, nil)),
+			"M": mustSeries(collection.NewFloat64SeriesFromData([]float64{2.5, 10, 2.5, 1}, nil)),
+		},
+		ColumnOrder: []string{"N", "M"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+	table, err = numeric.CrossTabulate("N", "M", "", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(table.Index, []string{"1", "2", "10"}) || !reflect.DeepEqual(table.ColumnOrder, []string{"1", "2.5", "10"}) {
+		t.Errorf("expected numeric order, got index %v, columns %v", table.Index, table.ColumnOrder)
+	}
+
+	clash := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"A": mustSeries(collection.NewStringSeriesFromData([]string{"All", "x"}, nil)),
+			"B": mustSeries(collection.NewStringSeriesFromData([]string{"one", "two"}, nil)),
+		},
+		ColumnOrder: []string{"A", "B"},
+		Index:       []string{"0", "1"},
+	}
+	if _, err := clash.CrossTabulate("A", "B", "", true); err == nil {
+		t.Error("expected error for a row value clashing with the margins row")
+	}
+	if _, err := clash.CrossTabulate("A", "B", "", false); err != nil {
+		t.Errorf("unexpected error without margins: %v", err)
+	}
+}
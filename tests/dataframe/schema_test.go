@@ -0,0 +1,59 @@
+package dataframe_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestValidate(t *testing.T) {
+	age, _ := collection.NewInt64SeriesFromData([]int64{30, 200, 0}, []bool{false, false, true})
+	status, _ := collection.NewStringSeriesFromData([]string{"active", "gone", "inactive"}, nil)
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"age": age, "status": status},
+		ColumnOrder: []string{"age", "status"},
+		Index:       []string{"a", "b", "c"},
+	}
+
+	t.Run("reports every violation", func(t *testing.T) {
+		schema := dataframe.Schema{Columns: []dataframe.ColumnSchema{
+			{Name: "age", DType: reflect.TypeOf(int64(0)), MinVal: 0, MaxVal: 150.0},
+			{Name: "status", DType: reflect.TypeOf(""), Nullable: true, AllowedValues: []any{"active", "inactive"}},
+			{Name: "email", DType: reflect.TypeOf("")},
+		}}
+		errs := df.Validate(schema)
+		if len(errs) != 4 {
+			t.Fatalf("expected 4 violations, got %d: %v", len(errs), errs)
+		}
+		expected := []struct {
+			column string
+			index  string
+		}{{"age", "b"}, {"age", "c"}, {"status", "b"}, {"email", ""}}
+		for i, e := range expected {
+			if errs[i].Column != e.column || errs[i].Index != e.index {
+				t.Errorf("violation %d: expected %s/%s, got %+v", i, e.column, e.index, errs[i])
+			}
+		}
+	})
+
+	t.Run("wrong dtype", func(t *testing.T) {
+		schema := dataframe.Schema{Columns: []dataframe.ColumnSchema{
+			{Name: "status", DType: reflect.TypeOf(float64(0)), Nullable: true},
+		}}
+		errs := df.Validate(schema)
+		if len(errs) != 1 || errs[0].Row != -1 {
+			t.Errorf("expected one column-level violation, got %v", errs)
+		}
+	})
+
+	t.Run("conforming frame", func(t *testing.T) {
+		schema := dataframe.Schema{Columns: []dataframe.ColumnSchema{
+			{Name: "status", DType: reflect.TypeOf("")},
+		}}
+		if errs := df.Validate(schema); len(errs) != 0 {
+			t.Errorf("expected no violations, got %v", errs)
+		}
+	})
+}
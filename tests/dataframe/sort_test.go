@@ -2,7 +2,9 @@ package dataframe_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas/dataframe"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -392,6 +394,72 @@ func TestSortValuesStableSort(t *testing.T) {
 	})
 }
 
+// TestSortValuesTypedColumns tests sorting typed Series, including NaN handling.
+func TestSortValuesTypedColumns(t *testing.T) {
+	t.Run("int32 sorts numerically", func(t *testing.T) {
+		ints, _ := collection.NewInt32SeriesFromData([]int32{10, 9, 100}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"N": ints},
+			ColumnOrder: []string{"N"},
+			Index:       []string{"a", "b", "c"},
+		}
+		result, err := df.SortValues(dataframe.SortOptions{By: []string{"N"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"b", "a", "c"}) {
+			t.Errorf("expected index [b a c], got %v", result.Index)
+		}
+	})
+
+	t.Run("datetime sorts chronologically", func(t *testing.T) {
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		times, _ := collection.NewDateTimeSeriesFromData([]time.Time{
+			base.AddDate(0, 0, 2),
+			base.In(time.FixedZone("X", 5*3600)),
+			base.AddDate(0, 0, 1),
+		}, nil)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"T": times},
+			ColumnOrder: []string{"T"},
+			Index:       []string{"a", "b", "c"},
+		}
+		result, err := df.SortValues(dataframe.SortOptions{By: []string{"T"}, Ascending: []bool{false}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"a", "c", "b"}) {
+			t.Errorf("expected index [a c b], got %v", result.Index)
+		}
+	})
+
+	t.Run("NaN is placed with nulls", func(t *testing.T) {
+		floats, _ := collection.NewFloat64SeriesFromData(
+			[]float64{3, math.NaN(), 1, 0, 2},
+			[]bool{false, false, false, true, false},
+		)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"F": floats},
+			ColumnOrder: []string{"F"},
+			Index:       []string{"a", "b", "c", "d", "e"},
+		}
+		last, err := df.SortValues(dataframe.SortOptions{By: []string{"F"}})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(last.Index, []string{"c", "e", "a", "b", "d"}) {
+			t.Errorf("NaLast: expected index [c e a b d], got %v", last.Index)
+		}
+		first, err := df.SortValues(dataframe.SortOptions{By: []string{"F"}, NaPosition: dataframe.NaFirst})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(first.Index, []string{"b", "d", "c", "e", "a"}) {
+			t.Errorf("NaFirst: expected index [b d c e a], got %v", first.Index)
+		}
+	})
+}
+
 // TestSortValuesSingleAscendingForMultipleColumns tests broadcast of single ascending value.
 func TestSortValuesSingleAscendingForMultipleColumns(t *testing.T) {
 	df := &dataframe.DataFrame{
@@ -699,6 +767,47 @@ func TestSortValuesLargeDataset(t *testing.T) {
 	}
 }
 
+// TestArgSort tests the sort permutation used by SortValues.
+func TestArgSort(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"Dept":   mustSeries("B", "A", "B", "A", "A"),
+			"Salary": mustSeries(10, 30, 20, 30, nil),
+		},
+		ColumnOrder: []string{"Dept", "Salary"},
+		Index:       []string{"a", "b", "c", "d", "e"},
+	}
+
+	order, err := df.ArgSort(dataframe.SortOptions{
+		By:        []string{"Dept", "Salary"},
+		Ascending: []bool{true, false},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A: 30 (b), 30 (d, stable after b), null (e); B: 20 (c), 10 (a)
+	expected := []int{1, 3, 4, 2, 0}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, order)
+		}
+	}
+
+	// Applying the permutation matches SortValues.
+	sorted, _ := df.SortValues(dataframe.SortOptions{
+		By:        []string{"Dept", "Salary"},
+		Ascending: []bool{true, false},
+	})
+	sliced, _ := df.Slice(order)
+	if !strSliceEqual(sorted.Index, sliced.Index) {
+		t.Errorf("SortValues index %v != Slice(ArgSort) index %v", sorted.Index, sliced.Index)
+	}
+
+	if _, err := df.ArgSort(dataframe.SortOptions{By: []string{"Missing"}}); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
+
 // valuesEqual compares values accounting for type differences (int vs int64 vs float64).
 func valuesEqual(a, b any) bool {
 	if a == b {
@@ -2,6 +2,7 @@ package dataframe_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas/dataframe"
 	"github.com/apoplexi24/gpandas/utils/collection"
@@ -142,3 +143,95 @@ func TestDropDuplicates(t *testing.T) {
 		}
 	})
 }
+
+func TestDropDuplicatesKeyCollisions(t *testing.T) {
+	t.Run("values of different types differ", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"A": mustSeries(int64(1), "1", int64(1))},
+			ColumnOrder: []string{"A"},
+			Index:       []string{"0", "1", "2"},
+		}
+		result, err := df.DropDuplicates(nil, "first")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"0", "1"}) {
+			t.Errorf("expected index [0 1], got %v", result.Index)
+		}
+	})
+
+	t.Run("string values do not spill into the next column", func(t *testing.T) {
+		df := &dataframe.DataFrame{
+			Columns: map[string]collection.Series{
+				"A": mustSeries("a\x01b", "a"),
+				"B": mustSeries("c", "b\x01c"),
+			},
+			ColumnOrder: []string{"A", "B"},
+			Index:       []string{"0", "1"},
+		}
+		result, err := df.DropDuplicates(nil, "first")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.Len() != 2 {
+			t.Errorf("expected 2 rows, got %d", result.Len())
+		}
+	})
+
+	t.Run("nulls match and equal instants match", func(t *testing.T) {
+		base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		times, _ := collection.NewDateTimeSeriesFromData(
+			[]time.Time{base, base.In(time.FixedZone("X", 3600)), {}, {}},
+			[]bool{false, false, true, true},
+		)
+		df := &dataframe.DataFrame{
+			Columns:     map[string]collection.Series{"T": times},
+			ColumnOrder: []string{"T"},
+			Index:       []string{"0", "1", "2", "3"},
+		}
+		result, err := df.DropDuplicates(nil, "last")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !strSliceEqual(result.Index, []string{"1", "3"}) {
+			t.Errorf("expected index [1 3], got %v", result.Index)
+		}
+	})
+}
+
+func TestDuplicateIndexLabels(t *testing.T) {
+	df := &dataframe.DataFrame{
+		Columns: map[string]collection.Series{
+			"V": mustSeries(1, 2, 3, 4, 5),
+		},
+		ColumnOrder: []string{"V"},
+		Index:       []string{"a", "b", "a", "c", "a"},
+	}
+
+	if !df.HasDuplicateIndex() {
+		t.Error("expected duplicate index")
+	}
+	if dups := df.DuplicateIndexLabels(); !strSliceEqual(dups, []string{"a"}) {
+		t.Errorf("expected [a], got %v", dups)
+	}
+
+	v, err := df.Loc().At("a", "V")
+	if err != nil || !valuesEqual(v, 1) {
+		t.Errorf("expected At to return the first match 1, got %v (%v)", v, err)
+	}
+	rows, err := df.Loc().Row("a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rows.Len() != 3 || !strSliceEqual(rows.Index, []string{"a", "a", "a"}) {
+		t.Errorf("expected 3 rows labelled a, got %d rows %v", rows.Len(), rows.Index)
+	}
+	if v, _ := rows.Columns["V"].At(2); !valuesEqual(v, 5) {
+		t.Errorf("expected last matching value 5, got %v", v)
+	}
+
+	df.Index = []string{"a", "b", "c", "d", "e"}
+	if df.HasDuplicateIndex() || df.DuplicateIndexLabels() != nil {
+		t.Error("expected unique index")
+	}
+}
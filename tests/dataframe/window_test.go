@@ -1,6 +1,7 @@
 package dataframe_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/apoplexi24/gpandas/dataframe"
@@ -51,6 +52,139 @@ func TestRollingInvalidWindow(t *testing.T) {
 	}
 }
 
+func TestRollingMinPeriodsAndStd(t *testing.T) {
+	a, _ := collection.NewFloat64SeriesFromData([]float64{1, 0, 3, 5}, []bool{false, true, false, false})
+	b, _ := collection.NewInt64SeriesFromData([]int64{2, 4, 6, 8}, nil)
+	names, _ := collection.NewStringSeriesFromData([]string{"w", "x", "y", "z"}, nil)
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": a, "B": b, "Name": names},
+		ColumnOrder: []string{"A", "B", "Name"},
+		Index:       []string{"0", "1", "2", "3"},
+	}
+
+	sums, err := df.Rolling(2).MinPeriods(1).Sum()
+	if err != nil {
+		t.Fatalf("Rolling.MinPeriods.Sum failed: %v", err)
+	}
+	// A: [1] [1,null] [null,3] [3,5]
+	wantA := []any{1.0, 1.0, 3.0, 8.0}
+	for i, want := range wantA {
+		if got, _ := sums.Columns["A"].At(i); !valuesEqual(got, want) {
+			t.Errorf("A[%d]: expected %v, got %v", i, want, got)
+		}
+	}
+	if sums.Columns["Name"] != names {
+		t.Error("expected non-numeric column to be carried over unchanged")
+	}
+
+	full, _ := df.Rolling(2).Sum()
+	if !full.Columns["A"].IsNull(1) || !full.Columns["A"].IsNull(2) {
+		t.Error("expected windows containing a null to be null without MinPeriods")
+	}
+
+	stds, err := df.Rolling(2).Std(0)
+	if err != nil {
+		t.Fatalf("Rolling.Std failed: %v", err)
+	}
+	// Population std of [2,4] is 1
+	if got, _ := stds.Columns["B"].At(1); !valuesEqual(got, 1.0) {
+		t.Errorf("expected population std 1, got %v", got)
+	}
+	sample, _ := df.Rolling(2).Std()
+	if got, _ := sample.Columns["B"].At(1); math.Abs(got.(float64)-math.Sqrt2) > 1e-9 {
+		t.Errorf("expected sample std sqrt(2), got %v", got)
+	}
+
+	if _, err := df.Rolling(2).MinPeriods(3).Mean(); err == nil {
+		t.Error("expected error for min periods larger than the window")
+	}
+	if _, err := df.Rolling(2).Std(-1); err == nil {
+		t.Error("expected error for negative ddof")
+	}
+}
+
+func TestPctChange(t *testing.T) {
+	price, _ := collection.NewInt64SeriesFromData([]int64{10, 12, 6}, nil)
+	volume, _ := collection.NewFloat64SeriesFromData([]float64{1, 2, 4}, nil)
+	names, _ := collection.NewStringSeriesFromData([]string{"a", "b", "c"}, nil)
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"Price": price, "Volume": volume, "Name": names},
+		ColumnOrder: []string{"Price", "Volume", "Name"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	all, err := df.PctChange(1, nil)
+	if err != nil {
+		t.Fatalf("PctChange failed: %v", err)
+	}
+	if !all.Columns["Price"].IsNull(0) {
+		t.Error("expected first change to be null")
+	}
+	if v, _ := all.Columns["Price"].At(1); !valuesEqual(v, 0.2) {
+		t.Errorf("expected Price change 0.2, got %v", v)
+	}
+	if v, _ := all.Columns["Price"].At(2); !valuesEqual(v, -0.5) {
+		t.Errorf("expected Price change -0.5, got %v", v)
+	}
+	if v, _ := all.Columns["Volume"].At(2); !valuesEqual(v, 1.0) {
+		t.Errorf("expected Volume change 1, got %v", v)
+	}
+	if all.Columns["Name"] != names {
+		t.Error("expected non-numeric column to be carried over")
+	}
+
+	some, err := df.PctChange(1, []string{"Volume"})
+	if err != nil {
+		t.Fatalf("PctChange failed: %v", err)
+	}
+	if some.Columns["Price"] != price {
+		t.Error("expected unselected column to be carried over")
+	}
+
+	if _, err := df.PctChange(1, []string{"Name"}); err == nil {
+		t.Error("expected error for non-numeric column")
+	}
+	if _, err := df.PctChange(1, []string{"Missing"}); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
+
+func TestEWM(t *testing.T) {
+	names, _ := collection.NewStringSeriesFromData([]string{"a", "b", "c"}, nil)
+	ints, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3}, nil)
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"N": ints, "Name": names},
+		ColumnOrder: []string{"N", "Name"},
+		Index:       []string{"0", "1", "2"},
+	}
+
+	mean, err := df.EWM(0.5, false, 0).Mean()
+	if err != nil {
+		t.Fatalf("EWM Mean failed: %v", err)
+	}
+	if v, _ := mean.Columns["N"].At(2); !valuesEqual(v, 2.25) {
+		t.Errorf("expected recursive mean 2.25, got %v", v)
+	}
+	if mean.Columns["Name"] != names {
+		t.Error("expected non-numeric column to be carried over")
+	}
+
+	std, err := df.EWM(0.5, true, 2).Std()
+	if err != nil {
+		t.Fatalf("EWM Std failed: %v", err)
+	}
+	if !std.Columns["N"].IsNull(0) {
+		t.Error("expected null before minPeriods observations")
+	}
+	if v, _ := std.Columns["N"].At(1); math.Abs(v.(float64)-math.Sqrt(0.5)) > 1e-9 {
+		t.Errorf("expected std sqrt(0.5), got %v", v)
+	}
+
+	if _, err := df.EWM(2, true, 0).Var(); err == nil {
+		t.Error("expected error for alpha above 1")
+	}
+}
+
 func TestShift(t *testing.T) {
 	t.Run("shift down by 1", func(t *testing.T) {
 		result, err := windowDF().Shift(1)
@@ -0,0 +1,126 @@
+package gpandas_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/apoplexi24/gpandas"
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestFeatherRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.feather")
+
+	name, _ := collection.NewStringSeriesFromData([]string{"Alice", ""}, []bool{false, true})
+	age, _ := collection.NewInt64SeriesFromData([]int64{30, 25}, nil)
+	score, _ := collection.NewFloat64SeriesFromData([]float64{9.5, 0}, []bool{false, true})
+	active, _ := collection.NewBoolSeriesFromData([]bool{true, false}, nil)
+	when := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	seen, _ := collection.NewDateTimeSeriesFromData([]time.Time{when, when.Add(time.Hour)}, nil)
+
+	df, err := gpandas.NewDataFrameFromSeries(map[string]collection.Series{
+		"name": name, "age": age, "score": score, "active": active, "seen": seen,
+	}, []string{"name", "age", "score", "active", "seen"})
+	if err != nil {
+		t.Fatalf("NewDataFrameFromSeries failed: %v", err)
+	}
+
+	if err := df.ToFeather(path); err != nil {
+		t.Fatalf("ToFeather failed: %v", err)
+	}
+
+	gp := gpandas.GoPandas{}
+	loaded, err := gp.Read_feather(path)
+	if err != nil {
+		t.Fatalf("Read_feather failed: %v", err)
+	}
+
+	if loaded.Len() != 2 {
+		t.Fatalf("expected 2 rows, got %d", loaded.Len())
+	}
+	for i, want := range df.ColumnOrder {
+		if loaded.ColumnOrder[i] != want {
+			t.Fatalf("expected column order %v, got %v", df.ColumnOrder, loaded.ColumnOrder)
+		}
+		if loaded.Columns[want].DType() != df.Columns[want].DType() {
+			t.Errorf("column %s: expected dtype %v, got %v", want, df.Columns[want].DType(), loaded.Columns[want].DType())
+		}
+	}
+	if !loaded.Columns["name"].IsNull(1) || !loaded.Columns["score"].IsNull(1) {
+		t.Error("expected nulls to be preserved")
+	}
+	if v, _ := loaded.Columns["seen"].At(0); !v.(time.Time).Equal(when) {
+		t.Errorf("expected %v, got %v", when, v)
+	}
+	if v, _ := loaded.Columns["active"].At(1); v != false {
+		t.Errorf("expected false, got %v", v)
+	}
+}
+
+// benchmarkFrame builds a mixed-type DataFrame with n rows.
+func benchmarkFrame(n int) *dataframe.DataFrame {
+	ids := make([]int64, n)
+	vals := make([]float64, n)
+	names := make([]string, n)
+	for i := 0; i < n; i++ {
+		ids[i] = int64(i)
+		vals[i] = float64(i) * 1.5
+		names[i] = fmt.Sprintf("name_%d", i%100)
+	}
+	idS, _ := collection.NewInt64SeriesFromData(ids, nil)
+	valS, _ := collection.NewFloat64SeriesFromData(vals, nil)
+	nameS, _ := collection.NewStringSeriesFromData(names, nil)
+	df, _ := gpandas.NewDataFrameFromSeries(map[string]collection.Series{
+		"id": idS, "value": valS, "name": nameS,
+	}, []string{"id", "value", "name"})
+	return df
+}
+
+func BenchmarkReadFormats(b *testing.B) {
+	dir, err := os.MkdirTemp("", "gpandas_bench")
+	if err != nil {
+		b.Fatalf("temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	df := benchmarkFrame(50000)
+	csvPath := filepath.Join(dir, "data.csv")
+	parquetPath := filepath.Join(dir, "data.parquet")
+	featherPath := filepath.Join(dir, "data.feather")
+	if _, err := df.ToCSV(csvPath); err != nil {
+		b.Fatalf("ToCSV: %v", err)
+	}
+	if err := df.ToParquet(parquetPath); err != nil {
+		b.Fatalf("ToParquet: %v", err)
+	}
+	if err := df.ToFeather(featherPath); err != nil {
+		b.Fatalf("ToFeather: %v", err)
+	}
+
+	gp := gpandas.GoPandas{}
+	b.Run("Read_csv", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := gp.Read_csv(csvPath); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Read_parquet", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := gp.Read_parquet(parquetPath); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Read_feather", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := gp.Read_feather(featherPath); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -1,12 +1,20 @@
 package gpandas_test
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas"
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
 
+	"cloud.google.com/go/bigquery"
 	"github.com/DATA-DOG/go-sqlmock"
 	_ "github.com/lib/pq" // PostgreSQL driver
 )
@@ -210,3 +218,308 @@ func TestFrom_gbq(t *testing.T) {
 		})
 	}
 }
+
+func TestFrom_gbq_with_options_NegativeMaxRows(t *testing.T) {
+	gp := gpandas.GoPandas{}
+	if _, err := gp.From_gbq_with_options("SELECT 1", "test-project", gpandas.GbqOptions{MaxRows: -1}); err == nil {
+		t.Error("expected error for negative MaxRows")
+	}
+}
+
+func TestRead_sql_chunked_InvalidChunksize(t *testing.T) {
+	gp := gpandas.GoPandas{}
+	chunks, errs, stop := gp.Read_sql_chunked("SELECT 1", gpandas.DbConfig{Database_server: "postgres"}, 0)
+	defer stop()
+	for range chunks {
+		t.Error("expected no chunks")
+	}
+	if err := <-errs; err == nil {
+		t.Error("expected error for non-positive chunksize")
+	}
+	if _, ok := <-errs; ok {
+		t.Error("expected error channel to be closed")
+	}
+}
+
+// chunkedMockConfig registers a sqlmock connection under the DSN that
+// connect_to_db builds for the returned config.
+func chunkedMockConfig(t *testing.T, name string) (gpandas.DbConfig, sqlmock.Sqlmock) {
+	t.Helper()
+	cfg := gpandas.DbConfig{Database_server: "sqlmock", Server: name, Port: "1", Database: "db", Username: "u", Password: "p"}
+	dsn := "host=" + name + " port=1 user=u password=p dbname=db sslmode=disable"
+	db, mock, err := sqlmock.NewWithDSN(dsn)
+	if err != nil {
+		t.Fatalf("error creating mock database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return cfg, mock
+}
+
+func TestRead_sql_chunked_Chunks(t *testing.T) {
+	cfg, mock := chunkedMockConfig(t, "chunks")
+	mock.ExpectQuery("SELECT id, name FROM users").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "name"}).
+			AddRow(1, "a").
+			AddRow(2, nil).
+			AddRow(3, "c").
+			AddRow(4, "d").
+			AddRow(5, "e"),
+	)
+
+	gp := gpandas.GoPandas{}
+	chunks, errs, stop := gp.Read_sql_chunked("SELECT id, name FROM users", cfg, 2)
+	defer stop()
+
+	var sizes []int
+	var index, names []string
+	var nulls []bool
+	for df := range chunks {
+		sizes = append(sizes, len(df.Index))
+		index = append(index, df.Index...)
+		col := df.Columns["name"]
+		for i := 0; i < col.Len(); i++ {
+			nulls = append(nulls, col.IsNull(i))
+			if v, _ := col.At(i); v != nil {
+				names = append(names, fmt.Sprint(v))
+			}
+		}
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fmt.Sprint(sizes) != "[2 2 1]" {
+		t.Errorf("expected chunk sizes [2 2 1], got %v", sizes)
+	}
+	if fmt.Sprint(index) != "[0 1 2 3 4]" {
+		t.Errorf("expected index to continue across chunks, got %v", index)
+	}
+	if fmt.Sprint(nulls) != "[false true false false false]" {
+		t.Errorf("expected only the second name to be null, got %v", nulls)
+	}
+	if fmt.Sprint(names) != "[a c d e]" {
+		t.Errorf("unexpected names %v", names)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRead_sql_chunked_ExactMultiple(t *testing.T) {
+	cfg, mock := chunkedMockConfig(t, "exact")
+	mock.ExpectQuery("SELECT id FROM t").WillReturnRows(
+		sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3).AddRow(4),
+	)
+
+	gp := gpandas.GoPandas{}
+	chunks, errs, stop := gp.Read_sql_chunked("SELECT id FROM t", cfg, 2)
+	defer stop()
+
+	var sizes []int
+	for df := range chunks {
+		sizes = append(sizes, len(df.Index))
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(sizes) != "[2 2]" {
+		t.Errorf("expected no trailing empty chunk, got sizes %v", sizes)
+	}
+}
+
+func TestRead_sql_chunked_Stop(t *testing.T) {
+	cfg, mock := chunkedMockConfig(t, "stop")
+	rows := sqlmock.NewRows([]string{"id"})
+	for i := 0; i < 10; i++ {
+		rows.AddRow(i)
+	}
+	mock.ExpectQuery("SELECT id FROM t").WillReturnRows(rows)
+	mock.ExpectClose()
+
+	gp := gpandas.GoPandas{}
+	chunks, errs, stop := gp.Read_sql_chunked("SELECT id FROM t", cfg, 2)
+
+	first, ok := <-chunks
+	if !ok || len(first.Index) != 2 {
+		t.Fatalf("expected a first chunk of 2 rows, got %v", first)
+	}
+	stop()
+	stop()
+
+	// The producer may already be blocked on the next chunk; it must give up.
+	for range chunks {
+	}
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled after stop, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("expected the connection to be closed after stop: %v", err)
+	}
+}
+
+func TestRead_sql_chunked_context_Cancelled(t *testing.T) {
+	cfg, mock := chunkedMockConfig(t, "cancelled")
+	mock.ExpectQuery("SELECT id FROM t").WillReturnRows(
+		sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2).AddRow(3),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gp := gpandas.GoPandas{}
+	chunks, errs, stop := gp.Read_sql_chunked_context(ctx, "SELECT id FROM t", cfg, 1)
+	defer stop()
+
+	<-chunks
+	cancel()
+	for range chunks {
+	}
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestFrom_gbq_rows(t *testing.T) {
+	gp := gpandas.GoPandas{}
+	ts := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	schema := bigquery.Schema{
+		{Name: "id", Type: bigquery.IntegerFieldType},
+		{Name: "score", Type: bigquery.FloatFieldType},
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "ok", Type: bigquery.BooleanFieldType},
+		{Name: "at", Type: bigquery.TimestampFieldType},
+		{Name: "amount", Type: bigquery.NumericFieldType},
+		{Name: "raw", Type: bigquery.BytesFieldType},
+		{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+	}
+	rows := [][]bigquery.Value{
+		{int64(1), 1.5, "a", true, ts, big.NewRat(1, 4), []byte("xy"), []bigquery.Value{"p", "q"}},
+		{nil, nil, nil, nil, nil, nil, nil, nil},
+		{int64(3), 2.0, "c"},
+	}
+
+	df, err := gp.From_gbq_rows(schema, rows, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantOrder := []string{"id", "score", "name", "ok", "at", "amount", "raw", "tags"}
+	if fmt.Sprint(df.ColumnOrder) != fmt.Sprint(wantOrder) {
+		t.Errorf("expected column order %v, got %v", wantOrder, df.ColumnOrder)
+	}
+	if fmt.Sprint(df.Index) != "[0 1 2]" {
+		t.Errorf("expected index [0 1 2], got %v", df.Index)
+	}
+
+	wantTypes := map[string]string{
+		"id":     "*collection.Int64Series",
+		"score":  "*collection.Float64Series",
+		"name":   "*collection.StringSeries",
+		"ok":     "*collection.BoolSeries",
+		"at":     "*collection.DateTimeSeries",
+		"amount": "*collection.AnySeries",
+		"raw":    "*collection.StringSeries",
+		"tags":   "*collection.AnySeries",
+	}
+	for name, want := range wantTypes {
+		if got := fmt.Sprintf("%T", df.Columns[name]); got != want {
+			t.Errorf("column %s: expected %s, got %s", name, want, got)
+		}
+	}
+
+	for _, name := range wantOrder {
+		if !df.Columns[name].IsNull(1) {
+			t.Errorf("column %s: expected NULL in row 1 to become null", name)
+		}
+	}
+	// Values missing at the end of a row are nulls too.
+	if !df.Columns["ok"].IsNull(2) || !df.Columns["tags"].IsNull(2) {
+		t.Error("expected missing trailing values to become nulls")
+	}
+
+	if v, _ := df.Columns["at"].At(0); v != ts {
+		t.Errorf("expected timestamp %v, got %v", ts, v)
+	}
+	if v, _ := df.Columns["amount"].At(0); v.(*big.Rat).Cmp(big.NewRat(1, 4)) != 0 {
+		t.Errorf("expected NUMERIC 1/4, got %v", v)
+	}
+	if v, _ := df.Columns["raw"].At(0); v != "xy" {
+		t.Errorf("expected BYTES as string \"xy\", got %v", v)
+	}
+	if v, _ := df.Columns["tags"].At(0); fmt.Sprint(v) != "[p q]" {
+		t.Errorf("expected REPEATED value [p q], got %v", v)
+	}
+
+	t.Run("mismatched value type", func(t *testing.T) {
+		_, err := gp.From_gbq_rows(schema[:1], [][]bigquery.Value{{int64(1)}, {"two"}}, 0)
+		if err == nil {
+			t.Error("expected error for a string in an INTEGER column")
+		}
+	})
+
+	t.Run("row longer than schema", func(t *testing.T) {
+		if _, err := gp.From_gbq_rows(schema[:1], [][]bigquery.Value{{int64(1), int64(2)}}, 0); err == nil {
+			t.Error("expected error for extra values")
+		}
+	})
+
+	t.Run("no rows", func(t *testing.T) {
+		df, err := gp.From_gbq_rows(schema, nil, 0)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(df.Index) != 0 || len(df.ColumnOrder) != len(schema) {
+			t.Errorf("expected an empty DataFrame with %d columns, got %v", len(schema), df.ColumnOrder)
+		}
+	})
+
+	t.Run("labels start at startIndex", func(t *testing.T) {
+		df, err := gp.From_gbq_rows(schema[:1], [][]bigquery.Value{{int64(1)}, {int64(2)}, {int64(3)}}, 1000)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if fmt.Sprint(df.Index) != "[1000 1001 1002]" {
+			t.Errorf("expected index [1000 1001 1002], got %v", df.Index)
+		}
+	})
+
+	t.Run("empty schema", func(t *testing.T) {
+		if _, err := gp.From_gbq_rows(nil, nil, 0); err == nil {
+			t.Error("expected error for an empty schema")
+		}
+	})
+}
+
+func TestTo_gbq_InvalidOptions(t *testing.T) {
+	gp := gpandas.GoPandas{}
+	a, _ := collection.NewFloat64SeriesFromData([]float64{1, 2}, nil)
+	df := &dataframe.DataFrame{
+		Columns:     map[string]collection.Series{"A": a},
+		ColumnOrder: []string{"A"},
+		Index:       []string{"0", "1"},
+	}
+	tests := []struct {
+		name    string
+		tableID string
+		opts    gpandas.ToGBQOptions
+	}{
+		{"missing dataset", "table", gpandas.ToGBQOptions{}},
+		{"empty table", "dataset.", gpandas.ToGBQOptions{}},
+		{"other project in table ID", "other-project.dataset.table", gpandas.ToGBQOptions{}},
+		{"bad write disposition", "dataset.table", gpandas.ToGBQOptions{WriteDisposition: "REPLACE"}},
+		{"bad create disposition", "dataset.table", gpandas.ToGBQOptions{CreateDisposition: "CREATE"}},
+		{"negative chunk size", "dataset.table", gpandas.ToGBQOptions{ChunkSize: -1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := gp.To_gbq(df, tt.tableID, "test-project", tt.opts); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+
+	if err := gp.To_gbq(df, "dataset.table", "", gpandas.ToGBQOptions{}); err == nil {
+		t.Error("expected error for a missing project ID")
+	}
+	if err := gp.To_gbq(nil, "dataset.table", "test-project", gpandas.ToGBQOptions{}); err == nil {
+		t.Error("expected error for nil DataFrame")
+	}
+}
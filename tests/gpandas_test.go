@@ -4,9 +4,14 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas"
+	"github.com/apoplexi24/gpandas/dataframe"
+	"github.com/apoplexi24/gpandas/utils/collection"
 )
 
 func TestRead_csv(t *testing.T) {
@@ -171,3 +176,353 @@ Bob,35,true,92.8`
 		}
 	}
 }
+
+func TestRead_csv_optsInferTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+	csvContent := `name,age,active,score,joined,code,empty
+John,30,true,95.5,2023-01-15,7,
+Alice,,false,87,2022-06-01,x9,
+Bob,35,TRUE,92.8,,12,`
+
+	testFile := filepath.Join(tmpDir, "infer_test.csv")
+	if err := os.WriteFile(testFile, []byte(csvContent), 0644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	pd := gpandas.GoPandas{}
+	df, err := pd.Read_csv_opts(testFile, gpandas.CSVReadOptions{
+		InferTypes: true,
+		Inference:  gpandas.TypeInferenceOptions{DateFormats: []string{"2006-01-02"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]reflect.Type{
+		"name":   reflect.TypeOf(""),
+		"age":    reflect.TypeOf(int64(0)),
+		"active": reflect.TypeOf(true),
+		"score":  reflect.TypeOf(float64(0)),
+		"joined": reflect.TypeOf(time.Time{}),
+		"code":   reflect.TypeOf(""),
+		"empty":  reflect.TypeOf(""),
+	}
+	for col, want := range expected {
+		if got := df.Columns[col].DType(); got != want {
+			t.Errorf("column %s: expected dtype %v, got %v", col, want, got)
+		}
+	}
+	if n := df.Columns["age"].NullCount(); n != 1 {
+		t.Errorf("expected 1 null in age, got %d", n)
+	}
+	if n := df.Columns["joined"].NullCount(); n != 1 {
+		t.Errorf("expected 1 null in joined, got %d", n)
+	}
+
+	t.Run("scan limit falls back to string", func(t *testing.T) {
+		df, err := pd.Read_csv_opts(testFile, gpandas.CSVReadOptions{
+			InferTypes: true,
+			Inference:  gpandas.TypeInferenceOptions{MaxRowsToScan: 1},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := df.Columns["code"].DType(); got != reflect.TypeOf("") {
+			t.Errorf("expected code to stay string, got %v", got)
+		}
+		if got := df.Columns["joined"].DType(); got != reflect.TypeOf("") {
+			t.Errorf("expected joined to stay string without date formats, got %v", got)
+		}
+	})
+
+	t.Run("scan limit widens to the next type", func(t *testing.T) {
+		rows := []string{"qty,flag"}
+		for i := 0; i < 50; i++ {
+			rows = append(rows, strconv.Itoa(i)+",1")
+		}
+		rows = append(rows, "1.5,true")
+		widenFile := filepath.Join(tmpDir, "widen_test.csv")
+		if err := os.WriteFile(widenFile, []byte(strings.Join(rows, "\n")), 0644); err != nil {
+			t.Fatalf("failed to create test file: %v", err)
+		}
+
+		df, err := pd.Read_csv_opts(widenFile, gpandas.CSVReadOptions{
+			InferTypes: true,
+			Inference:  gpandas.TypeInferenceOptions{MaxRowsToScan: 10},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := df.Columns["qty"].DType(); got != reflect.TypeOf(float64(0)) {
+			t.Fatalf("expected qty to widen to float64, got %v", got)
+		}
+		if v, _ := df.Columns["qty"].At(50); v != 1.5 {
+			t.Errorf("expected 1.5 in the last row, got %v", v)
+		}
+		if got := df.Columns["flag"].DType(); got != reflect.TypeOf("") {
+			t.Errorf("expected flag to stay string when no type fits every value, got %v", got)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		df, err := pd.Read_csv_opts(testFile, gpandas.CSVReadOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := df.Columns["age"].DType(); got != reflect.TypeOf("") {
+			t.Errorf("expected string dtype without inference, got %v", got)
+		}
+	})
+}
+
+func TestDataFrame32BitColumns(t *testing.T) {
+	gp := gpandas.GoPandas{}
+	df, err := gp.DataFrame(
+		[]string{"id", "score"},
+		[]gpandas.Column{{int32(1), nil}, {float32(0.5), float32(1.5)}},
+		map[string]any{"id": gpandas.Int32Col{}, "score": gpandas.Float32Col{}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := df.Columns["id"].(*collection.Int32Series); !ok {
+		t.Errorf("expected id to be *Int32Series, got %T", df.Columns["id"])
+	}
+	if !df.Columns["id"].IsNull(1) {
+		t.Error("expected id[1] to be null")
+	}
+	if v, _ := df.Columns["score"].At(1); v != float32(1.5) {
+		t.Errorf("expected score[1] = 1.5, got %v", v)
+	}
+
+	_, err = gp.DataFrame(
+		[]string{"id"},
+		[]gpandas.Column{{int64(1)}},
+		map[string]any{"id": gpandas.Int32Col{}},
+	)
+	if err == nil {
+		t.Error("expected type mismatch error for int64 value in Int32Col")
+	}
+}
+
+func TestFromDict(t *testing.T) {
+	df, err := gpandas.FromDict(map[string][]any{
+		"name": {"Alice", "Bob"},
+		"age":  {30, nil},
+	}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(df.ColumnOrder, []string{"age", "name"}) {
+		t.Errorf("expected alphabetical column order, got %v", df.ColumnOrder)
+	}
+	if _, ok := df.Columns["age"].(*collection.Int64Series); !ok {
+		t.Errorf("expected age to be inferred as *Int64Series, got %T", df.Columns["age"])
+	}
+	if !df.Columns["age"].IsNull(1) {
+		t.Error("expected age[1] to be null")
+	}
+
+	joined := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	df, err = gpandas.FromDict(map[string][]any{"joined": {joined, nil}}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := df.Columns["joined"].(*collection.DateTimeSeries); !ok {
+		t.Errorf("expected joined to be inferred as *DateTimeSeries, got %T", df.Columns["joined"])
+	}
+	if v, _ := df.Columns["joined"].At(0); v != joined || !df.Columns["joined"].IsNull(1) {
+		t.Errorf("unexpected joined values %v", df.Columns["joined"].ValuesCopy())
+	}
+
+	df, err = gpandas.FromDict(map[string][]any{"a": {1}, "b": {"x"}}, []string{"b", "a"})
+	if err != nil || !reflect.DeepEqual(df.ColumnOrder, []string{"b", "a"}) {
+		t.Errorf("expected explicit column order, got %v (err %v)", df, err)
+	}
+
+	if _, err := gpandas.FromDict(map[string][]any{"a": {1, 2}, "b": {"x"}}, nil); err == nil {
+		t.Error("expected error for mismatched column lengths")
+	}
+}
+
+func TestFromDictTyped(t *testing.T) {
+	df, err := gpandas.FromDictTyped(
+		map[string][]float64{"score": {1.5, 2.5}},
+		map[string][]int64{"id": {1, 2}},
+		map[string][]string{"name": {"a", "b"}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(df.ColumnOrder, []string{"id", "name", "score"}) {
+		t.Errorf("unexpected column order %v", df.ColumnOrder)
+	}
+	if _, ok := df.Columns["score"].(*collection.Float64Series); !ok {
+		t.Errorf("expected score to be *Float64Series, got %T", df.Columns["score"])
+	}
+
+	_, err = gpandas.FromDictTyped(map[string][]float64{"x": {1}}, map[string][]int64{"x": {1}}, nil)
+	if err == nil {
+		t.Error("expected error for duplicate column name")
+	}
+}
+
+func TestDataFrameApplyAlong(t *testing.T) {
+	gp := gpandas.GoPandas{}
+	df, err := gp.DataFrame(
+		[]string{"a", "b", "name"},
+		[]gpandas.Column{{1.0, 2.0, nil}, {10.0, nil, 30.0}, {"x", "y", "z"}},
+		map[string]any{"a": gpandas.FloatCol{}, "b": gpandas.FloatCol{}, "name": gpandas.StringCol{}},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	nulls, err := df.ApplyAlong(dataframe.ColumnWise, func(col collection.Series) any {
+		return col.NullCount()
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := nulls.ValuesCopy(); !reflect.DeepEqual(got, []any{int64(1), int64(1), int64(0)}) {
+		t.Errorf("expected column null counts [1 1 0], got %v", got)
+	}
+
+	sums, err := df.ApplyAlong(dataframe.RowWise, func(row collection.Series) any {
+		if row.Len() != 3 {
+			t.Errorf("expected rows of length 3, got %d", row.Len())
+		}
+		if row.IsNull(0) || row.IsNull(1) {
+			return nil
+		}
+		a, _ := row.At(0)
+		b, _ := row.At(1)
+		return a.(float64) + b.(float64)
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := sums.(*collection.Float64Series); !ok {
+		t.Errorf("expected *Float64Series, got %T", sums)
+	}
+	if got := sums.ValuesCopy(); !reflect.DeepEqual(got, []any{11.0, nil, nil}) {
+		t.Errorf("expected row sums [11 <nil> <nil>], got %v", got)
+	}
+
+	if _, err := df.ApplyAlong(dataframe.ApplyAxis(2), func(collection.Series) any { return nil }); err == nil {
+		t.Error("expected error for invalid axis")
+	}
+}
+
+func TestSelectDtypes(t *testing.T) {
+	df, err := gpandas.FromDict(map[string][]any{
+		"name":  {"a", "b"},
+		"age":   {30, 40},
+		"score": {1.5, 2.5},
+		"ok":    {true, false},
+	}, []string{"name", "age", "score", "ok"})
+	if err != nil {
+		t.Fatalf("FromDict failed: %v", err)
+	}
+
+	numeric, err := df.SelectDtypes(gpandas.NumericTypes(), nil)
+	if err != nil {
+		t.Fatalf("SelectDtypes failed: %v", err)
+	}
+	if !strSliceEqual(numeric.ColumnOrder, []string{"age", "score"}) {
+		t.Errorf("expected numeric columns [age score], got %v", numeric.ColumnOrder)
+	}
+	if _, err := numeric.Describe(); err != nil {
+		t.Errorf("Describe on numeric columns failed: %v", err)
+	}
+
+	rest, err := df.SelectDtypes(nil, []reflect.Type{reflect.TypeOf("")})
+	if err != nil {
+		t.Fatalf("SelectDtypes failed: %v", err)
+	}
+	if !strSliceEqual(rest.ColumnOrder, []string{"age", "score", "ok"}) {
+		t.Errorf("expected non-string columns, got %v", rest.ColumnOrder)
+	}
+
+	none, err := df.SelectDtypes([]reflect.Type{reflect.TypeOf(time.Time{})}, nil)
+	if err != nil {
+		t.Fatalf("SelectDtypes failed: %v", err)
+	}
+	if len(none.ColumnOrder) != 0 || len(none.Index) != 2 {
+		t.Errorf("expected no columns and the original index, got %v and %v", none.ColumnOrder, none.Index)
+	}
+
+	if _, err := df.SelectDtypes(nil, nil); err == nil {
+		t.Error("expected error when include and exclude are both empty")
+	}
+}
+
+func TestRangeIndex(t *testing.T) {
+	df, err := gpandas.RangeIndex(10, 0, -3, "id")
+	if err != nil {
+		t.Fatalf("RangeIndex failed: %v", err)
+	}
+	if !strSliceEqual(df.Index, []string{"10", "7", "4", "1"}) {
+		t.Errorf("unexpected index %v", df.Index)
+	}
+	if df.IndexName != "id" || len(df.ColumnOrder) != 0 {
+		t.Errorf("expected index name 'id' and no columns, got %q and %v", df.IndexName, df.ColumnOrder)
+	}
+
+	if err := df.Assign("A", mustSeries(1, 2, 3, 4)); err != nil {
+		t.Fatalf("Assign failed: %v", err)
+	}
+	if !strSliceEqual(df.Index, []string{"10", "7", "4", "1"}) {
+		t.Errorf("expected Assign to keep the range index, got %v", df.Index)
+	}
+	empty, _ := gpandas.RangeIndex(0, 3, 1, "")
+	if err := empty.Assign("A", mustSeries(1, 2)); err == nil {
+		t.Error("expected error for a column shorter than the index")
+	}
+
+	if _, err := gpandas.RangeIndex(0, 10, 0, ""); err == nil {
+		t.Error("expected error for zero step")
+	}
+}
+
+func TestDatetimeIndex(t *testing.T) {
+	start := time.Date(2024, 1, 31, 6, 0, 0, 0, time.UTC)
+	df, err := gpandas.DatetimeIndex(start, 3, "12H", "")
+	if err != nil {
+		t.Fatalf("DatetimeIndex failed: %v", err)
+	}
+	col, ok := df.Columns["timestamp"].(*collection.DateTimeSeries)
+	if !ok {
+		t.Fatalf("expected a DateTimeSeries named timestamp, got %T", df.Columns["timestamp"])
+	}
+	if v, _ := col.At(2); !v.(time.Time).Equal(start.Add(24 * time.Hour)) {
+		t.Errorf("expected the third timestamp one day later, got %v", v)
+	}
+
+	monthly, err := gpandas.DatetimeIndex(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), 2, "M", "month")
+	if err != nil {
+		t.Fatalf("DatetimeIndex failed: %v", err)
+	}
+	if v, _ := monthly.Columns["month"].At(1); !v.(time.Time).Equal(time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2024-02-15, got %v", v)
+	}
+
+	monthEnds, err := gpandas.DatetimeIndex(time.Date(2023, 1, 31, 9, 0, 0, 0, time.UTC), 4, "M", "month")
+	if err != nil {
+		t.Fatalf("DatetimeIndex failed: %v", err)
+	}
+	for i, day := range []int{31, 28, 31, 30} {
+		want := time.Date(2023, time.Month(i+1), day, 9, 0, 0, 0, time.UTC)
+		if v, _ := monthEnds.Columns["month"].At(i); !v.(time.Time).Equal(want) {
+			t.Errorf("step %d: expected %v, got %v", i, want, v)
+		}
+	}
+	leap, _ := gpandas.DatetimeIndex(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), 2, "A", "year")
+	if v, _ := leap.Columns["year"].At(1); !v.(time.Time).Equal(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected 2025-02-28, got %v", v)
+	}
+
+	if _, err := gpandas.DatetimeIndex(start, 3, "fortnight", ""); err == nil {
+		t.Error("expected error for unsupported frequency")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/apoplexi24/gpandas"
+	"github.com/parquet-go/parquet-go"
 )
 
 func TestParquetRoundTrip(t *testing.T) {
@@ -70,3 +71,87 @@ func TestParquetRoundTrip(t *testing.T) {
 		t.Errorf("expected false preserved, got %v", active1)
 	}
 }
+
+type parquetOptsRow struct {
+	ID    int64   `parquet:"id"`
+	Name  string  `parquet:"name"`
+	Score float64 `parquet:"score"`
+}
+
+func writeRowGroups(t *testing.T, path string, groups [][]parquetOptsRow) {
+	t.Helper()
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer f.Close()
+	w := parquet.NewGenericWriter[parquetOptsRow](f)
+	for _, rows := range groups {
+		if _, err := w.Write(rows); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatalf("flush: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}
+
+func TestReadParquetOpts(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "groups.parquet")
+	writeRowGroups(t, path, [][]parquetOptsRow{
+		{{1, "a", 1.5}, {2, "b", 2.5}},
+		{{10, "c", 10.5}, {11, "d", 11.5}},
+	})
+	gp := gpandas.GoPandas{}
+
+	t.Run("column pruning", func(t *testing.T) {
+		df, err := gp.Read_parquet_opts(path, gpandas.ParquetReadOptions{Columns: []string{"score", "id"}})
+		if err != nil {
+			t.Fatalf("Read_parquet_opts failed: %v", err)
+		}
+		if len(df.ColumnOrder) != 2 || df.ColumnOrder[0] != "score" || df.ColumnOrder[1] != "id" {
+			t.Fatalf("unexpected columns %v", df.ColumnOrder)
+		}
+		if df.Len() != 4 {
+			t.Fatalf("expected 4 rows, got %d", df.Len())
+		}
+		if v, _ := df.Columns["id"].At(2); v != int64(10) {
+			t.Errorf("expected id 10, got %v", v)
+		}
+	})
+
+	t.Run("row group filter", func(t *testing.T) {
+		var seen []any
+		df, err := gp.Read_parquet_opts(path, gpandas.ParquetReadOptions{
+			Columns:   []string{"id", "name"},
+			BatchSize: 1,
+			RowGroupFilter: func(min, max map[string]any) bool {
+				seen = append(seen, min["id"])
+				hi, ok := max["id"].(int64)
+				return !ok || hi >= 10
+			},
+		})
+		if err != nil {
+			t.Fatalf("Read_parquet_opts failed: %v", err)
+		}
+		if len(seen) != 2 || seen[0] != int64(1) || seen[1] != int64(10) {
+			t.Errorf("unexpected row group minimums %v", seen)
+		}
+		if df.Len() != 2 {
+			t.Fatalf("expected 2 rows after filtering, got %d", df.Len())
+		}
+		if v, _ := df.Columns["name"].At(0); v != "c" {
+			t.Errorf("expected c, got %v", v)
+		}
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		if _, err := gp.Read_parquet_opts(path, gpandas.ParquetReadOptions{Columns: []string{"missing"}}); err == nil {
+			t.Error("expected error for unknown column")
+		}
+	})
+}
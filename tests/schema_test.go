@@ -0,0 +1,55 @@
+package gpandas_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas"
+	"github.com/apoplexi24/gpandas/dataframe"
+)
+
+func TestNewDataFrameFromSchema(t *testing.T) {
+	schema := dataframe.Schema{Columns: []dataframe.ColumnSchema{
+		{Name: "id", DType: reflect.TypeOf(int64(0))},
+		{Name: "score", DType: reflect.TypeOf(float64(0)), Nullable: true, MinVal: 0.0},
+	}}
+
+	t.Run("valid data", func(t *testing.T) {
+		df, err := gpandas.NewDataFrameFromSchema(schema, []gpandas.Column{
+			{1, 2},
+			{int64(5), nil},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if df.Columns["id"].DType() != reflect.TypeOf(int64(0)) {
+			t.Errorf("expected int64 id column, got %v", df.Columns["id"].DType())
+		}
+		if v, _ := df.Columns["score"].At(0); v != 5.0 {
+			t.Errorf("expected score 5.0, got %v", v)
+		}
+		if !df.Columns["score"].IsNull(1) {
+			t.Error("expected null score")
+		}
+	})
+
+	t.Run("schema violation", func(t *testing.T) {
+		_, err := gpandas.NewDataFrameFromSchema(schema, []gpandas.Column{
+			{1, nil},
+			{-1.0, 2.0},
+		})
+		if err == nil {
+			t.Fatal("expected validation error")
+		}
+	})
+
+	t.Run("type mismatch", func(t *testing.T) {
+		_, err := gpandas.NewDataFrameFromSchema(schema, []gpandas.Column{
+			{"x"},
+			{1.0},
+		})
+		if err == nil {
+			t.Error("expected type error")
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package collection_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesUpdateConcurrent(t *testing.T) {
+	counter, _ := collection.NewInt64SeriesFromData([]int64{0}, nil)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 1000; k++ {
+				_ = counter.Update(0, func(v any) any { return v.(int64) + 1 })
+			}
+		}()
+	}
+	wg.Wait()
+	if v, _ := counter.At(0); v != int64(8000) {
+		t.Errorf("expected 8000 after concurrent updates, got %v", v)
+	}
+
+	if err := counter.Update(0, func(any) any { return "x" }); err == nil {
+		t.Error("expected type mismatch from Update")
+	}
+	_ = counter.Update(0, func(any) any { return nil })
+	if !counter.IsNull(0) {
+		t.Error("expected Update returning nil to store a null")
+	}
+}
+
+func TestSeriesCompareAndSwap(t *testing.T) {
+	f, _ := collection.NewFloat64SeriesFromData([]float64{1.5, 0}, []bool{false, true})
+	if ok, err := f.CompareAndSwap(0, 2.0, 3.0); ok || err != nil {
+		t.Errorf("expected failed swap, got %v, %v", ok, err)
+	}
+	if ok, _ := f.CompareAndSwap(0, 1.5, 3.0); !ok {
+		t.Error("expected swap to succeed")
+	}
+	if ok, _ := f.CompareAndSwap(1, nil, 4.0); !ok {
+		t.Error("expected swap of null to succeed")
+	}
+	if got := f.ValuesCopy(); got[0] != 3.0 || got[1] != 4.0 {
+		t.Errorf("unexpected values %v", got)
+	}
+	if _, err := f.CompareAndSwap(0, 3.0, int64(1)); err == nil {
+		t.Error("expected type mismatch error")
+	}
+
+	a, _ := collection.NewAnySeriesFromData([]any{[]int{1}}, nil)
+	if ok, _ := a.CompareAndSwap(0, []int{1}, "done"); !ok {
+		t.Error("expected AnySeries swap with deep-equal slice to succeed")
+	}
+	if v, _ := a.At(0); v != "done" {
+		t.Errorf("expected 'done', got %v", v)
+	}
+}
@@ -0,0 +1,113 @@
+package collection_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesAsTypes(t *testing.T) {
+	strs, _ := collection.NewStringSeriesFromData([]string{"1.5", "", "-2"}, []bool{false, true, false})
+
+	f, err := strs.AsFloat64()
+	if err != nil {
+		t.Fatalf("AsFloat64: %v", err)
+	}
+	if got := f.ValuesCopy(); !reflect.DeepEqual(got, []any{1.5, nil, -2.0}) {
+		t.Errorf("AsFloat64: got %v", got)
+	}
+
+	n, err := f.AsInt64()
+	if err != nil {
+		t.Fatalf("AsInt64: %v", err)
+	}
+	if got := n.ValuesCopy(); !reflect.DeepEqual(got, []any{int64(1), nil, int64(-2)}) {
+		t.Errorf("AsInt64: got %v", got)
+	}
+
+	b, err := n.AsBool()
+	if err != nil {
+		t.Fatalf("AsBool: %v", err)
+	}
+	if got := b.ValuesCopy(); !reflect.DeepEqual(got, []any{true, nil, true}) {
+		t.Errorf("AsBool: got %v", got)
+	}
+
+	s, err := b.AsString()
+	if err != nil {
+		t.Fatalf("AsString: %v", err)
+	}
+	if got := s.ValuesCopy(); !reflect.DeepEqual(got, []any{"true", nil, "true"}) {
+		t.Errorf("AsString: got %v", got)
+	}
+
+	words, _ := collection.NewStringSeriesFromData([]string{"false", "TRUE"}, nil)
+	if wb, err := words.AsBool(); err != nil || !reflect.DeepEqual(wb.ValuesCopy(), []any{false, true}) {
+		t.Errorf("AsBool on strings: got %v, %v", wb, err)
+	}
+
+	bad, _ := collection.NewStringSeriesFromData([]string{"1", "abc"}, nil)
+	_, err = bad.AsFloat64()
+	var castErr *collection.CastError
+	if !errors.As(err, &castErr) {
+		t.Fatalf("expected *CastError, got %v", err)
+	}
+	if castErr.Row != 1 || castErr.Value != "abc" || castErr.Target != "float64" {
+		t.Errorf("unexpected CastError %+v", castErr)
+	}
+	if _, err := bad.AsBool(); !errors.As(err, &castErr) {
+		t.Errorf("expected *CastError from AsBool, got %v", err)
+	}
+}
+
+func TestAsFunctions(t *testing.T) {
+	s, _ := collection.NewStringSeriesFromData([]string{"1", "0", ""}, []bool{false, false, true})
+	// The conversions only need the Series interface, so they also accept a
+	// Series implemented outside the package.
+	foreign := foreignSeries{s}
+
+	f, err := collection.AsFloat64(foreign)
+	if err != nil || !reflect.DeepEqual(f.ValuesCopy(), []any{1.0, 0.0, nil}) {
+		t.Errorf("unexpected AsFloat64 result %v, %v", f, err)
+	}
+	i, err := collection.AsInt64(foreign)
+	if err != nil || !reflect.DeepEqual(i.ValuesCopy(), []any{int64(1), int64(0), nil}) {
+		t.Errorf("unexpected AsInt64 result %v, %v", i, err)
+	}
+	b, err := collection.AsBool(foreign)
+	if err != nil || !reflect.DeepEqual(b.ValuesCopy(), []any{true, false, nil}) {
+		t.Errorf("unexpected AsBool result %v, %v", b, err)
+	}
+	str, err := collection.AsString(f)
+	if err != nil || !reflect.DeepEqual(str.ValuesCopy(), []any{"1", "0", nil}) {
+		t.Errorf("unexpected AsString result %v, %v", str, err)
+	}
+}
+
+func TestCastInt64RejectsNonFiniteFloats(t *testing.T) {
+	s, _ := collection.NewFloat64SeriesFromData([]float64{2.9, math.NaN(), math.Inf(1), math.Inf(-1), 1e19}, nil)
+
+	var castErr *collection.CastError
+	if _, err := collection.Cast(s, reflect.TypeOf(int64(0)), true); !errors.As(err, &castErr) || castErr.Row != 1 {
+		t.Errorf("expected *CastError at row 1 from strict Cast, got %v", err)
+	}
+	if _, err := collection.AsInt64(s); !errors.As(err, &castErr) {
+		t.Errorf("expected *CastError from AsInt64, got %v", err)
+	}
+
+	got, err := collection.Cast(s, reflect.TypeOf(int64(0)), false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []any{int64(2), nil, nil, nil, nil}; !reflect.DeepEqual(got.ValuesCopy(), want) {
+		t.Errorf("expected %v, got %v", want, got.ValuesCopy())
+	}
+
+	str, _ := collection.NewStringSeriesFromData([]string{"NaN", "1e30"}, nil)
+	if _, err := collection.AsInt64(str); !errors.As(err, &castErr) {
+		t.Errorf("expected *CastError for float strings out of range, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestConcatSeries(t *testing.T) {
+	t.Run("same type", func(t *testing.T) {
+		a, _ := collection.NewInt64SeriesFromData([]int64{1, 2}, nil)
+		b, _ := collection.NewInt64SeriesFromData([]int64{0, 4}, []bool{true, false})
+		out, err := collection.ConcatSeries(a, b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		is, ok := out.(*collection.Int64Series)
+		if !ok {
+			t.Fatalf("expected Int64Series, got %T", out)
+		}
+		if is.Len() != 4 || !is.IsNull(2) || is.IsNull(3) {
+			t.Errorf("unexpected result %v mask %v", is.Int64Values(), is.MaskCopy())
+		}
+	})
+
+	t.Run("int64 and float64 upcast to float64", func(t *testing.T) {
+		a, _ := collection.NewInt64SeriesFromData([]int64{1}, nil)
+		b, _ := collection.NewFloat64SeriesFromData([]float64{2.5}, nil)
+		out, err := collection.ConcatSeries(a, b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		fs, ok := out.(*collection.Float64Series)
+		if !ok {
+			t.Fatalf("expected Float64Series, got %T", out)
+		}
+		if v, _ := fs.Float64Value(0); v != 1 {
+			t.Errorf("expected 1, got %v", v)
+		}
+	})
+
+	t.Run("any input yields AnySeries", func(t *testing.T) {
+		a, _ := collection.NewStringSeriesFromData([]string{"x"}, nil)
+		b, _ := collection.NewAnySeriesFromData([]any{1}, nil)
+		out, err := collection.ConcatSeries(a, b)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := out.(*collection.AnySeries); !ok {
+			t.Fatalf("expected AnySeries, got %T", out)
+		}
+		if out.Len() != 2 {
+			t.Errorf("expected length 2, got %d", out.Len())
+		}
+	})
+
+	t.Run("no series", func(t *testing.T) {
+		if _, err := collection.ConcatSeries(); err == nil {
+			t.Error("expected error for empty input")
+		}
+	})
+}
+
+func TestConcatTypedSeries(t *testing.T) {
+	a, _ := collection.NewFloat64SeriesFromData([]float64{1, 2}, []bool{false, true})
+	b, _ := collection.NewFloat64SeriesFromData([]float64{3}, nil)
+	out, err := collection.ConcatFloat64Series(a, b)
+	if err != nil {
+		t.Fatalf("ConcatFloat64Series: %v", err)
+	}
+	if out.Len() != 3 || !out.IsNull(1) {
+		t.Fatalf("expected 3 values with a null at 1, got %v", out.ValuesCopy())
+	}
+	if v, _ := out.At(2); v != 3.0 {
+		t.Errorf("expected 3 at position 2, got %v", v)
+	}
+	if err := out.Set(0, 9.0); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if v, _ := a.At(0); v != 1.0 {
+		t.Errorf("expected input to be unchanged, got %v", v)
+	}
+
+	s1, _ := collection.NewStringSeriesFromData([]string{"x"}, nil)
+	s2, _ := collection.NewStringSeriesFromData([]string{"y", "z"}, nil)
+	strs, err := collection.ConcatStringSeries(s1, s2)
+	if err != nil {
+		t.Fatalf("ConcatStringSeries: %v", err)
+	}
+	if got := strs.ValuesCopy(); len(got) != 3 || got[2] != "z" {
+		t.Errorf("expected [x y z], got %v", got)
+	}
+
+	if _, err := collection.ConcatInt64Series(); err == nil {
+		t.Error("expected error for no series")
+	}
+	if _, err := collection.ConcatBoolSeries(nil); err == nil {
+		t.Error("expected error for nil series")
+	}
+}
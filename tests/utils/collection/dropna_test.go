@@ -0,0 +1,68 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesDropNa(t *testing.T) {
+	t.Run("float keeps order and type", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1, 0, 3, 0}, []bool{false, true, false, true})
+		out, err := s.DropNa()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := out.(*collection.Float64Series); !ok {
+			t.Fatalf("expected Float64Series, got %T", out)
+		}
+		if !reflect.DeepEqual(out.ValuesCopy(), []any{1.0, 3.0}) {
+			t.Errorf("expected [1 3], got %v", out.ValuesCopy())
+		}
+		if out.Len() != s.Len()-s.NullCount() || out.NullCount() != 0 {
+			t.Errorf("expected %d non-null elements, got len %d with %d nulls", s.Len()-s.NullCount(), out.Len(), out.NullCount())
+		}
+		if s.Len() != 4 {
+			t.Errorf("original modified, len %d", s.Len())
+		}
+	})
+
+	t.Run("categorical shares categories", func(t *testing.T) {
+		s, _ := collection.NewCategoricalSeriesFromStrings([]string{"a", "", "b"}, []bool{false, true, false})
+		out, err := s.DropNa()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(out.ValuesCopy(), []any{"a", "b"}) {
+			t.Errorf("expected [a b], got %v", out.ValuesCopy())
+		}
+	})
+
+	t.Run("all null gives empty series", func(t *testing.T) {
+		s, _ := collection.NewStringSeriesFromData([]string{"", ""}, []bool{true, true})
+		out, _ := s.DropNa()
+		if out.Len() != 0 {
+			t.Errorf("expected empty series, got %v", out.ValuesCopy())
+		}
+	})
+}
+
+func TestDropNaFunction(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewFloat64SeriesFromData([]float64{1, 0, 3}, []bool{false, true, false})
+
+	out, err := collection.DropNa(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := out.(*collection.Float64Series); !ok {
+		t.Errorf("expected Float64Series, got %T", out)
+	}
+	if !reflect.DeepEqual(out.ValuesCopy(), []any{1.0, 3.0}) {
+		t.Errorf("unexpected values %v", out.ValuesCopy())
+	}
+	if _, err := collection.DropNa(foreignSeries{s}); err == nil {
+		t.Error("expected error for unsupported series type")
+	}
+}
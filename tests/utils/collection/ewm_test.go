@@ -0,0 +1,59 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestEWM(t *testing.T) {
+	s, _ := collection.NewFloat64SeriesFromData([]float64{1, 2, 3}, nil)
+	check := func(name string, got *collection.Float64Series, want []float64) {
+		t.Helper()
+		for i, w := range want {
+			v, _ := got.At(i)
+			if math.IsNaN(w) {
+				if f, ok := v.(float64); !ok || !math.IsNaN(f) {
+					t.Errorf("%s position %d: expected NaN, got %v", name, i, v)
+				}
+				continue
+			}
+			if got.IsNull(i) || math.Abs(v.(float64)-w) > 1e-9 {
+				t.Errorf("%s position %d: expected %v, got %v", name, i, w, v)
+			}
+		}
+	}
+
+	mean, err := s.EWMMean(0.5, true, 0)
+	if err != nil {
+		t.Fatalf("EWMMean: %v", err)
+	}
+	check("adjusted mean", mean, []float64{1, 5.0 / 3, 4.25 / 1.75})
+
+	recursive, _ := s.EWMMean(0.5, false, 0)
+	check("recursive mean", recursive, []float64{1, 1.5, 2.25})
+
+	variance, _ := s.EWMVar(0.5, true, 0)
+	check("variance", variance, []float64{math.NaN(), 0.5, 0.928571428571})
+
+	std, _ := s.EWMStd(0.5, true, 0)
+	check("std", std, []float64{math.NaN(), math.Sqrt(0.5), math.Sqrt(0.928571428571)})
+
+	// A null ages the earlier observation without contributing itself.
+	gappy, _ := collection.NewFloat64SeriesFromData([]float64{1, 0, 3}, []bool{false, true, false})
+	gapMean, _ := gappy.EWMMean(0.5, true, 2)
+	if !gapMean.IsNull(0) || !gapMean.IsNull(1) {
+		t.Error("expected nulls before two observations have been seen")
+	}
+	if v, _ := gapMean.At(2); math.Abs(v.(float64)-2.6) > 1e-9 {
+		t.Errorf("expected 2.6 after a gap, got %v", v)
+	}
+
+	if _, err := s.EWMMean(0, true, 0); err == nil {
+		t.Error("expected error for alpha 0")
+	}
+	if _, err := s.EWMVar(1.5, true, 0); err == nil {
+		t.Error("expected error for alpha above 1")
+	}
+}
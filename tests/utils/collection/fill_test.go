@@ -0,0 +1,89 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesFill(t *testing.T) {
+	s, _ := collection.NewInt64SeriesFromData([]int64{0, 2, 0, 0, 5, 0}, []bool{true, false, true, true, false, true})
+
+	t.Run("FillValue", func(t *testing.T) {
+		out, err := s.FillValue(int64(-1))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []any{int64(-1), int64(2), int64(-1), int64(-1), int64(5), int64(-1)}
+		if !reflect.DeepEqual(out.ValuesCopy(), want) {
+			t.Errorf("expected %v, got %v", want, out.ValuesCopy())
+		}
+		if s.NullCount() != 4 {
+			t.Errorf("original modified, null count %d", s.NullCount())
+		}
+		if _, err := s.FillValue("x"); err == nil {
+			t.Error("expected error for incompatible fill value")
+		}
+		if _, err := s.FillValue(nil); err == nil {
+			t.Error("expected error for nil fill value")
+		}
+	})
+
+	t.Run("FillForward", func(t *testing.T) {
+		out, _ := s.FillForward()
+		want := []any{nil, int64(2), int64(2), int64(2), int64(5), int64(5)}
+		if !reflect.DeepEqual(out.ValuesCopy(), want) {
+			t.Errorf("expected %v, got %v", want, out.ValuesCopy())
+		}
+	})
+
+	t.Run("FillBackward", func(t *testing.T) {
+		out, _ := s.FillBackward()
+		want := []any{int64(2), int64(2), int64(5), int64(5), int64(5), nil}
+		if !reflect.DeepEqual(out.ValuesCopy(), want) {
+			t.Errorf("expected %v, got %v", want, out.ValuesCopy())
+		}
+	})
+
+	t.Run("categorical adds new category", func(t *testing.T) {
+		c, _ := collection.NewCategoricalSeriesFromStrings([]string{"a", ""}, []bool{false, true})
+		out, err := c.FillValue("z")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(out.ValuesCopy(), []any{"a", "z"}) {
+			t.Errorf("expected [a z], got %v", out.ValuesCopy())
+		}
+		if len(c.Categories()) != 1 {
+			t.Errorf("original categories modified: %v", c.Categories())
+		}
+	})
+}
+
+func TestFillFunctions(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewInt64SeriesFromData([]int64{0, 2, 0, 4, 0}, []bool{true, false, true, false, true})
+
+	cases := []struct {
+		name string
+		fill func(collection.Series) (collection.Series, error)
+		want []any
+	}{
+		{"value", func(s collection.Series) (collection.Series, error) { return collection.FillValue(s, int64(9)) }, []any{int64(9), int64(2), int64(9), int64(4), int64(9)}},
+		{"forward", collection.FillForward, []any{nil, int64(2), int64(2), int64(4), int64(4)}},
+		{"backward", collection.FillBackward, []any{int64(2), int64(2), int64(4), int64(4), nil}},
+	}
+	for _, c := range cases {
+		out, err := c.fill(s)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if !reflect.DeepEqual(out.ValuesCopy(), c.want) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, out.ValuesCopy())
+		}
+		if _, err := c.fill(foreignSeries{s}); err == nil {
+			t.Errorf("%s: expected error for unsupported series type", c.name)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package collection_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesHistogram(t *testing.T) {
+	t.Run("equal-width bins", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{0, 1, 2, 2.5, 99, 4}, []bool{false, false, false, false, true, false})
+		edges, counts, err := s.Histogram(4)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := edges.ToFloat64Slice(); !reflect.DeepEqual(got, []float64{0, 1, 2, 3, 4}) {
+			t.Errorf("edges = %v", got)
+		}
+		if got := counts.ToInt64Slice(); !reflect.DeepEqual(got, []int64{1, 1, 2, 1}) {
+			t.Errorf("counts = %v", got)
+		}
+	})
+
+	t.Run("int64 with constant values", func(t *testing.T) {
+		s, _ := collection.NewInt64SeriesFromData([]int64{5, 5, 5}, nil)
+		edges, counts, err := s.Histogram(2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := edges.ToFloat64Slice(); !reflect.DeepEqual(got, []float64{4.5, 5, 5.5}) {
+			t.Errorf("edges = %v", got)
+		}
+		if got := counts.ToInt64Slice(); !reflect.DeepEqual(got, []int64{0, 3}) {
+			t.Errorf("counts = %v", got)
+		}
+	})
+
+	t.Run("infinite values are ignored", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1, 2, math.Inf(1), 4, math.Inf(-1)}, nil)
+		edges, counts, err := s.Histogram(3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := edges.ToFloat64Slice(); !reflect.DeepEqual(got, []float64{1, 2, 3, 4}) {
+			t.Errorf("edges = %v", got)
+		}
+		if got := counts.ToInt64Slice(); !reflect.DeepEqual(got, []int64{1, 1, 1}) {
+			t.Errorf("counts = %v", got)
+		}
+		inf, _ := collection.NewFloat64SeriesFromData([]float64{math.Inf(1), math.Inf(-1)}, nil)
+		if _, _, err := inf.Histogram(3); err == nil {
+			t.Error("expected error for a series without finite values")
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1}, nil)
+		if _, _, err := s.Histogram(0); err == nil {
+			t.Error("expected error for zero bins")
+		}
+		if _, _, err := collection.NewFloat64Series(0).Histogram(3); err == nil {
+			t.Error("expected error for empty series")
+		}
+	})
+}
+
+func TestSeriesHistogramCustom(t *testing.T) {
+	s, _ := collection.NewInt64SeriesFromData([]int64{3, 18, 30, 64, 65, 120, 150}, nil)
+	counts, err := s.HistogramCustom([]float64{0, 18, 65, 120})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := counts.ToInt64Slice(); !reflect.DeepEqual(got, []int64{1, 3, 2}) {
+		t.Errorf("counts = %v", got)
+	}
+
+	if _, err := s.HistogramCustom([]float64{1}); err == nil {
+		t.Error("expected error for a single edge")
+	}
+	if _, err := s.HistogramCustom([]float64{0, 5, 5}); err == nil {
+		t.Error("expected error for non-increasing edges")
+	}
+}
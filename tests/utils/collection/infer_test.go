@@ -0,0 +1,49 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestAnySeriesInfer(t *testing.T) {
+	cases := []struct {
+		name   string
+		values []any
+		want   reflect.Type
+		vals   []any
+	}{
+		{"ints", []any{"1", 2, nil}, reflect.TypeOf(int64(0)), []any{int64(1), int64(2), nil}},
+		{"floats", []any{"1.5", 2, nil}, reflect.TypeOf(float64(0)), []any{1.5, 2.0, nil}},
+		{"bools", []any{"TRUE", false, nil}, reflect.TypeOf(true), []any{true, false, nil}},
+		{"times", []any{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil}, reflect.TypeOf(time.Time{}), []any{time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil}},
+		{"strings", []any{"a", 1, nil}, reflect.TypeOf(""), []any{"a", "1", nil}},
+	}
+	for _, c := range cases {
+		s, _ := collection.NewAnySeriesFromData(c.values, nil)
+		got, err := s.Infer()
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if got.DType() != c.want {
+			t.Errorf("%s: expected dtype %v, got %v", c.name, c.want, got.DType())
+		}
+		if !reflect.DeepEqual(got.ValuesCopy(), c.vals) {
+			t.Errorf("%s: expected %v, got %v", c.name, c.vals, got.ValuesCopy())
+		}
+	}
+
+	// The existing mask is respected even when the masked slot holds a value.
+	s, _ := collection.NewAnySeriesFromData([]any{"x", "3"}, []bool{true, false})
+	got, _ := s.Infer()
+	if got.DType() != reflect.TypeOf(int64(0)) || !got.IsNull(0) {
+		t.Errorf("expected int64 with null at 0, got %v %v", got.DType(), got.ValuesCopy())
+	}
+
+	empty, _ := collection.NewAnySeriesFromData([]any{nil, nil}, nil)
+	if got, _ := empty.Infer(); got.NullCount() != 2 {
+		t.Errorf("expected all-null result, got %v", got.ValuesCopy())
+	}
+}
@@ -0,0 +1,90 @@
+package collection_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesMode(t *testing.T) {
+	s, _ := collection.NewStringSeriesFromData([]string{"b", "a", "b", "a", "c", ""}, []bool{false, false, false, false, false, true})
+	mode, err := s.Mode()
+	if err != nil {
+		t.Fatalf("Mode: %v", err)
+	}
+	if _, ok := mode.(*collection.StringSeries); !ok {
+		t.Errorf("expected *StringSeries, got %T", mode)
+	}
+	if got := mode.ValuesCopy(); !reflect.DeepEqual(got, []any{"a", "b"}) {
+		t.Errorf("expected tied modes [a b], got %v", got)
+	}
+
+	f, _ := collection.NewFloat64SeriesFromData([]float64{2.5, 1, 2.5}, nil)
+	mode, _ = f.Mode()
+	if _, ok := mode.(*collection.Float64Series); !ok {
+		t.Errorf("expected *Float64Series, got %T", mode)
+	}
+	if got := mode.ValuesCopy(); !reflect.DeepEqual(got, []any{2.5}) {
+		t.Errorf("expected mode [2.5], got %v", got)
+	}
+
+	nan := math.NaN()
+	for _, tc := range []struct {
+		data []float64
+		want []any
+	}{
+		{[]float64{nan, nan, 5}, []any{5.0}},
+		{[]float64{nan, 1, 2}, []any{1.0, 2.0}},
+		{[]float64{0, math.Copysign(0, -1), 3}, []any{0.0}},
+	} {
+		f, _ := collection.NewFloat64SeriesFromData(tc.data, nil)
+		mode, _ = f.Mode()
+		if got := mode.ValuesCopy(); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Mode(%v): expected %v, got %v", tc.data, tc.want, got)
+		}
+	}
+	f32, _ := collection.NewFloat32SeriesFromData([]float32{float32(nan), float32(nan), 1}, nil)
+	mode, _ = f32.Mode()
+	if got := mode.ValuesCopy(); !reflect.DeepEqual(got, []any{float32(1)}) {
+		t.Errorf("expected float32 mode [1], got %v", got)
+	}
+
+	allNull, _ := collection.NewInt64SeriesFromData([]int64{0, 0}, []bool{true, true})
+	mode, _ = allNull.Mode()
+	if mode.Len() != 0 {
+		t.Errorf("expected empty mode for all-null series, got %v", mode.ValuesCopy())
+	}
+
+	anyS, _ := collection.NewAnySeriesFromData([]any{[]int{1}, []int{1}, 3}, nil)
+	mode, _ = anyS.Mode()
+	if got := mode.ValuesCopy(); !reflect.DeepEqual(got, []any{[]int{1}}) {
+		t.Errorf("expected mode [[1]] for unhashable values, got %v", got)
+	}
+
+	cat, _ := collection.NewCategoricalSeriesFromStrings([]string{"z", "y", "z", "y"}, nil)
+	mode, _ = cat.Mode()
+	if _, ok := mode.(*collection.CategoricalSeries); !ok {
+		t.Errorf("expected *CategoricalSeries, got %T", mode)
+	}
+	if got := mode.ValuesCopy(); !reflect.DeepEqual(got, []any{"y", "z"}) {
+		t.Errorf("expected categorical modes [y z], got %v", got)
+	}
+}
+
+func TestModeFunction(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewBoolSeriesFromData([]bool{true, false, true}, nil)
+
+	out, err := collection.Mode(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := out.(*collection.BoolSeries); !ok || !reflect.DeepEqual(out.ValuesCopy(), []any{true}) {
+		t.Errorf("expected BoolSeries [true], got %T %v", out, out.ValuesCopy())
+	}
+	if _, err := collection.Mode(foreignSeries{s}); err == nil {
+		t.Error("expected error for unsupported series type")
+	}
+}
@@ -0,0 +1,56 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesNUnique(t *testing.T) {
+	s, _ := collection.NewStringSeriesFromData([]string{"a", "b", "a", ""}, []bool{false, false, false, true})
+	if got := s.NUnique(true); got != 2 {
+		t.Errorf("expected 2 distinct values, got %d", got)
+	}
+	if got := s.NUnique(false); got != 3 {
+		t.Errorf("expected 3 distinct values counting null, got %d", got)
+	}
+
+	f, _ := collection.NewFloat64SeriesFromData([]float64{math.NaN(), math.NaN(), 0, math.Copysign(0, -1)}, nil)
+	if got := f.NUnique(true); got != 2 {
+		t.Errorf("expected NaN and zero to count once each, got %d", got)
+	}
+
+	noNulls, _ := collection.NewInt64SeriesFromData([]int64{1, 1, 2}, nil)
+	if got := noNulls.NUnique(false); got != 2 {
+		t.Errorf("expected null not to be counted when absent, got %d", got)
+	}
+
+	utc := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	dt, _ := collection.NewDateTimeSeriesFromData([]time.Time{utc, utc.In(time.FixedZone("X", 3600))}, nil)
+	if got := dt.NUnique(true); got != 1 {
+		t.Errorf("expected the same instant to count once, got %d", got)
+	}
+
+	anyS, _ := collection.NewAnySeriesFromData([]any{[]int{1}, []int{1}, "x", nil}, nil)
+	if got := anyS.NUnique(false); got != 3 {
+		t.Errorf("expected 3 distinct values counting null, got %d", got)
+	}
+}
+
+func TestNUniqueFunction(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewStringSeriesFromData([]string{"a", "b", "a", ""}, []bool{false, false, false, true})
+
+	if n := collection.NUnique(s, true); n != 2 {
+		t.Errorf("expected 2 distinct values, got %d", n)
+	}
+	foreign := foreignSeries{s}
+	if n := collection.NUnique(foreign, true); n != 2 {
+		t.Errorf("expected 2 distinct values for a foreign series, got %d", n)
+	}
+	if n := collection.NUnique(foreign, false); n != 3 {
+		t.Errorf("expected null to count as a value, got %d", n)
+	}
+}
@@ -0,0 +1,46 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestPctChange(t *testing.T) {
+	s, _ := collection.NewFloat64SeriesFromData([]float64{100, 110, 0, 50, 55, 0}, []bool{false, false, false, false, false, true})
+	got, err := s.PctChange(1)
+	if err != nil {
+		t.Fatalf("PctChange: %v", err)
+	}
+	// null, 0.1, -1, null (previous is zero), 0.1, null (current is null)
+	want := []any{nil, 0.1, -1.0, nil, 0.1, nil}
+	for i, w := range want {
+		v, _ := got.At(i)
+		if w == nil {
+			if !got.IsNull(i) {
+				t.Errorf("position %d: expected null, got %v", i, v)
+			}
+			continue
+		}
+		if got.IsNull(i) || math.Abs(v.(float64)-w.(float64)) > 1e-12 {
+			t.Errorf("position %d: expected %v, got %v", i, w, v)
+		}
+	}
+
+	ints, _ := collection.NewInt64SeriesFromData([]int64{2, 4, 8}, nil)
+	back, _ := ints.PctChange(-1)
+	if v, _ := back.At(0); v != -0.5 {
+		t.Errorf("expected -0.5 comparing with the next element, got %v", v)
+	}
+	if !back.IsNull(2) {
+		t.Error("expected last position to be null for periods -1")
+	}
+	two, _ := ints.PctChange(2)
+	if !two.IsNull(1) {
+		t.Error("expected position 1 to be null for periods 2")
+	}
+	if v, _ := two.At(2); v != 3.0 {
+		t.Errorf("expected 3 for (8-2)/2, got %v", v)
+	}
+}
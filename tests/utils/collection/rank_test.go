@@ -0,0 +1,54 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesRank(t *testing.T) {
+	s, _ := collection.NewFloat64SeriesFromData([]float64{3, 1, 3, 2}, nil)
+
+	tests := []struct {
+		method    string
+		ascending bool
+		expected  []float64
+	}{
+		{"average", true, []float64{3.5, 1, 3.5, 2}},
+		{"min", true, []float64{3, 1, 3, 2}},
+		{"max", true, []float64{4, 1, 4, 2}},
+		{"first", true, []float64{3, 1, 4, 2}},
+		{"dense", true, []float64{3, 1, 3, 2}},
+		{"min", false, []float64{1, 4, 1, 3}},
+	}
+	for _, tt := range tests {
+		ranks, err := s.Rank(tt.method, tt.ascending)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.method, err)
+		}
+		if !reflect.DeepEqual(ranks.Float64Values(), tt.expected) {
+			t.Errorf("%s (ascending=%v): expected %v, got %v", tt.method, tt.ascending, tt.expected, ranks.Float64Values())
+		}
+	}
+
+	t.Run("nulls stay null", func(t *testing.T) {
+		is, _ := collection.NewInt64SeriesFromData([]int64{5, 0, 1}, []bool{false, true, false})
+		ranks, err := is.Rank("average", true)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !ranks.IsNull(1) {
+			t.Error("expected null rank at position 1")
+		}
+		if v, _ := ranks.Float64Value(0); v != 2 {
+			t.Errorf("expected rank 2, got %v", v)
+		}
+	})
+
+	t.Run("invalid method", func(t *testing.T) {
+		if _, err := s.Rank("bogus", true); err == nil {
+			t.Error("expected error for invalid method")
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesRepeat(t *testing.T) {
+	s, _ := collection.NewInt64SeriesFromData([]int64{1, 0, 3}, []bool{false, true, false})
+
+	out, err := s.Repeat([]int{2, 1, 0})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.ValuesCopy(), []any{int64(1), int64(1), nil}) {
+		t.Errorf("unexpected values %v", out.ValuesCopy())
+	}
+	if _, ok := out.(*collection.Int64Series); !ok {
+		t.Errorf("expected Int64Series, got %T", out)
+	}
+
+	if _, err := s.Repeat([]int{1}); err == nil {
+		t.Error("expected error for repeats length mismatch")
+	}
+	if _, err := s.Repeat([]int{1, -1, 1}); err == nil {
+		t.Error("expected error for negative repeat")
+	}
+}
+
+func TestSeriesTile(t *testing.T) {
+	t.Run("string series", func(t *testing.T) {
+		s, _ := collection.NewStringSeriesFromData([]string{"a", ""}, []bool{false, true})
+		out, err := s.Tile(3)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(out.ValuesCopy(), []any{"a", nil, "a", nil, "a", nil}) {
+			t.Errorf("unexpected values %v", out.ValuesCopy())
+		}
+	})
+
+	t.Run("categorical series", func(t *testing.T) {
+		s, _ := collection.NewCategoricalSeriesFromStrings([]string{"x", "y"}, nil)
+		out, err := s.Tile(2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(out.ValuesCopy(), []any{"x", "y", "x", "y"}) {
+			t.Errorf("unexpected values %v", out.ValuesCopy())
+		}
+	})
+
+	t.Run("zero and negative counts", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1}, nil)
+		out, err := s.Tile(0)
+		if err != nil || out.Len() != 0 {
+			t.Errorf("expected empty series, got len %d (err %v)", out.Len(), err)
+		}
+		if _, err := s.Tile(-1); err == nil {
+			t.Error("expected error for negative tile count")
+		}
+	})
+}
+
+func TestRepeatTileFunctions(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewStringSeriesFromData([]string{"a", "b"}, nil)
+
+	out, err := collection.Repeat(s, []int{1, 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.ValuesCopy(), []any{"a", "b", "b"}) {
+		t.Errorf("unexpected Repeat values %v", out.ValuesCopy())
+	}
+	out, err = collection.Tile(s, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(out.ValuesCopy(), []any{"a", "b", "a", "b"}) {
+		t.Errorf("unexpected Tile values %v", out.ValuesCopy())
+	}
+
+	// A Series implemented outside the package only has the interface methods.
+	foreign := foreignSeries{s}
+	if _, err := collection.Repeat(foreign, []int{1, 1}); err == nil {
+		t.Error("expected error for unsupported series type")
+	}
+	if _, err := collection.Tile(foreign, 2); err == nil {
+		t.Error("expected error for unsupported series type")
+	}
+}
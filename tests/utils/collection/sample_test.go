@@ -0,0 +1,66 @@
+package collection_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesSample(t *testing.T) {
+	s, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3, 4, 5}, []bool{false, false, true, false, false})
+
+	t.Run("without replacement", func(t *testing.T) {
+		out, err := s.Sample(5, false, 42)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, ok := out.(*collection.Int64Series); !ok {
+			t.Fatalf("expected Int64Series, got %T", out)
+		}
+		seen := map[any]int{}
+		for _, v := range out.ValuesCopy() {
+			seen[v]++
+		}
+		if len(seen) != 5 || seen[nil] != 1 {
+			t.Errorf("expected each element exactly once, got %v", out.ValuesCopy())
+		}
+	})
+
+	t.Run("reproducible with seed", func(t *testing.T) {
+		a, _ := s.Sample(10, true, 7)
+		b, _ := s.Sample(10, true, 7)
+		if !reflect.DeepEqual(a.ValuesCopy(), b.ValuesCopy()) {
+			t.Errorf("expected identical samples, got %v and %v", a.ValuesCopy(), b.ValuesCopy())
+		}
+		if a.Len() != 10 {
+			t.Errorf("expected 10 elements, got %d", a.Len())
+		}
+	})
+
+	t.Run("too many without replacement", func(t *testing.T) {
+		if _, err := s.Sample(6, false, 1); err == nil {
+			t.Error("expected error when n exceeds length without replacement")
+		}
+	})
+}
+
+func TestSampleFunction(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewStringSeriesFromData([]string{"a", "b", "c"}, nil)
+
+	out, err := collection.Sample(s, 3, false, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := out.(*collection.StringSeries); !ok || out.Len() != 3 {
+		t.Errorf("expected a StringSeries of 3 elements, got %T of %d", out, out.Len())
+	}
+	same, _ := collection.Sample(s, 3, false, 7)
+	if !reflect.DeepEqual(out.ValuesCopy(), same.ValuesCopy()) {
+		t.Error("expected the same seed to give the same sample")
+	}
+	if _, err := collection.Sample(foreignSeries{s}, 1, false, 7); err == nil {
+		t.Error("expected error for unsupported series type")
+	}
+}
@@ -0,0 +1,86 @@
+package collection_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesSearchSorted(t *testing.T) {
+	s, _ := collection.NewInt64SeriesFromData([]int64{1, 3, 3, 5, 0}, []bool{false, false, false, false, true})
+
+	if !s.IsSorted(true) {
+		t.Fatal("expected series with trailing null to be sorted ascending")
+	}
+	if s.IsSorted(false) {
+		t.Error("expected series not to be sorted descending")
+	}
+
+	cases := []struct {
+		v    any
+		side string
+		want int
+	}{
+		{int64(3), "left", 1},
+		{int64(3), "right", 3},
+		{0, "left", 0},
+		{int64(4), "left", 3},
+		{int64(9), "right", 4},
+	}
+	for _, c := range cases {
+		got, err := s.SearchSorted(c.v, c.side)
+		if err != nil {
+			t.Fatalf("SearchSorted(%v, %s): %v", c.v, c.side, err)
+		}
+		if got != c.want {
+			t.Errorf("SearchSorted(%v, %s) = %d, want %d", c.v, c.side, got, c.want)
+		}
+	}
+
+	if _, err := s.SearchSorted(int64(1), "middle"); err == nil {
+		t.Error("expected error for invalid side")
+	}
+	if _, err := s.SearchSorted("3", "left"); err == nil {
+		t.Error("expected error for string value on int series")
+	}
+
+	unsorted, _ := collection.NewFloat64SeriesFromData([]float64{2, 0, 1}, []bool{false, true, false})
+	if unsorted.IsSorted(true) {
+		t.Error("expected value after null to make the series unsorted")
+	}
+}
+
+func TestDateTimeSearchSorted(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	s := collection.NewDateTimeSeries(0)
+	for _, d := range []int{1, 5, 10, 20} {
+		s.Append(day(d))
+	}
+
+	start, _ := s.SearchSorted(day(5), "left")
+	end, _ := s.SearchSorted(day(15), "right")
+	if start != 1 || end != 3 {
+		t.Errorf("expected range [1, 3), got [%d, %d)", start, end)
+	}
+}
+
+func TestSearchSortedFunctions(t *testing.T) {
+	var s collection.Series
+	s, _ = collection.NewStringSeriesFromData([]string{"a", "c", "c", ""}, []bool{false, false, false, true})
+
+	if pos, err := collection.SearchSorted(s, "c", "right"); err != nil || pos != 3 {
+		t.Errorf("expected position 3, got %d (%v)", pos, err)
+	}
+	if !collection.IsSorted(s, true) || collection.IsSorted(s, false) {
+		t.Error("expected the series to be sorted ascending only")
+	}
+
+	foreign := foreignSeries{s}
+	if _, err := collection.SearchSorted(foreign, "c", "left"); err == nil {
+		t.Error("expected error for unsupported series type")
+	}
+	if !collection.IsSorted(foreign, true) {
+		t.Error("expected IsSorted to fall back to comparing values")
+	}
+}
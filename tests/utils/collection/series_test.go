@@ -3,6 +3,7 @@ package collection_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/apoplexi24/gpandas/utils/collection"
 )
@@ -258,3 +259,165 @@ func TestNewSeriesFromValues(t *testing.T) {
 		t.Errorf("expected null count 3, got %d", s5.NullCount())
 	}
 }
+
+func TestSeriesToTypedSlice(t *testing.T) {
+	t.Run("float64 keeps zeros at null positions", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1.5, 2.5}, nil)
+		s.AppendNull()
+		got := s.ToFloat64Slice()
+		if !reflect.DeepEqual(got, []float64{1.5, 2.5, 0}) {
+			t.Fatalf("unexpected data %v", got)
+		}
+		got[0] = 100
+		if v, _ := s.Float64Value(0); v != 1.5 {
+			t.Errorf("expected returned slice to be a copy")
+		}
+	})
+
+	t.Run("float64 no nulls", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1, 2}, nil)
+		got, err := s.ToFloat64SliceNoNulls()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, []float64{1, 2}) {
+			t.Errorf("expected [1 2], got %v", got)
+		}
+
+		withNull, _ := collection.NewFloat64SeriesFromData([]float64{1, 2}, []bool{false, true})
+		if _, err := withNull.ToFloat64SliceNoNulls(); err == nil {
+			t.Error("expected error for series with nulls")
+		}
+	})
+
+	t.Run("other typed series", func(t *testing.T) {
+		i, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3}, nil)
+		if !reflect.DeepEqual(i.ToInt64Slice(), []int64{1, 2, 3}) {
+			t.Errorf("unexpected int64 data %v", i.ToInt64Slice())
+		}
+		s, _ := collection.NewStringSeriesFromData([]string{"a", "b"}, nil)
+		if !reflect.DeepEqual(s.ToStringSlice(), []string{"a", "b"}) {
+			t.Errorf("unexpected string data %v", s.ToStringSlice())
+		}
+		b, _ := collection.NewBoolSeriesFromData([]bool{true, false}, nil)
+		if !reflect.DeepEqual(b.ToBoolSlice(), []bool{true, false}) {
+			t.Errorf("unexpected bool data %v", b.ToBoolSlice())
+		}
+	})
+}
+
+func TestBoolSeriesCounts(t *testing.T) {
+	s, _ := collection.NewBoolSeriesFromData([]bool{true, false, true, true, false}, []bool{false, false, false, true, true})
+	if got := s.CountTrue(); got != 2 {
+		t.Errorf("CountTrue = %d, want 2", got)
+	}
+	if got := s.CountFalse(); got != 1 {
+		t.Errorf("CountFalse = %d, want 1", got)
+	}
+	if !s.Any() {
+		t.Error("Any = false, want true")
+	}
+	if s.All() {
+		t.Error("All = true, want false")
+	}
+
+	allTrue, _ := collection.NewBoolSeriesFromData([]bool{true, false}, []bool{false, true})
+	if !allTrue.All() {
+		t.Error("All should ignore nulls")
+	}
+
+	empty := collection.NewBoolSeries(0)
+	if empty.Any() || !empty.All() {
+		t.Error("empty series: want Any=false, All=true")
+	}
+}
+
+func TestSeriesRawAccess(t *testing.T) {
+	f, _ := collection.NewFloat64SeriesFromData([]float64{1.5, 0, 3}, []bool{false, true, false})
+	data, ok := f.RawData().([]float64)
+	if !ok || len(data) != 3 || data[2] != 3 {
+		t.Fatalf("unexpected RawData %v", f.RawData())
+	}
+	if mask := f.RawMask(); len(mask) != 3 || !mask[1] || mask[0] {
+		t.Errorf("unexpected RawMask %v", mask)
+	}
+
+	// RawData shares storage with the series.
+	_ = f.Set(0, 9.0)
+	if data[0] != 9 {
+		t.Errorf("expected RawData to reflect Set, got %v", data[0])
+	}
+
+	cases := []struct {
+		name   string
+		series collection.Series
+		want   any
+	}{
+		{"int64", collection.NewSeriesOfType(reflect.TypeOf(int64(0)), 0), []int64{}},
+		{"string", collection.NewSeriesOfType(reflect.TypeOf(""), 0), []string{}},
+		{"bool", collection.NewSeriesOfType(reflect.TypeOf(true), 0), []bool{}},
+		{"any", collection.NewAnySeries(0), []any{}},
+		{"datetime", collection.NewDateTimeSeries(0), []time.Time{}},
+	}
+	for _, c := range cases {
+		if reflect.TypeOf(collection.RawData(c.series)) != reflect.TypeOf(c.want) {
+			t.Errorf("%s: RawData type %T, want %T", c.name, collection.RawData(c.series), c.want)
+		}
+	}
+
+	cat, _ := collection.NewCategoricalSeriesFromStrings([]string{"a", "b", "a"}, []bool{false, false, true})
+	codes, ok := cat.RawData().([]int32)
+	if !ok || codes[0] != 0 || codes[1] != 1 || codes[2] != -1 {
+		t.Errorf("unexpected categorical codes %v", cat.RawData())
+	}
+	if mask := cat.RawMask(); !mask[2] || mask[0] {
+		t.Errorf("unexpected categorical mask %v", mask)
+	}
+
+	foreign := foreignSeries{cat}
+	if got := collection.RawData(foreign); !reflect.DeepEqual(got, []any{"a", "b", nil}) {
+		t.Errorf("expected RawData to fall back to ValuesCopy, got %v", got)
+	}
+	if got := collection.RawMask(foreign); !reflect.DeepEqual(got, []bool{false, false, true}) {
+		t.Errorf("expected RawMask to fall back to MaskCopy, got %v", got)
+	}
+}
+
+func Test32BitSeries(t *testing.T) {
+	i32, ok := collection.NewSeriesOfType(reflect.TypeOf(int32(0)), 0).(*collection.Int32Series)
+	if !ok {
+		t.Fatal("expected NewSeriesOfType(int32) to return *Int32Series")
+	}
+	if err := i32.Append(int32(7)); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	i32.AppendNull()
+	if err := i32.Append(int64(1)); err == nil {
+		t.Error("expected type mismatch appending int64 to Int32Series")
+	}
+	if v, _ := i32.At(0); v != int32(7) || !i32.IsNull(1) || i32.DType() != reflect.TypeOf(int32(0)) {
+		t.Errorf("unexpected Int32Series contents %v", i32.ValuesCopy())
+	}
+
+	f32, ok := collection.NewSeriesOfTypeWithSize(reflect.TypeOf(float32(0)), 2).(*collection.Float32Series)
+	if !ok {
+		t.Fatal("expected NewSeriesOfTypeWithSize(float32) to return *Float32Series")
+	}
+	if err := f32.Set(1, float32(2.5)); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	tiled, err := f32.Tile(2)
+	if err != nil {
+		t.Fatalf("Tile: %v", err)
+	}
+	want := []float32{0, 2.5, 0, 2.5}
+	if got := tiled.(*collection.Float32Series).Float32Values(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+// foreignSeries stands in for a Series implemented outside the collection
+// package: embedding the interface exposes only the interface methods.
+type foreignSeries struct {
+	collection.Series
+}
@@ -0,0 +1,75 @@
+package collection_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apoplexi24/gpandas/utils/collection"
+)
+
+func TestSeriesSum(t *testing.T) {
+	t.Run("float64 skips nulls", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1.5, 100, 2.5, 3, 4, 5}, []bool{false, true, false, false, false, false})
+		if got := s.Sum(); math.Abs(got-16) > 1e-9 {
+			t.Errorf("expected 16, got %v", got)
+		}
+	})
+
+	t.Run("float64 null slot holding Inf", func(t *testing.T) {
+		s, _ := collection.NewFloat64SeriesFromData([]float64{1, math.Inf(1), 2}, []bool{false, true, false})
+		if got := s.Sum(); got != 3 {
+			t.Errorf("expected 3, got %v", got)
+		}
+	})
+
+	t.Run("int64 skips nulls", func(t *testing.T) {
+		s, _ := collection.NewInt64SeriesFromData([]int64{1, 2, 3, 4, 5, 6, 7}, []bool{false, false, true, false, false, false, false})
+		if got := s.Sum(); got != 25 {
+			t.Errorf("expected 25, got %v", got)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if got := collection.NewFloat64Series(0).Sum(); got != 0 {
+			t.Errorf("expected 0, got %v", got)
+		}
+	})
+}
+
+func largeFloatSeries() *collection.Float64Series {
+	const n = 2_000_000
+	data := make([]float64, n)
+	mask := make([]bool, n)
+	for i := range data {
+		data[i] = float64(i % 1000)
+		mask[i] = i%10 == 0
+	}
+	s, _ := collection.NewFloat64SeriesFromData(data, mask)
+	return s
+}
+
+func BenchmarkFloat64SeriesSum(b *testing.B) {
+	s := largeFloatSeries()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = s.Sum()
+	}
+}
+
+// BenchmarkFloat64SeriesSumLoop is the baseline: the per-element loop with a
+// null check that callers previously wrote against the Series interface.
+func BenchmarkFloat64SeriesSumLoop(b *testing.B) {
+	s := largeFloatSeries()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		total := 0.0
+		for j := 0; j < s.Len(); j++ {
+			if s.IsNull(j) {
+				continue
+			}
+			v, _ := s.At(j)
+			total += v.(float64)
+		}
+		_ = total
+	}
+}
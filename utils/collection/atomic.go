@@ -0,0 +1,143 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// CompareAndSwap sets element i to newVal if its current value equals
+// expected, holding the write lock for the whole comparison and update. A nil
+// expected matches a null element and a nil newVal stores a null. Values are
+// compared with reflect.DeepEqual. It reports whether the swap happened.
+func (s *AnySeries) CompareAndSwap(i int, expected, newVal any) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 || i >= len(s.data) {
+		return false, errors.New("index out of range")
+	}
+	var current any
+	if !s.mask[i] {
+		current = s.data[i]
+	}
+	if !reflect.DeepEqual(current, expected) {
+		return false, nil
+	}
+	s.data[i] = newVal
+	s.mask[i] = newVal == nil
+	return true, nil
+}
+
+// Update replaces element i with fn applied to its current value (nil if
+// null), holding the write lock for the whole read-modify-write cycle. If fn
+// returns nil the element becomes null. fn must not call back into s.
+func (s *AnySeries) Update(i int, fn func(any) any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 || i >= len(s.data) {
+		return errors.New("index out of range")
+	}
+	var current any
+	if !s.mask[i] {
+		current = s.data[i]
+	}
+	next := fn(current)
+	s.data[i] = next
+	s.mask[i] = next == nil
+	return nil
+}
+
+// CompareAndSwap sets element i to newVal if its current value equals
+// expected, holding the write lock for the whole comparison and update. A nil
+// expected matches a null element and a nil newVal stores a null; other
+// values must be float64. It reports whether the swap happened.
+func (s *Float64Series) CompareAndSwap(i int, expected, newVal any) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return compareAndSwap(s.data, s.mask, i, expected, newVal, "float64")
+}
+
+// Update replaces element i with fn applied to its current value (nil if
+// null), holding the write lock for the whole read-modify-write cycle. fn must
+// return a float64 or nil (null), and must not call back into s.
+func (s *Float64Series) Update(i int, fn func(any) any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return update(s.data, s.mask, i, fn, "float64")
+}
+
+// CompareAndSwap sets element i to newVal if its current value equals
+// expected, holding the write lock for the whole comparison and update. A nil
+// expected matches a null element and a nil newVal stores a null; other
+// values must be int64. It reports whether the swap happened.
+func (s *Int64Series) CompareAndSwap(i int, expected, newVal any) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return compareAndSwap(s.data, s.mask, i, expected, newVal, "int64")
+}
+
+// Update replaces element i with fn applied to its current value (nil if
+// null), holding the write lock for the whole read-modify-write cycle. fn must
+// return an int64 or nil (null), and must not call back into s.
+func (s *Int64Series) Update(i int, fn func(any) any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return update(s.data, s.mask, i, fn, "int64")
+}
+
+// compareAndSwap implements CompareAndSwap for typed series. Caller must hold
+// the write lock.
+func compareAndSwap[T comparable](data []T, mask []bool, i int, expected, newVal any, typeName string) (bool, error) {
+	if i < 0 || i >= len(data) {
+		return false, errors.New("index out of range")
+	}
+	var next T
+	if newVal != nil {
+		v, ok := newVal.(T)
+		if !ok {
+			return false, fmt.Errorf("type mismatch: expected %s, got %T", typeName, newVal)
+		}
+		next = v
+	}
+	if expected == nil {
+		if !mask[i] {
+			return false, nil
+		}
+	} else {
+		want, ok := expected.(T)
+		if !ok {
+			return false, fmt.Errorf("type mismatch: expected %s, got %T", typeName, expected)
+		}
+		if mask[i] || data[i] != want {
+			return false, nil
+		}
+	}
+	data[i] = next
+	mask[i] = newVal == nil
+	return true, nil
+}
+
+// update implements Update for typed series. Caller must hold the write lock.
+func update[T any](data []T, mask []bool, i int, fn func(any) any, typeName string) error {
+	if i < 0 || i >= len(data) {
+		return errors.New("index out of range")
+	}
+	var current any
+	if !mask[i] {
+		current = data[i]
+	}
+	result := fn(current)
+	if result == nil {
+		var zero T
+		data[i] = zero
+		mask[i] = true
+		return nil
+	}
+	v, ok := result.(T)
+	if !ok {
+		return fmt.Errorf("type mismatch: expected %s, got %T", typeName, result)
+	}
+	data[i] = v
+	mask[i] = false
+	return nil
+}
@@ -0,0 +1,426 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CastError reports a value that one of the Series As* conversions could not
+// convert to the target type.
+type CastError struct {
+	// Row is the position of the offending value in the source series.
+	Row int
+	// Value is the value that failed to convert.
+	Value any
+	// Target is the name of the target type, e.g. "float64".
+	Target string
+}
+
+// Error implements the error interface.
+func (e *CastError) Error() string {
+	if s, ok := e.Value.(string); ok {
+		return fmt.Sprintf("row %d: cannot convert %q to %s", e.Row, s, e.Target)
+	}
+	return fmt.Sprintf("row %d: cannot convert %T to %s", e.Row, e.Value, e.Target)
+}
+
+// castFloat64 converts a Series to a Float64Series. Numbers are widened,
+// bools become 1/0 and strings are parsed. Nulls are preserved.
+func castFloat64(s Series) (*Float64Series, error) {
+	values := s.ValuesCopy()
+	out := &Float64Series{data: make([]float64, len(values)), mask: make([]bool, len(values))}
+	for i, v := range values {
+		if v == nil {
+			out.mask[i] = true
+			continue
+		}
+		f, ok := valueToFloat64(v)
+		if !ok {
+			return nil, &CastError{Row: i, Value: v, Target: "float64"}
+		}
+		out.data[i] = f
+	}
+	return out, nil
+}
+
+// castInt64 converts a Series to an Int64Series. Floats (and float strings
+// such as "3.7") are truncated toward zero, bools become 1/0. Nulls are
+// preserved.
+func castInt64(s Series) (*Int64Series, error) {
+	values := s.ValuesCopy()
+	out := &Int64Series{data: make([]int64, len(values)), mask: make([]bool, len(values))}
+	for i, v := range values {
+		if v == nil {
+			out.mask[i] = true
+			continue
+		}
+		n, ok := valueToInt64(v)
+		if !ok {
+			return nil, &CastError{Row: i, Value: v, Target: "int64"}
+		}
+		out.data[i] = n
+	}
+	return out, nil
+}
+
+// castString converts a Series to a StringSeries using fmt.Sprintf("%v").
+// Nulls are preserved.
+func castString(s Series) (*StringSeries, error) {
+	values := s.ValuesCopy()
+	out := &StringSeries{data: make([]string, len(values)), mask: make([]bool, len(values))}
+	for i, v := range values {
+		if v == nil {
+			out.mask[i] = true
+			continue
+		}
+		out.data[i] = fmt.Sprintf("%v", v)
+	}
+	return out, nil
+}
+
+// castBool converts a Series to a BoolSeries. Non-zero numbers are true, and
+// strings such as "true"/"false", "yes"/"no" or "1"/"0" are parsed
+// case-insensitively. Nulls are preserved.
+func castBool(s Series) (*BoolSeries, error) {
+	values := s.ValuesCopy()
+	out := &BoolSeries{data: make([]bool, len(values)), mask: make([]bool, len(values))}
+	for i, v := range values {
+		if v == nil {
+			out.mask[i] = true
+			continue
+		}
+		b, ok := valueToBool(v)
+		if !ok {
+			return nil, &CastError{Row: i, Value: v, Target: "bool"}
+		}
+		out.data[i] = b
+	}
+	return out, nil
+}
+
+// Cast converts s to a new Series of the given element type using the same
+// rules as the As* methods. Supported types are float64, float32, int64,
+// int32, string, bool and any (which yields an AnySeries). Nulls are
+// preserved.
+//
+// With strict set, the first value that cannot be converted is reported as a
+// *CastError; otherwise such values become nulls in the result.
+//
+// Example:
+//
+//	out, err := collection.Cast(s, reflect.TypeOf(float64(0)), false)
+func Cast(s Series, dtype reflect.Type, strict bool) (Series, error) {
+	if dtype == nil {
+		return nil, fmt.Errorf("target type must not be nil")
+	}
+	values := s.ValuesCopy()
+	switch dtype.Kind() {
+	case reflect.Float64:
+		data, mask, err := castValues(values, "float64", strict, valueToFloat64)
+		if err != nil {
+			return nil, err
+		}
+		return &Float64Series{data: data, mask: mask}, nil
+	case reflect.Float32:
+		data, mask, err := castValues(values, "float32", strict, func(v any) (float32, bool) {
+			f, ok := valueToFloat64(v)
+			return float32(f), ok
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Float32Series{data: data, mask: mask}, nil
+	case reflect.Int64:
+		data, mask, err := castValues(values, "int64", strict, valueToInt64)
+		if err != nil {
+			return nil, err
+		}
+		return &Int64Series{data: data, mask: mask}, nil
+	case reflect.Int32:
+		data, mask, err := castValues(values, "int32", strict, func(v any) (int32, bool) {
+			n, ok := valueToInt64(v)
+			return int32(n), ok && n >= math.MinInt32 && n <= math.MaxInt32
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &Int32Series{data: data, mask: mask}, nil
+	case reflect.String:
+		data, mask, err := castValues(values, "string", strict, func(v any) (string, bool) {
+			return fmt.Sprintf("%v", v), true
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &StringSeries{data: data, mask: mask}, nil
+	case reflect.Bool:
+		data, mask, err := castValues(values, "bool", strict, valueToBool)
+		if err != nil {
+			return nil, err
+		}
+		return &BoolSeries{data: data, mask: mask}, nil
+	case reflect.Interface:
+		mask := make([]bool, len(values))
+		for i, v := range values {
+			mask[i] = v == nil
+		}
+		return &AnySeries{data: values, mask: mask}, nil
+	default:
+		return nil, fmt.Errorf("unsupported target type %v", dtype)
+	}
+}
+
+// castValues converts each non-nil value with conv. Values conv rejects are
+// reported as a *CastError when strict, and become nulls otherwise.
+func castValues[T any](values []any, target string, strict bool, conv func(any) (T, bool)) ([]T, []bool, error) {
+	data := make([]T, len(values))
+	mask := make([]bool, len(values))
+	for i, v := range values {
+		if v == nil {
+			mask[i] = true
+			continue
+		}
+		converted, ok := conv(v)
+		if !ok {
+			if strict {
+				return nil, nil, &CastError{Row: i, Value: v, Target: target}
+			}
+			mask[i] = true
+			continue
+		}
+		data[i] = converted
+	}
+	return data, mask, nil
+}
+
+// numericValue returns v as a float64 if it has a Go numeric type.
+func numericValue(v any) (float64, bool) {
+	switch x := v.(type) {
+	case float64:
+		return x, true
+	case float32:
+		return float64(x), true
+	case int:
+		return float64(x), true
+	case int64:
+		return float64(x), true
+	case int32:
+		return float64(x), true
+	case int16:
+		return float64(x), true
+	case int8:
+		return float64(x), true
+	case uint:
+		return float64(x), true
+	case uint64:
+		return float64(x), true
+	case uint32:
+		return float64(x), true
+	case uint16:
+		return float64(x), true
+	case uint8:
+		return float64(x), true
+	}
+	return 0, false
+}
+
+func valueToFloat64(v any) (float64, bool) {
+	switch x := v.(type) {
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		return f, err == nil
+	case bool:
+		if x {
+			return 1, true
+		}
+		return 0, true
+	}
+	return numericValue(v)
+}
+
+func valueToInt64(v any) (int64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return x, true
+	case int:
+		return int64(x), true
+	case int32:
+		return int64(x), true
+	case string:
+		s := strings.TrimSpace(x)
+		if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return n, true
+		}
+		// Allow float strings like "3.0"; the fraction is truncated.
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return 0, false
+		}
+		return floatToInt64(f)
+	case bool:
+		if x {
+			return 1, true
+		}
+		return 0, true
+	}
+	f, ok := numericValue(v)
+	if !ok {
+		return 0, false
+	}
+	return floatToInt64(f)
+}
+
+// floatToInt64 truncates f toward zero, reporting false for NaN, ±Inf and
+// values outside the int64 range.
+func floatToInt64(f float64) (int64, bool) {
+	if math.IsNaN(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(f), true
+}
+
+func valueToBool(v any) (bool, bool) {
+	switch x := v.(type) {
+	case bool:
+		return x, true
+	case string:
+		switch strings.ToLower(strings.TrimSpace(x)) {
+		case "true", "1", "t", "yes", "y":
+			return true, true
+		case "false", "0", "f", "no", "n":
+			return false, true
+		}
+		return false, false
+	}
+	f, ok := numericValue(v)
+	return f != 0, ok
+}
+
+// AsFloat64 returns a copy of s converted to float64. Numeric values are
+// widened, bools become 1/0 and strings are parsed. A value that cannot be
+// converted yields a *CastError.
+func AsFloat64(s Series) (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 returns a copy of s converted to int64. Floats are truncated toward
+// zero, and NaN, ±Inf or floats outside the int64 range yield a *CastError;
+// otherwise it behaves like AsFloat64.
+func AsInt64(s Series) (*Int64Series, error) { return castInt64(s) }
+
+// AsString returns a copy of s with every non-null value formatted with
+// fmt.Sprintf("%v", v).
+func AsString(s Series) (*StringSeries, error) { return castString(s) }
+
+// AsBool returns a copy of s converted to bool. Non-zero numbers are true and
+// strings such as "true"/"false" are parsed; other values yield a *CastError.
+func AsBool(s Series) (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *AnySeries) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *AnySeries) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *AnySeries) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *AnySeries) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *Float64Series) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *Float64Series) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *Float64Series) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *Float64Series) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *Int64Series) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *Int64Series) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *Int64Series) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *Int64Series) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *StringSeries) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *StringSeries) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *StringSeries) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *StringSeries) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *BoolSeries) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *BoolSeries) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *BoolSeries) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *BoolSeries) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *DateTimeSeries) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *DateTimeSeries) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *DateTimeSeries) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *DateTimeSeries) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *CategoricalSeries) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *CategoricalSeries) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *CategoricalSeries) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *CategoricalSeries) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *Int32Series) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *Int32Series) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *Int32Series) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *Int32Series) AsBool() (*BoolSeries, error) { return castBool(s) }
+
+// AsFloat64 converts the series to a new Float64Series. See AsFloat64.
+func (s *Float32Series) AsFloat64() (*Float64Series, error) { return castFloat64(s) }
+
+// AsInt64 converts the series to a new Int64Series. See AsInt64.
+func (s *Float32Series) AsInt64() (*Int64Series, error) { return castInt64(s) }
+
+// AsString converts the series to a new StringSeries. See AsString.
+func (s *Float32Series) AsString() (*StringSeries, error) { return castString(s) }
+
+// AsBool converts the series to a new BoolSeries. See AsBool.
+func (s *Float32Series) AsBool() (*BoolSeries, error) { return castBool(s) }
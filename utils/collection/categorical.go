@@ -186,6 +186,21 @@ func (s *CategoricalSeries) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []int32 category codes without copying; code
+// -1 marks a null and other codes index Categories. See RawData for the
+// read-only contract.
+func (s *CategoricalSeries) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.codes
+}
+
+// RawMask returns the null mask. CategoricalSeries encodes nulls in its codes
+// rather than storing a mask, so unlike other series this builds a new slice.
+func (s *CategoricalSeries) RawMask() []bool {
+	return s.MaskCopy()
+}
+
 func (s *CategoricalSeries) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -0,0 +1,182 @@
+package collection
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// ConcatSeries concatenates the given series into a new series whose length
+// is the sum of the input lengths. Null masks are carried over.
+//
+// When all inputs share a dtype, the result has that dtype. Otherwise the
+// result is upcast: a mix of Int64 and Float64 series yields a Float64Series,
+// and any other mix (including any AnySeries input) yields an AnySeries.
+//
+// An error is returned if no series are provided or any input is nil.
+func ConcatSeries(series ...Series) (Series, error) {
+	if len(series) == 0 {
+		return nil, errors.New("no series to concatenate")
+	}
+
+	total := 0
+	for _, s := range series {
+		if s == nil {
+			return nil, errors.New("cannot concatenate nil series")
+		}
+		total += s.Len()
+	}
+
+	out := newConcatTarget(concatDType(series), total)
+	_, toFloat := out.(*Float64Series)
+	for _, s := range series {
+		n := s.Len()
+		for i := 0; i < n; i++ {
+			if s.IsNull(i) {
+				out.AppendNull()
+				continue
+			}
+			v, err := s.At(i)
+			if err != nil {
+				return nil, err
+			}
+			if iv, isInt := v.(int64); isInt && toFloat {
+				v = float64(iv)
+			}
+			if err := out.Append(v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+// concatDType returns the common dtype of the given series, or nil when they
+// can only be combined in an AnySeries.
+func concatDType(series []Series) reflect.Type {
+	anyType := reflect.TypeOf((*any)(nil)).Elem()
+	float64Type := reflect.TypeOf(float64(0))
+	int64Type := reflect.TypeOf(int64(0))
+
+	common := series[0].DType()
+	for _, s := range series[1:] {
+		t := s.DType()
+		switch {
+		case t == common:
+		case (common == int64Type && t == float64Type) || (common == float64Type && t == int64Type):
+			common = float64Type
+		default:
+			return nil
+		}
+	}
+	if common == anyType {
+		return nil
+	}
+	return common
+}
+
+// newConcatTarget returns an empty series able to hold values of type t.
+func newConcatTarget(t reflect.Type, capacity int) Series {
+	if t == reflect.TypeOf(time.Time{}) {
+		return NewDateTimeSeries(capacity)
+	}
+	return NewSeriesOfType(t, capacity)
+}
+
+// ConcatFloat64Series concatenates the given Float64 series into a new
+// Float64Series, copying their data and null masks directly instead of going
+// through the Series interface as ConcatSeries does. An error is returned if
+// no series are provided or any input is nil.
+func ConcatFloat64Series(series ...*Float64Series) (*Float64Series, error) {
+	parts := make([]typedParts[float64], len(series))
+	for i, s := range series {
+		if s == nil {
+			return nil, errors.New("cannot concatenate nil series")
+		}
+		parts[i] = typedParts[float64]{&s.mu, &s.data, &s.mask}
+	}
+	data, mask, err := concatTyped(parts)
+	if err != nil {
+		return nil, err
+	}
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// ConcatInt64Series concatenates the given Int64 series into a new
+// Int64Series. See ConcatFloat64Series.
+func ConcatInt64Series(series ...*Int64Series) (*Int64Series, error) {
+	parts := make([]typedParts[int64], len(series))
+	for i, s := range series {
+		if s == nil {
+			return nil, errors.New("cannot concatenate nil series")
+		}
+		parts[i] = typedParts[int64]{&s.mu, &s.data, &s.mask}
+	}
+	data, mask, err := concatTyped(parts)
+	if err != nil {
+		return nil, err
+	}
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// ConcatStringSeries concatenates the given String series into a new
+// StringSeries. See ConcatFloat64Series.
+func ConcatStringSeries(series ...*StringSeries) (*StringSeries, error) {
+	parts := make([]typedParts[string], len(series))
+	for i, s := range series {
+		if s == nil {
+			return nil, errors.New("cannot concatenate nil series")
+		}
+		parts[i] = typedParts[string]{&s.mu, &s.data, &s.mask}
+	}
+	data, mask, err := concatTyped(parts)
+	if err != nil {
+		return nil, err
+	}
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// ConcatBoolSeries concatenates the given Bool series into a new BoolSeries.
+// See ConcatFloat64Series.
+func ConcatBoolSeries(series ...*BoolSeries) (*BoolSeries, error) {
+	parts := make([]typedParts[bool], len(series))
+	for i, s := range series {
+		if s == nil {
+			return nil, errors.New("cannot concatenate nil series")
+		}
+		parts[i] = typedParts[bool]{&s.mu, &s.data, &s.mask}
+	}
+	data, mask, err := concatTyped(parts)
+	if err != nil {
+		return nil, err
+	}
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// typedParts refers to the lock, data and mask of one input of concatTyped.
+type typedParts[T any] struct {
+	mu   *sync.RWMutex
+	data *[]T
+	mask *[]bool
+}
+
+// concatTyped copies the data and masks of parts, in order, into new slices,
+// holding each part's read lock while it is copied.
+func concatTyped[T any](parts []typedParts[T]) ([]T, []bool, error) {
+	if len(parts) == 0 {
+		return nil, nil, errors.New("no series to concatenate")
+	}
+	var data []T
+	var mask []bool
+	for _, p := range parts {
+		p.mu.RLock()
+		data = append(data, *p.data...)
+		mask = append(mask, *p.mask...)
+		p.mu.RUnlock()
+	}
+	if data == nil {
+		data, mask = []T{}, []bool{}
+	}
+	return data, mask, nil
+}
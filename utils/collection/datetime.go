@@ -160,6 +160,21 @@ func (s *DateTimeSeries) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []time.Time without copying. See RawData for
+// the read-only contract.
+func (s *DateTimeSeries) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *DateTimeSeries) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
 func (s *DateTimeSeries) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -0,0 +1,103 @@
+package collection
+
+import "fmt"
+
+// dropNullData returns the elements of data whose mask bit is false, together
+// with an all-false mask of the same length.
+func dropNullData[T any](data []T, mask []bool) ([]T, []bool) {
+	outData := make([]T, 0, len(data))
+	for i, v := range data {
+		if !mask[i] {
+			outData = append(outData, v)
+		}
+	}
+	return outData, make([]bool, len(outData))
+}
+
+// DropNa returns a new Series of the same type as s containing only the
+// non-null elements, in their original order.
+func DropNa(s Series) (Series, error) {
+	d, ok := s.(interface{ DropNa() (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("DropNa: unsupported series type %T", s)
+	}
+	return d.DropNa()
+}
+
+// DropNa returns a new AnySeries containing only the non-null elements of s.
+func (s *AnySeries) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new Float64Series containing only the non-null elements of s.
+func (s *Float64Series) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new Int64Series containing only the non-null elements of s.
+func (s *Int64Series) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new Int32Series containing only the non-null elements of s.
+func (s *Int32Series) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new Float32Series containing only the non-null elements of s.
+func (s *Float32Series) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new StringSeries containing only the non-null elements of s.
+func (s *StringSeries) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new BoolSeries containing only the non-null elements of s.
+func (s *BoolSeries) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new DateTimeSeries containing only the non-null elements of s.
+func (s *DateTimeSeries) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := dropNullData(s.data, s.mask)
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// DropNa returns a new CategoricalSeries containing only the non-null elements
+// of s. The result shares the same categories.
+func (s *CategoricalSeries) DropNa() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	codes := make([]int32, 0, len(s.codes))
+	for _, c := range s.codes {
+		if c >= 0 {
+			codes = append(codes, c)
+		}
+	}
+	return s.withCodes(codes), nil
+}
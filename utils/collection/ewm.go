@@ -0,0 +1,170 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+)
+
+// EWMMean returns the exponentially weighted moving average of s as a new
+// Float64Series. alpha is the smoothing factor and must satisfy
+// 0 < alpha <= 1. With adjust the average at position i weights the j-th
+// most recent observation by (1-alpha)^j and divides by the sum of the
+// weights; without it the recursive form y[i] = (1-alpha)*y[i-1] + alpha*x[i]
+// is used. Nulls are skipped but still age the earlier observations. A
+// position is null until at least minPeriods non-null values have been seen
+// (a minPeriods of 0 is treated as 1).
+//
+// This is analogous to Series.ewm(alpha=alpha, adjust=adjust,
+// min_periods=minPeriods).mean() in pandas.
+func (s *Float64Series) EWMMean(alpha float64, adjust bool, minPeriods int) (*Float64Series, error) {
+	if err := checkEWMParams(alpha, minPeriods); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := len(s.data)
+	out := make([]float64, n)
+	outMask := make([]bool, n)
+	oldWtFactor := 1 - alpha
+	newWt := 1.0
+	if !adjust {
+		newWt = alpha
+	}
+
+	started := false
+	var weighted, oldWt float64
+	nobs := 0
+	for i, x := range s.data {
+		isObs := !s.mask[i]
+		if isObs {
+			nobs++
+		}
+		switch {
+		case started:
+			oldWt *= oldWtFactor
+			if isObs {
+				if weighted != x {
+					weighted = (oldWt*weighted + newWt*x) / (oldWt + newWt)
+				}
+				if adjust {
+					oldWt += newWt
+				} else {
+					oldWt = 1
+				}
+			}
+		case isObs:
+			started = true
+			weighted = x
+			oldWt = 1
+		}
+		if nobs < max(minPeriods, 1) {
+			outMask[i] = true
+			continue
+		}
+		out[i] = weighted
+	}
+	return &Float64Series{data: out, mask: outMask}, nil
+}
+
+// EWMVar returns the exponentially weighted moving variance of s as a new
+// Float64Series, using the same weights as EWMMean and the bias correction
+// for weighted samples, so that equal weights give the sample variance.
+// Positions with fewer than minPeriods non-null values are null; positions
+// where the variance is undefined (a single observation) are NaN.
+//
+// This is analogous to Series.ewm(alpha=alpha, adjust=adjust,
+// min_periods=minPeriods).var() in pandas.
+func (s *Float64Series) EWMVar(alpha float64, adjust bool, minPeriods int) (*Float64Series, error) {
+	if err := checkEWMParams(alpha, minPeriods); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	n := len(s.data)
+	out := make([]float64, n)
+	outMask := make([]bool, n)
+	oldWtFactor := 1 - alpha
+	newWt := 1.0
+	if !adjust {
+		newWt = alpha
+	}
+
+	started := false
+	var mean, cov, sumWt, sumWt2, oldWt float64
+	nobs := 0
+	for i, x := range s.data {
+		isObs := !s.mask[i]
+		if isObs {
+			nobs++
+		}
+		switch {
+		case started:
+			sumWt *= oldWtFactor
+			sumWt2 *= oldWtFactor * oldWtFactor
+			oldWt *= oldWtFactor
+			if isObs {
+				oldMean := mean
+				if mean != x {
+					mean = (oldWt*oldMean + newWt*x) / (oldWt + newWt)
+				}
+				d := oldMean - mean
+				cov = (oldWt*(cov+d*d) + newWt*(x-mean)*(x-mean)) / (oldWt + newWt)
+				sumWt += newWt
+				sumWt2 += newWt * newWt
+				oldWt += newWt
+				if !adjust {
+					sumWt /= oldWt
+					sumWt2 /= oldWt * oldWt
+					oldWt = 1
+				}
+			}
+		case isObs:
+			started = true
+			mean = x
+			cov, sumWt, sumWt2, oldWt = 0, 1, 1, 1
+		}
+		if nobs < max(minPeriods, 1) {
+			outMask[i] = true
+			continue
+		}
+		numerator := sumWt * sumWt
+		denominator := numerator - sumWt2
+		if denominator > 0 {
+			out[i] = numerator / denominator * cov
+		} else {
+			out[i] = math.NaN()
+		}
+	}
+	return &Float64Series{data: out, mask: outMask}, nil
+}
+
+// EWMStd returns the exponentially weighted moving standard deviation of s,
+// the square root of EWMVar.
+//
+// This is analogous to Series.ewm(alpha=alpha, adjust=adjust,
+// min_periods=minPeriods).std() in pandas.
+func (s *Float64Series) EWMStd(alpha float64, adjust bool, minPeriods int) (*Float64Series, error) {
+	out, err := s.EWMVar(alpha, adjust, minPeriods)
+	if err != nil {
+		return nil, err
+	}
+	for i, v := range out.data {
+		if !out.mask[i] {
+			out.data[i] = math.Sqrt(v)
+		}
+	}
+	return out, nil
+}
+
+// checkEWMParams validates the parameters shared by the EWM methods.
+func checkEWMParams(alpha float64, minPeriods int) error {
+	if !(alpha > 0 && alpha <= 1) {
+		return fmt.Errorf("alpha must satisfy 0 < alpha <= 1, got %v", alpha)
+	}
+	if minPeriods < 0 {
+		return fmt.Errorf("min periods must be >= 0, got %d", minPeriods)
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// fillValueData returns copies of data and mask with every null replaced by v,
+// which must be of type T.
+func fillValueData[T any](data []T, mask []bool, v any, typeName string) ([]T, []bool, error) {
+	if v == nil {
+		return nil, nil, errors.New("fill value must not be nil")
+	}
+	val, ok := v.(T)
+	if !ok {
+		return nil, nil, fmt.Errorf("type mismatch: expected %s, got %T", typeName, v)
+	}
+	outData := make([]T, len(data))
+	outMask := make([]bool, len(mask))
+	for i := range data {
+		if mask[i] {
+			outData[i] = val
+		} else {
+			outData[i] = data[i]
+		}
+	}
+	return outData, outMask, nil
+}
+
+// fillForwardData returns copies of data and mask with each null replaced by
+// the last preceding non-null value.
+func fillForwardData[T any](data []T, mask []bool) ([]T, []bool) {
+	outData := append([]T(nil), data...)
+	outMask := append([]bool(nil), mask...)
+	last := -1
+	for i := range outData {
+		if !outMask[i] {
+			last = i
+		} else if last >= 0 {
+			outData[i] = outData[last]
+			outMask[i] = false
+		}
+	}
+	return outData, outMask
+}
+
+// fillBackwardData returns copies of data and mask with each null replaced by
+// the next following non-null value.
+func fillBackwardData[T any](data []T, mask []bool) ([]T, []bool) {
+	outData := append([]T(nil), data...)
+	outMask := append([]bool(nil), mask...)
+	next := -1
+	for i := len(outData) - 1; i >= 0; i-- {
+		if !outMask[i] {
+			next = i
+		} else if next >= 0 {
+			outData[i] = outData[next]
+			outMask[i] = false
+		}
+	}
+	return outData, outMask
+}
+
+// FillValue returns a new Series of the same type as s with every null
+// replaced by v, which must match the element type (as for Set).
+func FillValue(s Series, v any) (Series, error) {
+	f, ok := s.(interface{ FillValue(any) (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("FillValue: unsupported series type %T", s)
+	}
+	return f.FillValue(v)
+}
+
+// FillForward returns a new Series of the same type as s with each null
+// replaced by the last preceding non-null value. Leading nulls remain null.
+func FillForward(s Series) (Series, error) {
+	f, ok := s.(interface{ FillForward() (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("FillForward: unsupported series type %T", s)
+	}
+	return f.FillForward()
+}
+
+// FillBackward returns a new Series of the same type as s with each null
+// replaced by the next following non-null value. Trailing nulls remain null.
+func FillBackward(s Series) (Series, error) {
+	f, ok := s.(interface{ FillBackward() (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("FillBackward: unsupported series type %T", s)
+	}
+	return f.FillBackward()
+}
+
+// FillValue returns a new AnySeries with every null replaced by v.
+func (s *AnySeries) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "any")
+	if err != nil {
+		return nil, err
+	}
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new AnySeries with nulls forward-filled.
+func (s *AnySeries) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new AnySeries with nulls backward-filled.
+func (s *AnySeries) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new Float64Series with every null replaced by v, which must be
+// a float64.
+func (s *Float64Series) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "float64")
+	if err != nil {
+		return nil, err
+	}
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new Float64Series with nulls forward-filled.
+func (s *Float64Series) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new Float64Series with nulls backward-filled.
+func (s *Float64Series) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new Int64Series with every null replaced by v, which must be
+// a int64.
+func (s *Int64Series) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "int64")
+	if err != nil {
+		return nil, err
+	}
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new Int64Series with nulls forward-filled.
+func (s *Int64Series) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new Int64Series with nulls backward-filled.
+func (s *Int64Series) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new Int32Series with every null replaced by v, which must be
+// a int32.
+func (s *Int32Series) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "int32")
+	if err != nil {
+		return nil, err
+	}
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new Int32Series with nulls forward-filled.
+func (s *Int32Series) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new Int32Series with nulls backward-filled.
+func (s *Int32Series) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new Float32Series with every null replaced by v, which must be
+// a float32.
+func (s *Float32Series) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "float32")
+	if err != nil {
+		return nil, err
+	}
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new Float32Series with nulls forward-filled.
+func (s *Float32Series) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new Float32Series with nulls backward-filled.
+func (s *Float32Series) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new StringSeries with every null replaced by v, which must be
+// a string.
+func (s *StringSeries) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "string")
+	if err != nil {
+		return nil, err
+	}
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new StringSeries with nulls forward-filled.
+func (s *StringSeries) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new StringSeries with nulls backward-filled.
+func (s *StringSeries) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new BoolSeries with every null replaced by v, which must be
+// a bool.
+func (s *BoolSeries) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "bool")
+	if err != nil {
+		return nil, err
+	}
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new BoolSeries with nulls forward-filled.
+func (s *BoolSeries) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new BoolSeries with nulls backward-filled.
+func (s *BoolSeries) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new DateTimeSeries with every null replaced by v, which must be
+// a time.Time.
+func (s *DateTimeSeries) FillValue(v any) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := fillValueData(s.data, s.mask, v, "time.Time")
+	if err != nil {
+		return nil, err
+	}
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// FillForward returns a new DateTimeSeries with nulls forward-filled.
+func (s *DateTimeSeries) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillForwardData(s.data, s.mask)
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// FillBackward returns a new DateTimeSeries with nulls backward-filled.
+func (s *DateTimeSeries) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := fillBackwardData(s.data, s.mask)
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// FillValue returns a new CategoricalSeries with every null replaced by v,
+// which must be a string. A value that is not yet a category is added as a new
+// category of the result.
+func (s *CategoricalSeries) FillValue(v any) (Series, error) {
+	if v == nil {
+		return nil, errors.New("fill value must not be nil")
+	}
+	str, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("type mismatch: expected string, got %T", v)
+	}
+	s.mu.RLock()
+	out := s.withCodes(append([]int32(nil), s.codes...))
+	s.mu.RUnlock()
+	code := out.codeFor(str)
+	for i, c := range out.codes {
+		if c < 0 {
+			out.codes[i] = code
+		}
+	}
+	return out, nil
+}
+
+// FillForward returns a new CategoricalSeries with nulls forward-filled. The
+// result shares the same categories.
+func (s *CategoricalSeries) FillForward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	codes, _ := fillForwardData(s.codes, s.nullMask())
+	return s.withCodes(codes), nil
+}
+
+// FillBackward returns a new CategoricalSeries with nulls backward-filled.
+// The result shares the same categories.
+func (s *CategoricalSeries) FillBackward() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	codes, _ := fillBackwardData(s.codes, s.nullMask())
+	return s.withCodes(codes), nil
+}
+
+// nullMask returns the null mask implied by negative codes. Caller must hold
+// the read lock.
+func (s *CategoricalSeries) nullMask() []bool {
+	mask := make([]bool, len(s.codes))
+	for i, c := range s.codes {
+		mask[i] = c < 0
+	}
+	return mask
+}
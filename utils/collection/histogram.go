@@ -0,0 +1,153 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Histogram counts the non-null, finite values in bins equal-width buckets
+// spanning [min, max]; NaN and ±Inf are ignored. edges has bins+1 elements:
+// the left edge of every bucket plus the right edge of the last one. Each
+// bucket is half-open [left, right), except the last, which also includes
+// max. If all values are equal, the range is widened to [v-0.5, v+0.5].
+//
+// An error is returned if bins is not positive or the series has no non-null
+// finite values.
+//
+// This is analogous to numpy.histogram(s.dropna(), bins=bins) in Python.
+//
+// Example:
+//
+//	edges, counts, err := s.Histogram(10)
+func (s *Float64Series) Histogram(bins int) (*Float64Series, *Int64Series, error) {
+	s.mu.RLock()
+	values := nonNullFloat64s(s.data, s.mask)
+	s.mu.RUnlock()
+	return histogram(values, bins)
+}
+
+// HistogramCustom counts the non-null, finite values falling into the buckets
+// defined by edges, which must contain at least two strictly increasing
+// values. Each bucket is half-open [edges[i], edges[i+1]), except the last,
+// which also includes its right edge. Values outside the edges are not
+// counted.
+//
+// Example:
+//
+//	counts, err := s.HistogramCustom([]float64{0, 18, 65, 120})
+func (s *Float64Series) HistogramCustom(edges []float64) (*Int64Series, error) {
+	s.mu.RLock()
+	values := nonNullFloat64s(s.data, s.mask)
+	s.mu.RUnlock()
+	return histogramCustom(values, edges)
+}
+
+// Histogram counts the non-null values in bins equal-width buckets spanning
+// [min, max]. See Float64Series.Histogram for details.
+func (s *Int64Series) Histogram(bins int) (*Float64Series, *Int64Series, error) {
+	s.mu.RLock()
+	values := nonNullInt64sAsFloat(s.data, s.mask)
+	s.mu.RUnlock()
+	return histogram(values, bins)
+}
+
+// HistogramCustom counts the non-null values falling into the buckets defined
+// by edges. See Float64Series.HistogramCustom for details.
+func (s *Int64Series) HistogramCustom(edges []float64) (*Int64Series, error) {
+	s.mu.RLock()
+	values := nonNullInt64sAsFloat(s.data, s.mask)
+	s.mu.RUnlock()
+	return histogramCustom(values, edges)
+}
+
+// nonNullFloat64s returns the values whose mask entry is false, skipping NaN
+// and ±Inf, which have no place in a finite bucket.
+func nonNullFloat64s(data []float64, mask []bool) []float64 {
+	out := make([]float64, 0, len(data))
+	for i, v := range data {
+		if !mask[i] && !math.IsNaN(v) && !math.IsInf(v, 0) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// nonNullInt64sAsFloat returns the values whose mask entry is false,
+// converted to float64.
+func nonNullInt64sAsFloat(data []int64, mask []bool) []float64 {
+	out := make([]float64, 0, len(data))
+	for i, v := range data {
+		if !mask[i] {
+			out = append(out, float64(v))
+		}
+	}
+	return out
+}
+
+// histogram computes equal-width bucket edges and counts for values.
+func histogram(values []float64, bins int) (*Float64Series, *Int64Series, error) {
+	if bins <= 0 {
+		return nil, nil, fmt.Errorf("bins must be positive, got %d", bins)
+	}
+	if len(values) == 0 {
+		return nil, nil, errors.New("cannot compute histogram of a series without non-null finite values")
+	}
+
+	lo, hi := values[0], values[0]
+	for _, v := range values[1:] {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	if lo == hi {
+		lo -= 0.5
+		hi += 0.5
+	}
+
+	edges := make([]float64, bins+1)
+	width := (hi - lo) / float64(bins)
+	for i := range edges {
+		edges[i] = lo + float64(i)*width
+	}
+	edges[bins] = hi
+
+	counts := make([]int64, bins)
+	for _, v := range values {
+		b := min(max(int((v-lo)/width), 0), bins-1)
+		counts[b]++
+	}
+
+	return &Float64Series{data: edges, mask: make([]bool, len(edges))},
+		&Int64Series{data: counts, mask: make([]bool, len(counts))}, nil
+}
+
+// histogramCustom counts values into the buckets defined by edges.
+func histogramCustom(values []float64, edges []float64) (*Int64Series, error) {
+	if len(edges) < 2 {
+		return nil, fmt.Errorf("at least two edges are required, got %d", len(edges))
+	}
+	for i := 1; i < len(edges); i++ {
+		if !(edges[i] > edges[i-1]) {
+			return nil, fmt.Errorf("edges must be strictly increasing, got %v after %v", edges[i], edges[i-1])
+		}
+	}
+
+	bins := len(edges) - 1
+	counts := make([]int64, bins)
+	for _, v := range values {
+		if v < edges[0] || v > edges[bins] {
+			continue
+		}
+		// Index of the first edge greater than v, minus one, is v's bucket.
+		b := sort.SearchFloat64s(edges, v)
+		if b == len(edges) || edges[b] != v {
+			b--
+		}
+		if b >= bins {
+			b = bins - 1
+		}
+		counts[b]++
+	}
+	return &Int64Series{data: counts, mask: make([]bool, bins)}, nil
+}
@@ -0,0 +1,159 @@
+package collection
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Infer returns a new typed Series holding the values of s converted to the
+// most specific type that fits every non-null value:
+//   - Int64Series if all values are Go integers or strings that parse as one
+//   - Float64Series if all values are numbers or strings that parse as one
+//   - BoolSeries if all values are bools or the strings "true"/"false"
+//     (case-insensitive)
+//   - DateTimeSeries if all values are time.Time
+//   - StringSeries otherwise, with values formatted via fmt.Sprintf("%v")
+//
+// The null mask is carried over, with nil values also treated as null. A
+// series with no non-null values cannot be inferred and is returned as a copy
+// of the AnySeries.
+//
+// This is analogous to Series.infer_objects() in pandas, extended to parse
+// strings, which makes it useful after Read_csv or after a Map whose function
+// returns mixed types.
+//
+// Example:
+//
+//	s, _ := collection.NewAnySeriesFromData([]any{"1", 2, nil}, nil)
+//	typed, _ := s.Infer() // *Int64Series [1 2 <nil>]
+func (s *AnySeries) Infer() (Series, error) {
+	s.mu.RLock()
+	data := make([]any, len(s.data))
+	copy(data, s.data)
+	mask := make([]bool, len(s.mask))
+	copy(mask, s.mask)
+	s.mu.RUnlock()
+
+	hasValue := false
+	allInt, allFloat, allBool, allTime := true, true, true, true
+	for i, v := range data {
+		if mask[i] || v == nil {
+			continue
+		}
+		hasValue = true
+		if allInt {
+			_, allInt = inferInt64(v)
+		}
+		if allFloat {
+			_, allFloat = inferFloat64(v)
+		}
+		if allBool {
+			_, allBool = inferBool(v)
+		}
+		if allTime {
+			_, allTime = v.(time.Time)
+		}
+	}
+	if !hasValue {
+		return NewAnySeriesFromData(data, nullMask(data, mask))
+	}
+
+	switch {
+	case allInt:
+		out := make([]int64, len(data))
+		for i, v := range data {
+			if !mask[i] && v != nil {
+				out[i], _ = inferInt64(v)
+			}
+		}
+		return NewInt64SeriesFromData(out, nullMask(data, mask))
+	case allFloat:
+		out := make([]float64, len(data))
+		for i, v := range data {
+			if !mask[i] && v != nil {
+				out[i], _ = inferFloat64(v)
+			}
+		}
+		return NewFloat64SeriesFromData(out, nullMask(data, mask))
+	case allBool:
+		out := make([]bool, len(data))
+		for i, v := range data {
+			if !mask[i] && v != nil {
+				out[i], _ = inferBool(v)
+			}
+		}
+		return NewBoolSeriesFromData(out, nullMask(data, mask))
+	case allTime:
+		out := make([]time.Time, len(data))
+		for i, v := range data {
+			if !mask[i] && v != nil {
+				out[i] = v.(time.Time)
+			}
+		}
+		return NewDateTimeSeriesFromData(out, nullMask(data, mask))
+	default:
+		out := make([]string, len(data))
+		for i, v := range data {
+			if !mask[i] && v != nil {
+				out[i] = fmt.Sprintf("%v", v)
+			}
+		}
+		return NewStringSeriesFromData(out, nullMask(data, mask))
+	}
+}
+
+// nullMask returns mask with nil values also marked as null.
+func nullMask(data []any, mask []bool) []bool {
+	out := make([]bool, len(data))
+	for i, v := range data {
+		out[i] = mask[i] || v == nil
+	}
+	return out
+}
+
+// inferInt64 reports whether v is a Go integer or a string holding one.
+func inferInt64(v any) (int64, bool) {
+	switch x := v.(type) {
+	case int:
+		return int64(x), true
+	case int64:
+		return x, true
+	case int32:
+		return int64(x), true
+	case int16:
+		return int64(x), true
+	case int8:
+		return int64(x), true
+	case string:
+		n, err := strconv.ParseInt(strings.TrimSpace(x), 10, 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// inferFloat64 reports whether v is a Go number or a string holding one.
+func inferFloat64(v any) (float64, bool) {
+	if s, ok := v.(string); ok {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return f, err == nil
+	}
+	return numericValue(v)
+}
+
+// inferBool reports whether v is a bool or the string "true"/"false".
+func inferBool(v any) (bool, bool) {
+	switch x := v.(type) {
+	case bool:
+		return x, true
+	case string:
+		switch strings.ToLower(strings.TrimSpace(x)) {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+	}
+	return false, false
+}
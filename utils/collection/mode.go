@@ -0,0 +1,158 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// modeData returns the most frequent non-null elements of data in ascending
+// order. Occurrences are counted in a map keyed by key(v).
+func modeData[T any, K comparable](data []T, mask []bool, key func(T) K, compare func(a, b T) int) []T {
+	counts := make(map[K]int)
+	first := make(map[K]T)
+	best := 0
+	for i, v := range data {
+		if mask[i] {
+			continue
+		}
+		k := key(v)
+		counts[k]++
+		if counts[k] == 1 {
+			first[k] = v
+		}
+		best = max(best, counts[k])
+	}
+
+	modes := make([]T, 0, 1)
+	for k, n := range counts {
+		if n == best {
+			modes = append(modes, first[k])
+		}
+	}
+	sort.Slice(modes, func(i, j int) bool {
+		return compare(modes[i], modes[j]) < 0
+	})
+	return modes
+}
+
+func identity[T any](v T) T { return v }
+
+// Mode returns a new Series of the same type as s holding the most frequent
+// non-null value(s), with ties in ascending order. An all-null series yields
+// an empty Series.
+func Mode(s Series) (Series, error) {
+	m, ok := s.(interface{ Mode() (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("Mode: unsupported series type %T", s)
+	}
+	return m.Mode()
+}
+
+// Mode returns the most frequent value(s) of s. See Mode. Values are
+// counted by equality where their type allows it and by their %#v formatting
+// otherwise; ties are ordered as by compareAny, falling back to formatting.
+func (s *AnySeries) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, s.mask, func(v any) any {
+		if v != nil && !reflect.TypeOf(v).Comparable() {
+			return fmt.Sprintf("%#v", v)
+		}
+		return v
+	}, func(a, b any) int {
+		if c, err := compareAny(a, b); err == nil {
+			return c
+		}
+		return strings.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+	})
+	return &AnySeries{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s. See Mode. NaN is
+// treated as null and -0 counts as 0.
+func (s *Float64Series) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, nanAsNull(s.data, s.mask), floatKey, cmp.Compare[float64])
+	return &Float64Series{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s. See Mode. NaN is
+// treated as null and -0 counts as 0.
+func (s *Float32Series) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, nanAsNull(s.data, s.mask), func(v float32) uint64 {
+		return floatKey(float64(v))
+	}, cmp.Compare[float32])
+	return &Float32Series{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// nanAsNull returns a copy of mask that is also true wherever data is NaN.
+func nanAsNull[T float32 | float64](data []T, mask []bool) []bool {
+	out := make([]bool, len(mask))
+	for i, v := range data {
+		out[i] = mask[i] || v != v
+	}
+	return out
+}
+
+// Mode returns the most frequent value(s) of s. See Mode.
+func (s *Int64Series) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, s.mask, identity[int64], cmp.Compare[int64])
+	return &Int64Series{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s. See Mode.
+func (s *Int32Series) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, s.mask, identity[int32], cmp.Compare[int32])
+	return &Int32Series{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s. See Mode.
+func (s *StringSeries) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, s.mask, identity[string], strings.Compare)
+	return &StringSeries{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s. See Mode.
+func (s *BoolSeries) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, s.mask, identity[bool], compareBools)
+	return &BoolSeries{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s. See Mode. Times are
+// counted as equal when they denote the same instant.
+func (s *DateTimeSeries) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data := modeData(s.data, s.mask, func(t time.Time) time.Time { return t.Round(0).UTC() }, compareTimes)
+	return &DateTimeSeries{data: data, mask: make([]bool, len(data))}, nil
+}
+
+// Mode returns the most frequent value(s) of s, ordering ties by category
+// string. See Mode. The result shares the same categories.
+func (s *CategoricalSeries) Mode() (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	mask := make([]bool, len(s.codes))
+	for i, c := range s.codes {
+		mask[i] = c < 0
+	}
+	codes := modeData(s.codes, mask, identity[int32], func(a, b int32) int {
+		return strings.Compare(s.categories[a], s.categories[b])
+	})
+	return s.withCodes(codes), nil
+}
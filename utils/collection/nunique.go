@@ -0,0 +1,128 @@
+package collection
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// nunique returns the number of distinct non-null elements of data, counted
+// in a hash set keyed by key(v), plus one if dropNa is false and data holds a
+// null.
+func nunique[T any, K comparable](data []T, mask []bool, key func(T) K, dropNa bool) int {
+	seen := make(map[K]struct{})
+	hasNull := false
+	for i, v := range data {
+		if mask[i] {
+			hasNull = true
+			continue
+		}
+		seen[key(v)] = struct{}{}
+	}
+	if hasNull && !dropNa {
+		return len(seen) + 1
+	}
+	return len(seen)
+}
+
+// floatKey maps f to a hashable key under which every NaN is one value and
+// -0 equals +0.
+func floatKey(f float64) uint64 {
+	switch {
+	case math.IsNaN(f):
+		return math.Float64bits(math.NaN())
+	case f == 0:
+		return 0
+	}
+	return math.Float64bits(f)
+}
+
+// NUnique returns the number of distinct non-null values of s. If dropNa is
+// false and s holds any nulls, null counts as one more value. A Series
+// implemented outside this package is counted through its ValuesCopy, as for
+// an AnySeries.
+func NUnique(s Series, dropNa bool) int {
+	if n, ok := s.(interface{ NUnique(bool) int }); ok {
+		return n.NUnique(dropNa)
+	}
+	return (&AnySeries{data: s.ValuesCopy(), mask: s.MaskCopy()}).NUnique(dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+// Values whose type is not comparable are told apart by their %#v
+// formatting.
+func (s *AnySeries) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, func(v any) any {
+		if v != nil && !reflect.TypeOf(v).Comparable() {
+			return fmt.Sprintf("%#v", v)
+		}
+		return v
+	}, dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+// All NaN values count as a single value.
+func (s *Float64Series) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, floatKey, dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+// All NaN values count as a single value.
+func (s *Float32Series) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, func(f float32) uint64 { return floatKey(float64(f)) }, dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+func (s *Int64Series) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, identity[int64], dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+func (s *Int32Series) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, identity[int32], dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+func (s *StringSeries) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, identity[string], dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+func (s *BoolSeries) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, identity[bool], dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+// Times are compared as instants, regardless of location.
+func (s *DateTimeSeries) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return nunique(s.data, s.mask, func(t time.Time) time.Time { return t.Round(0).UTC() }, dropNa)
+}
+
+// NUnique returns the number of distinct values of s. See NUnique.
+// Only categories that occur in s are counted.
+func (s *CategoricalSeries) NUnique(dropNa bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	mask := make([]bool, len(s.codes))
+	for i, c := range s.codes {
+		mask[i] = c < 0
+	}
+	return nunique(s.codes, mask, identity[int32], dropNa)
+}
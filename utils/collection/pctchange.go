@@ -0,0 +1,42 @@
+package collection
+
+// PctChange returns the fractional change between each element and the one
+// periods positions earlier, (s[i] - s[i-periods]) / s[i-periods], as a new
+// Float64Series. A negative periods compares with later elements instead. The
+// result is null where the other position is out of range, where either
+// value is null, and where the earlier value is zero.
+//
+// This is analogous to Series.pct_change(periods) in pandas, without its
+// default forward filling of nulls.
+func (s *Float64Series) PctChange(periods int) (*Float64Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := pctChange(s.data, s.mask, periods)
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// PctChange returns the fractional change between each element and the one
+// periods positions earlier as a new Float64Series. See
+// Float64Series.PctChange.
+func (s *Int64Series) PctChange(periods int) (*Float64Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask := pctChange(s.data, s.mask, periods)
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// pctChange implements PctChange. Caller must hold the read lock.
+func pctChange[T int64 | float64](data []T, mask []bool, periods int) ([]float64, []bool) {
+	n := len(data)
+	out := make([]float64, n)
+	outMask := make([]bool, n)
+	for i := range data {
+		prev := i - periods
+		if prev < 0 || prev >= n || mask[i] || mask[prev] || data[prev] == 0 {
+			outMask[i] = true
+			continue
+		}
+		out[i] = (float64(data[i]) - float64(data[prev])) / float64(data[prev])
+	}
+	return out, outMask
+}
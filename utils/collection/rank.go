@@ -0,0 +1,115 @@
+package collection
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Rank methods accepted by Rank. They control how tied values are ranked:
+//   - "average": the mean of the ranks the tied values would occupy
+//   - "min": the lowest rank in the tie group
+//   - "max": the highest rank in the tie group
+//   - "first": ranks assigned in order of appearance
+//   - "dense": like "min", but ranks increase by 1 between groups
+const (
+	RankAverage = "average"
+	RankMin     = "min"
+	RankMax     = "max"
+	RankFirst   = "first"
+	RankDense   = "dense"
+)
+
+// Rank returns the 1-based rank of each value as a new Float64Series. Null
+// values stay null and are not ranked. method selects the tie-breaking rule
+// (see RankAverage and friends); an empty method means "average".
+//
+// This is analogous to Series.rank(method=..., ascending=...) in pandas.
+func (s *Float64Series) Rank(method string, ascending bool) (*Float64Series, error) {
+	s.mu.RLock()
+	values := make([]float64, len(s.data))
+	copy(values, s.data)
+	mask := make([]bool, len(s.mask))
+	copy(mask, s.mask)
+	s.mu.RUnlock()
+
+	ranks, err := RankFloat64s(values, mask, method, ascending)
+	if err != nil {
+		return nil, err
+	}
+	return &Float64Series{data: ranks, mask: mask}, nil
+}
+
+// Rank returns the 1-based rank of each value as a new Float64Series. Null
+// values stay null and are not ranked. See Float64Series.Rank for the
+// supported methods.
+func (s *Int64Series) Rank(method string, ascending bool) (*Float64Series, error) {
+	s.mu.RLock()
+	values := make([]float64, len(s.data))
+	for i, v := range s.data {
+		values[i] = float64(v)
+	}
+	mask := make([]bool, len(s.mask))
+	copy(mask, s.mask)
+	s.mu.RUnlock()
+
+	ranks, err := RankFloat64s(values, mask, method, ascending)
+	if err != nil {
+		return nil, err
+	}
+	return &Float64Series{data: ranks, mask: mask}, nil
+}
+
+// RankFloat64s ranks values, skipping positions where mask is true (a nil
+// mask means no nulls). The returned slice has the same length as values,
+// with 0 at null positions.
+func RankFloat64s(values []float64, mask []bool, method string, ascending bool) ([]float64, error) {
+	if method == "" {
+		method = RankAverage
+	}
+	switch method {
+	case RankAverage, RankMin, RankMax, RankFirst, RankDense:
+	default:
+		return nil, fmt.Errorf("unsupported rank method %q", method)
+	}
+
+	order := make([]int, 0, len(values))
+	for i := range values {
+		if mask == nil || !mask[i] {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if ascending {
+			return values[order[a]] < values[order[b]]
+		}
+		return values[order[a]] > values[order[b]]
+	})
+
+	ranks := make([]float64, len(values))
+	dense := 0.0
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && values[order[end]] == values[order[start]] {
+			end++
+		}
+		dense++
+		for k := start; k < end; k++ {
+			var r float64
+			switch method {
+			case RankAverage:
+				r = float64(start+end+1) / 2
+			case RankMin:
+				r = float64(start + 1)
+			case RankMax:
+				r = float64(end)
+			case RankFirst:
+				r = float64(k + 1)
+			case RankDense:
+				r = dense
+			}
+			ranks[order[k]] = r
+		}
+		start = end
+	}
+	return ranks, nil
+}
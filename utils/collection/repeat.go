@@ -0,0 +1,276 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+// repeatData returns data and mask with each element i repeated repeats[i]
+// times.
+func repeatData[T any](data []T, mask []bool, repeats []int) ([]T, []bool, error) {
+	if len(repeats) != len(data) {
+		return nil, nil, fmt.Errorf("repeats length (%d) must match series length (%d)", len(repeats), len(data))
+	}
+	total := 0
+	for _, r := range repeats {
+		if r < 0 {
+			return nil, nil, errors.New("repeats must be non-negative")
+		}
+		total += r
+	}
+	outData := make([]T, 0, total)
+	outMask := make([]bool, 0, total)
+	for i, r := range repeats {
+		for j := 0; j < r; j++ {
+			outData = append(outData, data[i])
+			outMask = append(outMask, mask[i])
+		}
+	}
+	return outData, outMask, nil
+}
+
+// tileData returns data and mask concatenated with themselves n times.
+func tileData[T any](data []T, mask []bool, n int) ([]T, []bool, error) {
+	if n < 0 {
+		return nil, nil, errors.New("tile count must be non-negative")
+	}
+	outData := make([]T, len(data)*n)
+	outMask := make([]bool, len(mask)*n)
+	for k := 0; k < n; k++ {
+		copy(outData[k*len(data):], data)
+		copy(outMask[k*len(mask):], mask)
+	}
+	return outData, outMask, nil
+}
+
+// Repeat returns a new Series of the same type as s where the element at
+// position i is repeated repeats[i] times. len(repeats) must equal s.Len().
+func Repeat(s Series, repeats []int) (Series, error) {
+	r, ok := s.(interface{ Repeat([]int) (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("Repeat: unsupported series type %T", s)
+	}
+	return r.Repeat(repeats)
+}
+
+// Tile returns a new Series of the same type as s containing the whole series
+// repeated n times.
+func Tile(s Series, n int) (Series, error) {
+	t, ok := s.(interface{ Tile(int) (Series, error) })
+	if !ok {
+		return nil, fmt.Errorf("Tile: unsupported series type %T", s)
+	}
+	return t.Tile(n)
+}
+
+// Repeat returns a new AnySeries where element i is repeated repeats[i] times.
+func (s *AnySeries) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// Tile returns a new AnySeries containing the series repeated n times.
+func (s *AnySeries) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new Float64Series where element i is repeated repeats[i] times.
+func (s *Float64Series) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// Tile returns a new Float64Series containing the series repeated n times.
+func (s *Float64Series) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new Int64Series where element i is repeated repeats[i] times.
+func (s *Int64Series) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// Tile returns a new Int64Series containing the series repeated n times.
+func (s *Int64Series) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new Int32Series where element i is repeated repeats[i] times.
+func (s *Int32Series) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// Tile returns a new Int32Series containing the series repeated n times.
+func (s *Int32Series) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new Float32Series where element i is repeated repeats[i] times.
+func (s *Float32Series) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// Tile returns a new Float32Series containing the series repeated n times.
+func (s *Float32Series) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new StringSeries where element i is repeated repeats[i] times.
+func (s *StringSeries) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// Tile returns a new StringSeries containing the series repeated n times.
+func (s *StringSeries) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new BoolSeries where element i is repeated repeats[i] times.
+func (s *BoolSeries) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// Tile returns a new BoolSeries containing the series repeated n times.
+func (s *BoolSeries) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new DateTimeSeries where element i is repeated repeats[i] times.
+func (s *DateTimeSeries) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := repeatData(s.data, s.mask, repeats)
+	if err != nil {
+		return nil, err
+	}
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// Tile returns a new DateTimeSeries containing the series repeated n times.
+func (s *DateTimeSeries) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, mask, err := tileData(s.data, s.mask, n)
+	if err != nil {
+		return nil, err
+	}
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// Repeat returns a new CategoricalSeries where element i is repeated
+// repeats[i] times. The result shares the same categories.
+func (s *CategoricalSeries) Repeat(repeats []int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	codes, _, err := repeatData(s.codes, make([]bool, len(s.codes)), repeats)
+	if err != nil {
+		return nil, err
+	}
+	return s.withCodes(codes), nil
+}
+
+// Tile returns a new CategoricalSeries containing the series repeated n
+// times. The result shares the same categories.
+func (s *CategoricalSeries) Tile(n int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	codes, _, err := tileData(s.codes, make([]bool, len(s.codes)), n)
+	if err != nil {
+		return nil, err
+	}
+	return s.withCodes(codes), nil
+}
+
+// withCodes returns a new CategoricalSeries with the given codes and a copy of
+// the receiver's categories. Caller must hold the read lock.
+func (s *CategoricalSeries) withCodes(codes []int32) *CategoricalSeries {
+	categories := make([]string, len(s.categories))
+	copy(categories, s.categories)
+	catIndex := make(map[string]int32, len(s.catIndex))
+	for k, v := range s.catIndex {
+		catIndex[k] = v
+	}
+	return &CategoricalSeries{codes: codes, categories: categories, catIndex: catIndex}
+}
@@ -0,0 +1,163 @@
+package collection
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// sampleIndices returns n positions drawn from [0, length) using a PRNG seeded
+// with seed. Without replacement each position is drawn at most once.
+func sampleIndices(length, n int, replace bool, seed int64) ([]int, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("sample size must be non-negative, got %d", n)
+	}
+	if !replace && n > length {
+		return nil, fmt.Errorf("cannot sample %d elements from series of length %d without replacement", n, length)
+	}
+	if replace && n > 0 && length == 0 {
+		return nil, fmt.Errorf("cannot sample %d elements from an empty series", n)
+	}
+
+	rng := rand.New(rand.NewPCG(uint64(seed), uint64(seed)))
+	if !replace {
+		return rng.Perm(length)[:n], nil
+	}
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = rng.IntN(length)
+	}
+	return indices, nil
+}
+
+// takeData returns the elements of data and mask at the given positions.
+func takeData[T any](data []T, mask []bool, indices []int) ([]T, []bool) {
+	outData := make([]T, len(indices))
+	outMask := make([]bool, len(indices))
+	for i, idx := range indices {
+		outData[i] = data[idx]
+		outMask[i] = mask[idx]
+	}
+	return outData, outMask
+}
+
+// Sample returns a new Series of the same type as s holding n elements drawn
+// at random, with or without replacement. The seed makes the selection
+// reproducible.
+func Sample(s Series, n int, replace bool, seed int64) (Series, error) {
+	sm, ok := s.(interface {
+		Sample(int, bool, int64) (Series, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Sample: unsupported series type %T", s)
+	}
+	return sm.Sample(n, replace, seed)
+}
+
+// Sample returns a new AnySeries of n elements drawn at random from s.
+func (s *AnySeries) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &AnySeries{data: data, mask: mask}, nil
+}
+
+// Sample returns a new Float64Series of n elements drawn at random from s.
+func (s *Float64Series) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &Float64Series{data: data, mask: mask}, nil
+}
+
+// Sample returns a new Int64Series of n elements drawn at random from s.
+func (s *Int64Series) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &Int64Series{data: data, mask: mask}, nil
+}
+
+// Sample returns a new Int32Series of n elements drawn at random from s.
+func (s *Int32Series) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &Int32Series{data: data, mask: mask}, nil
+}
+
+// Sample returns a new Float32Series of n elements drawn at random from s.
+func (s *Float32Series) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &Float32Series{data: data, mask: mask}, nil
+}
+
+// Sample returns a new StringSeries of n elements drawn at random from s.
+func (s *StringSeries) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &StringSeries{data: data, mask: mask}, nil
+}
+
+// Sample returns a new BoolSeries of n elements drawn at random from s.
+func (s *BoolSeries) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &BoolSeries{data: data, mask: mask}, nil
+}
+
+// Sample returns a new DateTimeSeries of n elements drawn at random from s.
+func (s *DateTimeSeries) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.data), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	data, mask := takeData(s.data, s.mask, indices)
+	return &DateTimeSeries{data: data, mask: mask}, nil
+}
+
+// Sample returns a new CategoricalSeries of n elements drawn at random from s.
+// The result shares the same categories.
+func (s *CategoricalSeries) Sample(n int, replace bool, seed int64) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	indices, err := sampleIndices(len(s.codes), n, replace, seed)
+	if err != nil {
+		return nil, err
+	}
+	codes, _ := takeData(s.codes, make([]bool, len(s.codes)), indices)
+	return s.withCodes(codes), nil
+}
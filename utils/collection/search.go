@@ -0,0 +1,338 @@
+package collection
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// searchSorted binary-searches an ascending data slice for v. Nulls compare
+// greater than any value, so they must be at the end.
+func searchSorted[T any](data []T, mask []bool, v T, side string, compare func(a, b T) int) (int, error) {
+	switch side {
+	case "left":
+		return sort.Search(len(data), func(i int) bool {
+			return mask[i] || compare(data[i], v) >= 0
+		}), nil
+	case "right":
+		return sort.Search(len(data), func(i int) bool {
+			return mask[i] || compare(data[i], v) > 0
+		}), nil
+	default:
+		return 0, fmt.Errorf("side must be 'left' or 'right', got '%s'", side)
+	}
+}
+
+// isSorted reports whether the non-null elements of data are ordered and all
+// nulls come last.
+func isSorted[T any](data []T, mask []bool, ascending bool, compare func(a, b T) int) bool {
+	prev := -1
+	for i := range data {
+		if mask[i] {
+			continue
+		}
+		if i > 0 && mask[i-1] {
+			return false // a value after a null
+		}
+		if prev >= 0 {
+			c := compare(data[prev], data[i])
+			if (ascending && c > 0) || (!ascending && c < 0) {
+				return false
+			}
+		}
+		prev = i
+	}
+	return true
+}
+
+// searchTypeError reports a SearchSorted value of the wrong type.
+func searchTypeError(v any, typeName string) error {
+	return fmt.Errorf("type mismatch: cannot search %s series for %T", typeName, v)
+}
+
+func compareBools(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case !a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func compareTimes(a, b time.Time) int { return a.Compare(b) }
+
+// compareAny orders two values of compatible types: numbers numerically,
+// strings lexically, bools with false first and times chronologically.
+func compareAny(a, b any) (int, error) {
+	if af, ok := numericValue(a); ok {
+		if bf, ok := numericValue(b); ok {
+			return cmp.Compare(af, bf), nil
+		}
+	}
+	switch av := a.(type) {
+	case string:
+		if bv, ok := b.(string); ok {
+			return strings.Compare(av, bv), nil
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			return compareBools(av, bv), nil
+		}
+	case time.Time:
+		if bv, ok := b.(time.Time); ok {
+			return av.Compare(bv), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %T and %T", a, b)
+}
+
+// SearchSorted returns the position at which v would be inserted to keep the
+// ascending series s sorted: before equal values for side "left", after them
+// for side "right". Nulls are treated as greater than any value. The result
+// is undefined if s is not sorted (see IsSorted).
+func SearchSorted(s Series, v any, side string) (int, error) {
+	ss, ok := s.(interface {
+		SearchSorted(any, string) (int, error)
+	})
+	if !ok {
+		return 0, fmt.Errorf("SearchSorted: unsupported series type %T", s)
+	}
+	return ss.SearchSorted(v, side)
+}
+
+// IsSorted reports whether the non-null values of s are in ascending (or
+// descending) order with every null at the end. A Series implemented outside
+// this package is checked through its ValuesCopy, as for an AnySeries.
+func IsSorted(s Series, ascending bool) bool {
+	if is, ok := s.(interface{ IsSorted(bool) bool }); ok {
+		return is.IsSorted(ascending)
+	}
+	return (&AnySeries{data: s.ValuesCopy(), mask: s.MaskCopy()}).IsSorted(ascending)
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted. Values are compared as by compareAny; an error is
+// returned if v cannot be compared with an element.
+func (s *AnySeries) SearchSorted(v any, side string) (int, error) {
+	if v == nil {
+		return 0, fmt.Errorf("search value must not be nil")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var cmpErr error
+	pos, err := searchSorted(s.data, s.mask, v, side, func(a, b any) int {
+		c, err := compareAny(a, b)
+		if err != nil && cmpErr == nil {
+			cmpErr = err
+		}
+		return c
+	})
+	if err != nil {
+		return 0, err
+	}
+	return pos, cmpErr
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted. It
+// returns false if two values cannot be compared.
+func (s *AnySeries) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	comparable := true
+	sorted := isSorted(s.data, s.mask, ascending, func(a, b any) int {
+		c, err := compareAny(a, b)
+		if err != nil {
+			comparable = false
+		}
+		return c
+	})
+	return sorted && comparable
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *Float64Series) SearchSorted(v any, side string) (int, error) {
+	f, ok := numericValue(v)
+	if !ok {
+		return 0, searchTypeError(v, "float64")
+	}
+	target := f
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return searchSorted(s.data, s.mask, target, side, cmp.Compare[float64])
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *Float64Series) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, cmp.Compare[float64])
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *Float32Series) SearchSorted(v any, side string) (int, error) {
+	f, ok := numericValue(v)
+	if !ok {
+		return 0, searchTypeError(v, "float32")
+	}
+	target := float32(f)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return searchSorted(s.data, s.mask, target, side, cmp.Compare[float32])
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *Float32Series) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, cmp.Compare[float32])
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *Int64Series) SearchSorted(v any, side string) (int, error) {
+	target, ok := searchInt(v)
+	if !ok {
+		return 0, searchTypeError(v, "int64")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return searchSorted(s.data, s.mask, target, side, cmp.Compare[int64])
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *Int64Series) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, cmp.Compare[int64])
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *Int32Series) SearchSorted(v any, side string) (int, error) {
+	n, ok := searchInt(v)
+	if !ok {
+		return 0, searchTypeError(v, "int32")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	// Widen to int64 so that targets outside the int32 range still land at
+	// either end.
+	return searchSorted(s.data, s.mask, int32(0), side, func(a, _ int32) int {
+		return cmp.Compare(int64(a), n)
+	})
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *Int32Series) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, cmp.Compare[int32])
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *StringSeries) SearchSorted(v any, side string) (int, error) {
+	target, ok := v.(string)
+	if !ok {
+		return 0, searchTypeError(v, "string")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return searchSorted(s.data, s.mask, target, side, strings.Compare)
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *StringSeries) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, strings.Compare)
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *BoolSeries) SearchSorted(v any, side string) (int, error) {
+	target, ok := v.(bool)
+	if !ok {
+		return 0, searchTypeError(v, "bool")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return searchSorted(s.data, s.mask, target, side, compareBools)
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *BoolSeries) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, compareBools)
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted.
+func (s *DateTimeSeries) SearchSorted(v any, side string) (int, error) {
+	target, ok := v.(time.Time)
+	if !ok {
+		return 0, searchTypeError(v, "time.Time")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return searchSorted(s.data, s.mask, target, side, compareTimes)
+}
+
+// IsSorted reports whether the series is sorted. See IsSorted.
+func (s *DateTimeSeries) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return isSorted(s.data, s.mask, ascending, compareTimes)
+}
+
+// SearchSorted returns the insertion position of v in the sorted series. See
+// SearchSorted. Categories are compared as strings.
+func (s *CategoricalSeries) SearchSorted(v any, side string) (int, error) {
+	target, ok := v.(string)
+	if !ok {
+		return 0, searchTypeError(v, "categorical")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values, mask := s.stringValues()
+	return searchSorted(values, mask, target, side, strings.Compare)
+}
+
+// IsSorted reports whether the series is sorted, comparing categories as
+// strings. See IsSorted.
+func (s *CategoricalSeries) IsSorted(ascending bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values, mask := s.stringValues()
+	return isSorted(values, mask, ascending, strings.Compare)
+}
+
+// stringValues returns the category string of each element and the null
+// mask. Caller must hold the read lock.
+func (s *CategoricalSeries) stringValues() ([]string, []bool) {
+	values := make([]string, len(s.codes))
+	mask := make([]bool, len(s.codes))
+	for i, c := range s.codes {
+		if c < 0 {
+			mask[i] = true
+			continue
+		}
+		values[i] = s.categories[c]
+	}
+	return values, mask
+}
+
+// searchInt returns v as an int64 if it is a Go integer.
+func searchInt(v any) (int64, bool) {
+	if _, isString := v.(string); isString {
+		return 0, false
+	}
+	return inferInt64(v)
+}
@@ -56,8 +56,12 @@ func NewSeriesOfType(t reflect.Type, capacity int) Series {
 	switch t.Kind() {
 	case reflect.Float64:
 		return NewFloat64Series(capacity)
+	case reflect.Float32:
+		return NewFloat32Series(capacity)
 	case reflect.Int64, reflect.Int:
 		return NewInt64Series(capacity)
+	case reflect.Int32:
+		return NewInt32Series(capacity)
 	case reflect.String:
 		return NewStringSeries(capacity)
 	case reflect.Bool:
@@ -74,9 +78,15 @@ func NewSeriesOfTypeWithSize(t reflect.Type, size int) Series {
 	case reflect.Float64:
 		s, _ := NewFloat64SeriesFromData(make([]float64, size), nil)
 		return s
+	case reflect.Float32:
+		s, _ := NewFloat32SeriesFromData(make([]float32, size), nil)
+		return s
 	case reflect.Int64, reflect.Int:
 		s, _ := NewInt64SeriesFromData(make([]int64, size), nil)
 		return s
+	case reflect.Int32:
+		s, _ := NewInt32SeriesFromData(make([]int32, size), nil)
+		return s
 	case reflect.String:
 		s, _ := NewStringSeriesFromData(make([]string, size), nil)
 		return s
@@ -107,6 +117,29 @@ func NewSeriesWithData(t reflect.Type, values []any) (Series, error) {
 	return s, nil
 }
 
+// RawData returns the typed slice underlying s without copying, e.g.
+// []float64 for a Float64Series, so callers that know the column type can
+// bypass At. Values at null positions are unspecified placeholders. The slice
+// is shared with the series: callers must not modify it, and it is not safe to
+// use while the series is being written to. For a Series implemented outside
+// this package it falls back to ValuesCopy.
+func RawData(s Series) any {
+	if r, ok := s.(interface{ RawData() any }); ok {
+		return r.RawData()
+	}
+	return s.ValuesCopy()
+}
+
+// RawMask returns the null mask (true = null) of s without copying, under the
+// same read-only contract as RawData. For a Series implemented outside this
+// package it falls back to MaskCopy.
+func RawMask(s Series) []bool {
+	if r, ok := s.(interface{ RawMask() []bool }); ok {
+		return r.RawMask()
+	}
+	return s.MaskCopy()
+}
+
 // -----------------------------------------------------------------------------
 // AnySeries
 // -----------------------------------------------------------------------------
@@ -257,6 +290,21 @@ func (s *AnySeries) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []any without copying. See RawData for
+// the read-only contract.
+func (s *AnySeries) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *AnySeries) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
 func (s *AnySeries) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -436,6 +484,21 @@ func (s *Float64Series) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []float64 without copying. See RawData for
+// the read-only contract.
+func (s *Float64Series) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *Float64Series) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
 func (s *Float64Series) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -475,6 +538,27 @@ func (s *Float64Series) Float64Values() []float64 {
 	return out
 }
 
+// ToFloat64Slice returns a copy of the raw float64 data, including the placeholder
+// values (normally zero) stored at null positions. Use MaskCopy to tell nulls apart.
+func (s *Float64Series) ToFloat64Slice() []float64 {
+	return s.Float64Values()
+}
+
+// ToFloat64SliceNoNulls returns a copy of the float64 data, or an error if the
+// series contains any null values.
+func (s *Float64Series) ToFloat64SliceNoNulls() ([]float64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for i, isNull := range s.mask {
+		if isNull {
+			return nil, fmt.Errorf("series contains null at index %d", i)
+		}
+	}
+	out := make([]float64, len(s.data))
+	copy(out, s.data)
+	return out, nil
+}
+
 // -----------------------------------------------------------------------------
 // Int64Series
 // -----------------------------------------------------------------------------
@@ -633,6 +717,21 @@ func (s *Int64Series) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []int64 without copying. See RawData for
+// the read-only contract.
+func (s *Int64Series) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *Int64Series) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
 func (s *Int64Series) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -672,6 +771,12 @@ func (s *Int64Series) Int64Values() []int64 {
 	return out
 }
 
+// ToInt64Slice returns a copy of the raw int64 data, including the placeholder values
+// (normally zero) stored at null positions. Use MaskCopy to tell nulls apart.
+func (s *Int64Series) ToInt64Slice() []int64 {
+	return s.Int64Values()
+}
+
 // -----------------------------------------------------------------------------
 // StringSeries
 // -----------------------------------------------------------------------------
@@ -830,6 +935,21 @@ func (s *StringSeries) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []string without copying. See RawData for
+// the read-only contract.
+func (s *StringSeries) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *StringSeries) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
 func (s *StringSeries) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -869,6 +989,12 @@ func (s *StringSeries) StringValues() []string {
 	return out
 }
 
+// ToStringSlice returns a copy of the raw string data, including the placeholder
+// values (normally empty) stored at null positions. Use MaskCopy to tell nulls apart.
+func (s *StringSeries) ToStringSlice() []string {
+	return s.StringValues()
+}
+
 // -----------------------------------------------------------------------------
 // BoolSeries
 // -----------------------------------------------------------------------------
@@ -1027,6 +1153,21 @@ func (s *BoolSeries) MaskCopy() []bool {
 	return out
 }
 
+// RawData returns the underlying []bool without copying. See RawData for
+// the read-only contract.
+func (s *BoolSeries) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *BoolSeries) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
 func (s *BoolSeries) Slice(start, end int) (Series, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -1046,3 +1187,66 @@ func (s *BoolSeries) Slice(start, end int) (Series, error) {
 		mask: newMask,
 	}, nil
 }
+
+// ToBoolSlice returns a copy of the raw bool data, including the placeholder
+// values (normally false) stored at null positions. Use MaskCopy to tell nulls apart.
+func (s *BoolSeries) ToBoolSlice() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]bool, len(s.data))
+	copy(out, s.data)
+	return out
+}
+
+// CountTrue returns the number of non-null values that are true.
+func (s *BoolSeries) CountTrue() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := 0
+	for i, v := range s.data {
+		if v && !s.mask[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// CountFalse returns the number of non-null values that are false.
+func (s *BoolSeries) CountFalse() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := 0
+	for i, v := range s.data {
+		if !v && !s.mask[i] {
+			n++
+		}
+	}
+	return n
+}
+
+// Any reports whether at least one non-null value is true.
+// This is analogous to Series.any() in pandas.
+func (s *BoolSeries) Any() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for i, v := range s.data {
+		if v && !s.mask[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether every non-null value is true. A series without non-null
+// values returns true.
+// This is analogous to Series.all() in pandas.
+func (s *BoolSeries) All() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for i, v := range s.data {
+		if !v && !s.mask[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,418 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// -----------------------------------------------------------------------------
+// Int32Series
+// -----------------------------------------------------------------------------
+
+// Int32Series is a series for int32 values with null support. It uses half the
+// memory of Int64Series and avoids upcasting data that is stored as int32 at
+// the source, such as 32-bit Parquet or Arrow columns.
+type Int32Series struct {
+	mu   sync.RWMutex
+	data []int32
+	mask []bool // true = null
+}
+
+// NewInt32Series creates a new empty Int32Series with optional capacity.
+func NewInt32Series(capacity int) *Int32Series {
+	return &Int32Series{
+		data: make([]int32, 0, capacity),
+		mask: make([]bool, 0, capacity),
+	}
+}
+
+// NewInt32SeriesFromData creates a Int32Series from values and mask.
+func NewInt32SeriesFromData(data []int32, mask []bool) (*Int32Series, error) {
+	if mask != nil && len(data) != len(mask) {
+		return nil, errors.New("data and mask length mismatch")
+	}
+	dataCopy := make([]int32, len(data))
+	copy(dataCopy, data)
+
+	var maskCopy []bool
+	if mask != nil {
+		maskCopy = make([]bool, len(mask))
+		copy(maskCopy, mask)
+	} else {
+		maskCopy = make([]bool, len(data))
+	}
+
+	return &Int32Series{data: dataCopy, mask: maskCopy}, nil
+}
+
+func (s *Int32Series) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+func (s *Int32Series) DType() reflect.Type {
+	return reflect.TypeOf(int32(0))
+}
+
+func (s *Int32Series) At(i int) (any, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if i < 0 || i >= len(s.data) {
+		return nil, errors.New("index out of range")
+	}
+	if s.mask[i] {
+		return nil, nil
+	}
+	return s.data[i], nil
+}
+
+func (s *Int32Series) IsNull(i int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if i < 0 || i >= len(s.mask) {
+		return true
+	}
+	return s.mask[i]
+}
+
+func (s *Int32Series) NullCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := 0
+	for _, isNull := range s.mask {
+		if isNull {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Int32Series) Set(i int, v any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 || i >= len(s.data) {
+		return errors.New("index out of range")
+	}
+	if v == nil {
+		s.mask[i] = true
+		s.data[i] = 0
+		return nil
+	}
+	val, ok := v.(int32)
+	if !ok {
+		return fmt.Errorf("type mismatch: expected int32, got %T", v)
+	}
+	s.data[i] = val
+	s.mask[i] = false
+	return nil
+}
+
+func (s *Int32Series) SetNull(i int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 || i >= len(s.data) {
+		return errors.New("index out of range")
+	}
+	s.mask[i] = true
+	s.data[i] = 0
+	return nil
+}
+
+func (s *Int32Series) Append(v any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v == nil {
+		s.data = append(s.data, 0)
+		s.mask = append(s.mask, true)
+		return nil
+	}
+	val, ok := v.(int32)
+	if !ok {
+		return fmt.Errorf("type mismatch: expected int32, got %T", v)
+	}
+	s.data = append(s.data, val)
+	s.mask = append(s.mask, false)
+	return nil
+}
+
+func (s *Int32Series) AppendNull() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = append(s.data, 0)
+	s.mask = append(s.mask, true)
+}
+
+func (s *Int32Series) ValuesCopy() []any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]any, len(s.data))
+	for i, v := range s.data {
+		if s.mask[i] {
+			out[i] = nil
+		} else {
+			out[i] = v
+		}
+	}
+	return out
+}
+
+func (s *Int32Series) MaskCopy() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]bool, len(s.mask))
+	copy(out, s.mask)
+	return out
+}
+
+// RawData returns the underlying []int32 without copying. See RawData for
+// the read-only contract.
+func (s *Int32Series) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *Int32Series) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
+func (s *Int32Series) Slice(start, end int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if start < 0 || end > len(s.data) || start > end {
+		return nil, errors.New("invalid slice bounds")
+	}
+
+	newData := make([]int32, end-start)
+	copy(newData, s.data[start:end])
+
+	newMask := make([]bool, end-start)
+	copy(newMask, s.mask[start:end])
+
+	return &Int32Series{
+		data: newData,
+		mask: newMask,
+	}, nil
+}
+
+// Int32Values returns a copy of the raw int32 data slice, including the
+// placeholder values (zero) stored at null positions.
+func (s *Int32Series) Int32Values() []int32 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]int32, len(s.data))
+	copy(out, s.data)
+	return out
+}
+
+// -----------------------------------------------------------------------------
+// Float32Series
+// -----------------------------------------------------------------------------
+
+// Float32Series is a series for float32 values with null support. It uses half the
+// memory of Float64Series and avoids upcasting data that is stored as float32 at
+// the source, such as 32-bit Parquet or Arrow columns.
+type Float32Series struct {
+	mu   sync.RWMutex
+	data []float32
+	mask []bool // true = null
+}
+
+// NewFloat32Series creates a new empty Float32Series with optional capacity.
+func NewFloat32Series(capacity int) *Float32Series {
+	return &Float32Series{
+		data: make([]float32, 0, capacity),
+		mask: make([]bool, 0, capacity),
+	}
+}
+
+// NewFloat32SeriesFromData creates a Float32Series from values and mask.
+func NewFloat32SeriesFromData(data []float32, mask []bool) (*Float32Series, error) {
+	if mask != nil && len(data) != len(mask) {
+		return nil, errors.New("data and mask length mismatch")
+	}
+	dataCopy := make([]float32, len(data))
+	copy(dataCopy, data)
+
+	var maskCopy []bool
+	if mask != nil {
+		maskCopy = make([]bool, len(mask))
+		copy(maskCopy, mask)
+	} else {
+		maskCopy = make([]bool, len(data))
+	}
+
+	return &Float32Series{data: dataCopy, mask: maskCopy}, nil
+}
+
+func (s *Float32Series) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.data)
+}
+
+func (s *Float32Series) DType() reflect.Type {
+	return reflect.TypeOf(float32(0))
+}
+
+func (s *Float32Series) At(i int) (any, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if i < 0 || i >= len(s.data) {
+		return nil, errors.New("index out of range")
+	}
+	if s.mask[i] {
+		return nil, nil
+	}
+	return s.data[i], nil
+}
+
+func (s *Float32Series) IsNull(i int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if i < 0 || i >= len(s.mask) {
+		return true
+	}
+	return s.mask[i]
+}
+
+func (s *Float32Series) NullCount() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	count := 0
+	for _, isNull := range s.mask {
+		if isNull {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Float32Series) Set(i int, v any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 || i >= len(s.data) {
+		return errors.New("index out of range")
+	}
+	if v == nil {
+		s.mask[i] = true
+		s.data[i] = 0
+		return nil
+	}
+	val, ok := v.(float32)
+	if !ok {
+		return fmt.Errorf("type mismatch: expected float32, got %T", v)
+	}
+	s.data[i] = val
+	s.mask[i] = false
+	return nil
+}
+
+func (s *Float32Series) SetNull(i int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if i < 0 || i >= len(s.data) {
+		return errors.New("index out of range")
+	}
+	s.mask[i] = true
+	s.data[i] = 0
+	return nil
+}
+
+func (s *Float32Series) Append(v any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v == nil {
+		s.data = append(s.data, 0)
+		s.mask = append(s.mask, true)
+		return nil
+	}
+	val, ok := v.(float32)
+	if !ok {
+		return fmt.Errorf("type mismatch: expected float32, got %T", v)
+	}
+	s.data = append(s.data, val)
+	s.mask = append(s.mask, false)
+	return nil
+}
+
+func (s *Float32Series) AppendNull() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = append(s.data, 0)
+	s.mask = append(s.mask, true)
+}
+
+func (s *Float32Series) ValuesCopy() []any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]any, len(s.data))
+	for i, v := range s.data {
+		if s.mask[i] {
+			out[i] = nil
+		} else {
+			out[i] = v
+		}
+	}
+	return out
+}
+
+func (s *Float32Series) MaskCopy() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]bool, len(s.mask))
+	copy(out, s.mask)
+	return out
+}
+
+// RawData returns the underlying []float32 without copying. See RawData for
+// the read-only contract.
+func (s *Float32Series) RawData() any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data
+}
+
+// RawMask returns the null mask without copying. See RawMask.
+func (s *Float32Series) RawMask() []bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.mask
+}
+
+func (s *Float32Series) Slice(start, end int) (Series, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if start < 0 || end > len(s.data) || start > end {
+		return nil, errors.New("invalid slice bounds")
+	}
+
+	newData := make([]float32, end-start)
+	copy(newData, s.data[start:end])
+
+	newMask := make([]bool, end-start)
+	copy(newMask, s.mask[start:end])
+
+	return &Float32Series{
+		data: newData,
+		mask: newMask,
+	}, nil
+}
+
+// Float32Values returns a copy of the raw float32 data slice, including the
+// placeholder values (zero) stored at null positions.
+func (s *Float32Series) Float32Values() []float32 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]float32, len(s.data))
+	copy(out, s.data)
+	return out
+}
@@ -0,0 +1,47 @@
+package collection
+
+import "math"
+
+// Sum returns the sum of the non-null values. On amd64 it uses an unrolled,
+// branch-free loop over the raw data (see sumAVX); elsewhere it falls back to
+// a plain loop that skips nulls.
+func (s *Float64Series) Sum() float64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	total := s.sumAVX()
+	if math.IsNaN(total) || math.IsInf(total, 0) {
+		// The branch-free path multiplies null slots by zero, which yields NaN
+		// when a null slot holds Inf or NaN. Recompute with explicit checks.
+		return sumFloat64Generic(s.data, s.mask)
+	}
+	return total
+}
+
+// Sum returns the sum of the non-null values. See Float64Series.Sum.
+func (s *Int64Series) Sum() int64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.sumAVX()
+}
+
+// sumFloat64Generic sums data, skipping positions where mask is true.
+func sumFloat64Generic(data []float64, mask []bool) float64 {
+	total := 0.0
+	for i, v := range data {
+		if !mask[i] {
+			total += v
+		}
+	}
+	return total
+}
+
+// sumInt64Generic sums data, skipping positions where mask is true.
+func sumInt64Generic(data []int64, mask []bool) int64 {
+	var total int64
+	for i, v := range data {
+		if !mask[i] {
+			total += v
+		}
+	}
+	return total
+}
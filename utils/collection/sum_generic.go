@@ -0,0 +1,15 @@
+//go:build !amd64
+
+package collection
+
+// sumAVX falls back to a plain loop on architectures without the amd64 fast
+// path. Caller must hold the read lock.
+func (s *Float64Series) sumAVX() float64 {
+	return sumFloat64Generic(s.data, s.mask)
+}
+
+// sumAVX falls back to a plain loop on architectures without the amd64 fast
+// path. Caller must hold the read lock.
+func (s *Int64Series) sumAVX() int64 {
+	return sumInt64Generic(s.data, s.mask)
+}